@@ -2,6 +2,7 @@ package m3u8reader
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/eswarantg/m3u8reader/common"
 	"github.com/eswarantg/m3u8reader/parsers"
@@ -10,6 +11,60 @@ import (
 type M3U8Entry struct {
 	Tag    common.TagId
 	Values *parsers.AttrKVPairs
+	//Raw bytes read for the entry in lossless mode, including line terminators
+	//Written back as is by WriteTo as long as Values are the ones read, the entry is written from Values otherwise
+	Raw []byte
+	//Order of the attributes as read in lossless mode
+	AttrOrder []common.AttrId
+
+	//span - for a tag with an URI line, the bytes from the tag line to the URI line as read in lossless mode
+	//set if there are tag lines in between, kept as the entries before it with inner set
+	span  []byte
+	inner bool
+	//rawValues - Values as read along with Raw
+	rawValues map[common.AttrId]interface{}
+}
+
+//setRaw - raw bytes of the entry as per its current Values
+func (m *M3U8Entry) setRaw(raw []byte) {
+	m.Raw = raw
+	m.rawValues = make(map[common.AttrId]interface{}, len(m.Values.Map()))
+	for k, v := range m.Values.Map() {
+		if !computedAttr(k) {
+			m.rawValues[k] = v
+		}
+	}
+}
+
+//rawValid - Raw is to be written, Values are the ones read along with it
+func (m *M3U8Entry) rawValid() bool {
+	if m.Raw == nil {
+		return false
+	}
+	if m.rawValues == nil {
+		//Raw set by the caller
+		return true
+	}
+	n := 0
+	for k, v := range m.Values.Map() {
+		if computedAttr(k) {
+			continue
+		}
+		n++
+		if read, ok := m.rawValues[k]; !ok || !reflect.DeepEqual(read, v) {
+			return false
+		}
+	}
+	return n == len(m.rawValues)
+}
+
+//computedAttr - values computed when the entry is posted, not part of the playlist text
+func computedAttr(k common.AttrId) bool {
+	switch k {
+	case common.INTProgramDateTime, common.INTMediaSequenceNumber, common.INTPartNumber:
+		return true
+	}
+	return false
 }
 
 func (m *M3U8Entry) Done() {
@@ -18,6 +73,10 @@ func (m *M3U8Entry) Done() {
 }
 
 func (m *M3U8Entry) StoreKV(k common.AttrId, v interface{}) {
+	if !computedAttr(k) {
+		//computed values are not part of the raw entry
+		m.Raw = nil
+	}
 	m.Values.Store(k, v)
 }
 
@@ -25,13 +84,19 @@ func (m *M3U8Entry) String() string {
 	return fmt.Sprintf("%v(%v)%v", common.TagNames[m.Tag], m.Tag, m.Values.String())
 }
 
+//URI - as in the playlist, error if the tag has none
+//EXT-X-KEY with METHOD=NONE, EXT-X-MEDIA and EXT-X-SESSION-DATA may have no URI
 func (m *M3U8Entry) URI() (string, error) {
 	var attrId common.AttrId = -1
 	switch m.Tag {
 	case common.M3U8ExtXStreamInf:
 		attrId = common.INTUnknownAttr
-	case common.M3U8ExtXMedia, common.M3U8ExtInf, common.M3U8ExtXPreLoadHint, common.M3U8ExtXPart:
+	case common.M3U8ExtXMedia, common.M3U8ExtInf, common.M3U8ExtXPreLoadHint, common.M3U8ExtXPart,
+		common.M3U8ExtXMap, common.M3U8ExtXKey, common.M3U8ExtXSesionKey, common.M3U8ExtXIFrameStreamInf,
+		common.M3U8ExtXRenditionReport, common.M3U8ExtXSessionData:
 		attrId = common.M3U8Uri
+	case common.M3U8ExtXContentSteering:
+		attrId = common.M3U8ServerUri
 	}
 	if attrId != -1 {
 		val := m.Values.Get(attrId)
@@ -0,0 +1,174 @@
+package m3u8reader
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/eswarantg/m3u8reader/common"
+)
+
+//HDCP-LEVEL values in increasing order of protection
+var hdcpLevels = map[string]int{
+	"NONE":   0,
+	"TYPE-0": 1,
+	"TYPE-1": 2,
+}
+
+//VariantConstraints - what the player can play, zero values do not constrain
+type VariantConstraints struct {
+	//MaxBandwidth - bits per second, compared to AVERAGE-BANDWIDTH when present, BANDWIDTH otherwise
+	MaxBandwidth int64
+	//PeakBandwidth - MaxBandwidth is compared to BANDWIDTH even when AVERAGE-BANDWIDTH is present
+	PeakBandwidth bool
+	MaxResolution common.Resolution
+	MaxFrameRate  float64
+	//Codecs - supported codecs, an entry matches the CODECS starting with it, "avc1" matches "avc1.64001f"
+	//Variants without CODECS are not excluded
+	Codecs []string
+	//HDCPLevel - highest HDCP-LEVEL the output supports, NONE if HDCP isn't available
+	HDCPLevel string
+	//VideoRanges - supported VIDEO-RANGE values most preferred first, SDR applies when not specified
+	VideoRanges []string
+}
+
+//Exclusion - reason a Variant can't be played
+type Exclusion struct {
+	Attr    common.AttrId //attribute of EXT-X-STREAM-INF not satisfying the constraint
+	Message string
+}
+
+func (e Exclusion) String() string {
+	return fmt.Sprintf("%v : %v", common.AttrNames[e.Attr], e.Message)
+}
+
+//VariantChoice - Variant along with the reasons it is excluded, none if it can be played
+type VariantChoice struct {
+	Variant  Variant
+	Excluded []Exclusion
+}
+
+//Playable - true if the Variant satisfies all the constraints
+func (c VariantChoice) Playable() bool {
+	return len(c.Excluded) == 0
+}
+
+//bandwidth - AVERAGE-BANDWIDTH if present and not peak, BANDWIDTH otherwise
+func (c *VariantConstraints) bandwidth(v Variant) (attr common.AttrId, bps int64) {
+	if !c.PeakBandwidth && v.AverageBandwidth > 0 {
+		return common.M3U8AverageBandwidth, v.AverageBandwidth
+	}
+	return common.M3U8Bandwidth, v.Bandwidth
+}
+
+//videoRange - VIDEO-RANGE, SDR when not specified
+func videoRange(v Variant) string {
+	if v.VideoRange == "" {
+		return "SDR"
+	}
+	return v.VideoRange
+}
+
+//rangePreference - index in VideoRanges, 0 if not constrained, -1 if not supported
+func (c *VariantConstraints) rangePreference(v Variant) int {
+	if len(c.VideoRanges) == 0 {
+		return 0
+	}
+	for i, r := range c.VideoRanges {
+		if r == videoRange(v) {
+			return i
+		}
+	}
+	return -1
+}
+
+//supportedCodec - codec starts with one of the supported codecs
+func (c *VariantConstraints) supportedCodec(codec string) bool {
+	for _, supported := range c.Codecs {
+		if strings.HasPrefix(codec, supported) {
+			return true
+		}
+	}
+	return false
+}
+
+//exclusions - constraints the variant doesn't satisfy
+func (c *VariantConstraints) exclusions(v Variant) (excluded []Exclusion) {
+	exclude := func(attr common.AttrId, format string, args ...interface{}) {
+		excluded = append(excluded, Exclusion{Attr: attr, Message: fmt.Sprintf(format, args...)})
+	}
+	if c.MaxBandwidth > 0 {
+		if attr, bps := c.bandwidth(v); bps > c.MaxBandwidth {
+			exclude(attr, "%v above the maximum %v", bps, c.MaxBandwidth)
+		}
+	}
+	if c.MaxResolution.Width > 0 && v.Resolution.Width > c.MaxResolution.Width ||
+		c.MaxResolution.Height > 0 && v.Resolution.Height > c.MaxResolution.Height {
+		exclude(common.M3U8Resolution, "%v above the maximum %v", v.Resolution, c.MaxResolution)
+	}
+	if c.MaxFrameRate > 0 && v.FrameRate > c.MaxFrameRate {
+		exclude(common.M3U8FrameRate, "%v above the maximum %v", v.FrameRate, c.MaxFrameRate)
+	}
+	if len(c.Codecs) > 0 {
+		for _, codec := range v.Codecs {
+			if !c.supportedCodec(codec) {
+				exclude(common.M3U8Codecs, "%v not supported", codec)
+			}
+		}
+	}
+	if c.HDCPLevel != "" && v.HDCPLevel != "" {
+		level, ok := hdcpLevels[v.HDCPLevel]
+		if !ok || level > hdcpLevels[c.HDCPLevel] {
+			exclude(common.M3U8HdcpLevel, "%v not supported by the output %v", v.HDCPLevel, c.HDCPLevel)
+		}
+	}
+	if c.rangePreference(v) < 0 {
+		exclude(common.M3U8VideoRange, "%v not supported", videoRange(v))
+	}
+	return
+}
+
+//SelectVariants - all the Variants, the playable ones first, most preferred first
+//Playable Variants are ranked by SCORE, Variants without SCORE last, then by
+//preference of VIDEO-RANGE, then by bandwidth, then by resolution
+//Excluded Variants follow in the order of the playlist
+func (m *MasterPlaylist) SelectVariants(c VariantConstraints) []VariantChoice {
+	choices := make([]VariantChoice, len(m.Variants))
+	for i, v := range m.Variants {
+		choices[i] = VariantChoice{Variant: v, Excluded: c.exclusions(v)}
+	}
+	sort.SliceStable(choices, func(i, j int) bool {
+		a, b := choices[i], choices[j]
+		if a.Playable() != b.Playable() {
+			return a.Playable()
+		}
+		if !a.Playable() {
+			return false
+		}
+		if a.Variant.Score != b.Variant.Score {
+			return a.Variant.Score > b.Variant.Score
+		}
+		if pa, pb := c.rangePreference(a.Variant), c.rangePreference(b.Variant); pa != pb {
+			return pa < pb
+		}
+		_, bwa := c.bandwidth(a.Variant)
+		_, bwb := c.bandwidth(b.Variant)
+		if bwa != bwb {
+			return bwa > bwb
+		}
+		return a.Variant.Resolution.Width*a.Variant.Resolution.Height > b.Variant.Resolution.Width*b.Variant.Resolution.Height
+	})
+	return choices
+}
+
+//SelectVariant - most preferred playable Variant, error if none can be played
+func (m *MasterPlaylist) SelectVariant(c VariantConstraints) (v *Variant, err error) {
+	choices := m.SelectVariants(c)
+	if len(choices) == 0 {
+		return nil, fmt.Errorf("no Variant Stream")
+	}
+	if !choices[0].Playable() {
+		return nil, fmt.Errorf("no Variant Stream playable, %v : %v", choices[0].Variant.URI, choices[0].Excluded)
+	}
+	return &choices[0].Variant, nil
+}
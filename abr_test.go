@@ -0,0 +1,158 @@
+package m3u8reader_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eswarantg/m3u8reader"
+	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+const abrMaster = `#EXTM3U
+#EXT-X-VERSION:7
+#EXT-X-STREAM-INF:BANDWIDTH=800000,AVERAGE-BANDWIDTH=700000,CODECS="avc1.4d401e,mp4a.40.2",RESOLUTION=640x360,FRAME-RATE=30.000
+low.m3u8
+#EXT-X-STREAM-INF:BANDWIDTH=2500000,AVERAGE-BANDWIDTH=2000000,CODECS="avc1.640028,mp4a.40.2",RESOLUTION=1280x720,FRAME-RATE=30.000
+mid.m3u8
+#EXT-X-STREAM-INF:BANDWIDTH=6000000,CODECS="avc1.640028,mp4a.40.2",RESOLUTION=1920x1080,FRAME-RATE=60.000,HDCP-LEVEL=TYPE-0
+high.m3u8
+#EXT-X-STREAM-INF:BANDWIDTH=5000000,CODECS="hvc1.2.4.L123.B0,mp4a.40.2",RESOLUTION=1920x1080,FRAME-RATE=30.000,HDCP-LEVEL=TYPE-1,VIDEO-RANGE=PQ
+hdr.m3u8
+#EXT-X-STREAM-INF:BANDWIDTH=15000000,CODECS="avc1.640033,mp4a.40.2",RESOLUTION=3840x2160,FRAME-RATE=30.000,HDCP-LEVEL=TYPE-1
+4k.m3u8
+`
+
+//summary - URIs of the playable Variants, then | and the URIs of the excluded ones with the attributes
+func summary(choices []m3u8reader.VariantChoice) string {
+	var playable, excluded []string
+	for _, c := range choices {
+		if c.Playable() {
+			playable = append(playable, c.Variant.URI)
+			continue
+		}
+		var attrs []string
+		for _, e := range c.Excluded {
+			attrs = append(attrs, common.AttrNames[e.Attr])
+		}
+		excluded = append(excluded, strings.TrimSuffix(c.Variant.URI, ".m3u8")+"("+strings.Join(attrs, ",")+")")
+	}
+	return strings.Join(playable, " ") + " | " + strings.Join(excluded, " ")
+}
+
+func Test_SelectVariants(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	tests := []struct {
+		name        string
+		constraints m3u8reader.VariantConstraints
+		expected    string
+	}{
+		{"none", m3u8reader.VariantConstraints{},
+			"4k.m3u8 high.m3u8 hdr.m3u8 mid.m3u8 low.m3u8 | "},
+		{"average bandwidth", m3u8reader.VariantConstraints{MaxBandwidth: 2200000},
+			"mid.m3u8 low.m3u8 | high(BANDWIDTH) hdr(BANDWIDTH) 4k(BANDWIDTH)"},
+		{"peak bandwidth", m3u8reader.VariantConstraints{MaxBandwidth: 2200000, PeakBandwidth: true},
+			"low.m3u8 | mid(BANDWIDTH) high(BANDWIDTH) hdr(BANDWIDTH) 4k(BANDWIDTH)"},
+		{"resolution frame rate", m3u8reader.VariantConstraints{MaxResolution: common.Resolution{Width: 1920, Height: 1080}, MaxFrameRate: 30},
+			"hdr.m3u8 mid.m3u8 low.m3u8 | high(FRAME-RATE) 4k(RESOLUTION)"},
+		{"codecs", m3u8reader.VariantConstraints{Codecs: []string{"avc1", "mp4a.40"}},
+			"4k.m3u8 high.m3u8 mid.m3u8 low.m3u8 | hdr(CODECS)"},
+		{"no hdcp", m3u8reader.VariantConstraints{HDCPLevel: "NONE"},
+			"mid.m3u8 low.m3u8 | high(HDCP-LEVEL) hdr(HDCP-LEVEL) 4k(HDCP-LEVEL)"},
+		{"hdcp type 0", m3u8reader.VariantConstraints{HDCPLevel: "TYPE-0"},
+			"high.m3u8 mid.m3u8 low.m3u8 | hdr(HDCP-LEVEL) 4k(HDCP-LEVEL)"},
+		{"hdr preferred", m3u8reader.VariantConstraints{VideoRanges: []string{"PQ", "SDR"}},
+			"hdr.m3u8 4k.m3u8 high.m3u8 mid.m3u8 low.m3u8 | "},
+		{"sdr only", m3u8reader.VariantConstraints{VideoRanges: []string{"SDR"}, MaxBandwidth: 7000000},
+			"high.m3u8 mid.m3u8 low.m3u8 | hdr(VIDEO-RANGE) 4k(BANDWIDTH)"},
+		{"many reasons", m3u8reader.VariantConstraints{MaxBandwidth: 1000000, Codecs: []string{"avc1", "mp4a"}, HDCPLevel: "NONE"},
+			"low.m3u8 | mid(AVERAGE-BANDWIDTH) high(BANDWIDTH,HDCP-LEVEL) hdr(BANDWIDTH,CODECS,HDCP-LEVEL) 4k(BANDWIDTH,HDCP-LEVEL)"},
+	}
+	for _, opt := range []m3u8reader.ParserOption{m3u8reader.M3U8ParserScanner2, m3u8reader.M3U8ParserScanner3,
+		m3u8reader.M3U8ParserGrammar, m3u8reader.M3U8ParserYacc} {
+		m := &m3u8reader.M3U8{}
+		m.SetParserOption(opt)
+		_, err := m.ParseData([]byte(abrMaster))
+		if err != nil {
+			t.Fatalf("parser %v : ParseData : %v", opt, err)
+		}
+		master, err := m.MasterPlaylist()
+		if err != nil {
+			t.Fatalf("parser %v : MasterPlaylist : %v", opt, err)
+		}
+		for _, test := range tests {
+			got := summary(master.SelectVariants(test.constraints))
+			if got != test.expected {
+				t.Errorf("parser %v : %v : expected %v : got %v", opt, test.name, test.expected, got)
+			}
+		}
+	}
+}
+
+func Test_SelectVariantScore(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	m := &m3u8reader.M3U8{}
+	_, err := m.ParseData([]byte(`#EXTM3U
+#EXT-X-STREAM-INF:BANDWIDTH=3000000,SCORE=1.5
+a.m3u8
+#EXT-X-STREAM-INF:BANDWIDTH=1000000
+b.m3u8
+#EXT-X-STREAM-INF:BANDWIDTH=2000000,SCORE=2.0
+c.m3u8
+#EXT-X-STREAM-INF:BANDWIDTH=4000000,SCORE=1.5
+d.m3u8
+`))
+	if err != nil {
+		t.Fatalf("ParseData : %v", err)
+	}
+	master, _ := m.MasterPlaylist()
+	got := summary(master.SelectVariants(m3u8reader.VariantConstraints{}))
+	expected := "c.m3u8 d.m3u8 a.m3u8 b.m3u8 | "
+	if got != expected {
+		t.Errorf("score : expected %v : got %v", expected, got)
+	}
+	v, err := master.SelectVariant(m3u8reader.VariantConstraints{MaxBandwidth: 3500000})
+	if err != nil || v.URI != "c.m3u8" {
+		t.Errorf("SelectVariant : expected c.m3u8 : got %v %v", v, err)
+	}
+	_, err = master.SelectVariant(m3u8reader.VariantConstraints{MaxBandwidth: 500000})
+	if err == nil {
+		t.Errorf("SelectVariant : error expected")
+	}
+}
+
+func Test_GetVideoMediaPlaylist(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	m := &m3u8reader.M3U8{}
+	_, err := m.ParseData([]byte(abrMaster))
+	if err != nil {
+		t.Fatalf("ParseData : %v", err)
+	}
+	//BANDWIDTH as text, as set by an application
+	kv := parsers.NewAttrKVPairs()
+	kv.Store(common.M3U8Bandwidth, "1000000")
+	kv.Store(common.INTUnknownAttr, "text.m3u8")
+	err = m.PostRecord(common.M3U8ExtXStreamInf, kv)
+	if err != nil {
+		t.Fatalf("PostRecord : %v", err)
+	}
+	tests := []struct {
+		max      int64
+		expected string
+	}{
+		{2500000, "mid.m3u8"},
+		{1000000, "text.m3u8"},
+		{100000000, "4k.m3u8"},
+		{100, ""},
+	}
+	for _, test := range tests {
+		entry, err := m.GetVideoMediaPlaylist(test.max)
+		var uri string
+		if entry != nil {
+			uri, _ = entry.URI()
+		}
+		if err != nil || uri != test.expected {
+			t.Errorf("%v : expected %v : got %v %v", test.max, test.expected, uri, err)
+		}
+	}
+}
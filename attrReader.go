@@ -0,0 +1,214 @@
+package m3u8reader
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+//attrReader - reads typed values out of an entry
+//Values may be stored as text (scan parsers) or as typed values (grammar parser)
+//The first error is kept and all further reads return zero values
+type attrReader struct {
+	entry *M3U8Entry
+	err   error
+}
+
+func newAttrReader(entry *M3U8Entry) *attrReader {
+	return &attrReader{entry: entry}
+}
+
+func (r *attrReader) get(attrId common.AttrId) interface{} {
+	if r.err != nil {
+		return nil
+	}
+	return r.entry.Values.Get(attrId)
+}
+
+func (r *attrReader) fail(attrId common.AttrId, val interface{}, err error) {
+	r.err = fmt.Errorf("%v:%v invalid value \"%v\" : %w", common.TagNames[r.entry.Tag], common.AttrNames[attrId], val, err)
+}
+
+func (r *attrReader) has(attrId common.AttrId) bool {
+	return r.entry.Values.Exists(attrId)
+}
+
+func (r *attrReader) str(attrId common.AttrId) string {
+	switch v := r.get(attrId).(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		str, err := parsers.FormatValue(v)
+		if err != nil {
+			r.fail(attrId, v, err)
+		}
+		return str
+	}
+}
+
+func (r *attrReader) int64(attrId common.AttrId) int64 {
+	switch v := r.get(attrId).(type) {
+	case nil:
+		return 0
+	case int64:
+		return v
+	case string, []byte:
+		i, err := strconv.ParseInt(r.str(attrId), 10, 64)
+		if err != nil {
+			r.fail(attrId, v, err)
+		}
+		return i
+	default:
+		r.fail(attrId, v, fmt.Errorf("expected int64 found %T", v))
+	}
+	return 0
+}
+
+func (r *attrReader) float64(attrId common.AttrId) float64 {
+	switch v := r.get(attrId).(type) {
+	case nil:
+		return 0
+	case float64:
+		return v
+	case int64:
+		return float64(v)
+	case string, []byte:
+		f, err := strconv.ParseFloat(r.str(attrId), 64)
+		if err != nil {
+			r.fail(attrId, v, err)
+		}
+		return f
+	default:
+		r.fail(attrId, v, fmt.Errorf("expected float64 found %T", v))
+	}
+	return 0
+}
+
+//duration - decimal-floating-point seconds as time.Duration
+func (r *attrReader) duration(attrId common.AttrId) time.Duration {
+	return secondsToDuration(r.float64(attrId))
+}
+
+//bool - enumerated-string YES/NO
+func (r *attrReader) bool(attrId common.AttrId) bool {
+	switch v := r.get(attrId).(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string, []byte:
+		switch r.str(attrId) {
+		case "YES":
+			return true
+		case "NO":
+			return false
+		}
+		r.fail(attrId, v, fmt.Errorf("expected YES or NO"))
+	default:
+		r.fail(attrId, v, fmt.Errorf("expected bool found %T", v))
+	}
+	return false
+}
+
+func (r *attrReader) resolution(attrId common.AttrId) common.Resolution {
+	switch v := r.get(attrId).(type) {
+	case nil:
+		return common.Resolution{}
+	case common.Resolution:
+		return v
+	case string, []byte:
+		res, err := common.ParseResolution(r.str(attrId))
+		if err != nil {
+			r.fail(attrId, v, err)
+		}
+		return res
+	default:
+		r.fail(attrId, v, fmt.Errorf("expected resolution found %T", v))
+	}
+	return common.Resolution{}
+}
+
+func (r *attrReader) time(attrId common.AttrId) time.Time {
+	switch v := r.get(attrId).(type) {
+	case nil:
+		return time.Time{}
+	case time.Time:
+		return v
+	case string, []byte:
+		t, err := time.Parse(time.RFC3339Nano, r.str(attrId))
+		if err != nil {
+			r.fail(attrId, v, err)
+		}
+		return t
+	default:
+		r.fail(attrId, v, fmt.Errorf("expected time found %T", v))
+	}
+	return time.Time{}
+}
+
+//byteRange - <n>[@<o>], nil if not present
+func (r *attrReader) byteRange(attrId common.AttrId) *ByteRange {
+	switch v := r.get(attrId).(type) {
+	case nil:
+		return nil
+	case [2]int64:
+		return &ByteRange{Length: v[0], Offset: v[1]}
+	case string, []byte:
+		br, err := ParseByteRange(r.str(attrId))
+		if err != nil {
+			r.fail(attrId, v, err)
+			return nil
+		}
+		return br
+	default:
+		r.fail(attrId, v, fmt.Errorf("expected byte range found %T", v))
+	}
+	return nil
+}
+
+//list - comma or slash seperated values in a quoted-string
+func (r *attrReader) list(attrId common.AttrId, sep string) []string {
+	str := r.str(attrId)
+	if str == "" {
+		return nil
+	}
+	return strings.Split(str, sep)
+}
+
+//hex - hexadecimal-sequence 0x...
+func (r *attrReader) hex(attrId common.AttrId) []byte {
+	switch v := r.get(attrId).(type) {
+	case nil:
+		return nil
+	case [16]byte:
+		return append([]byte(nil), v[:]...)
+	case string, []byte:
+		str := r.str(attrId)
+		str = strings.TrimPrefix(strings.TrimPrefix(str, "0x"), "0X")
+		if len(str)%2 == 1 {
+			str = "0" + str
+		}
+		b, err := hex.DecodeString(str)
+		if err != nil {
+			r.fail(attrId, v, err)
+		}
+		return b
+	default:
+		r.fail(attrId, v, fmt.Errorf("expected hexadecimal-sequence found %T", v))
+	}
+	return nil
+}
+
+func secondsToDuration(f float64) time.Duration {
+	return time.Duration(math.Round(f * float64(time.Second)))
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/eswarantg/m3u8reader"
+	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/validate"
+)
+
+//header - labels the output of each playlist when more than one is given
+func (e *env) header(name string) {
+	if e.multiple {
+		fmt.Fprintf(e.stdout, "==> %v <==\n", name)
+	}
+}
+
+//entryText - entry as written in the playlist, on a single line
+func entryText(entry *m3u8reader.M3U8Entry) string {
+	var buf bytes.Buffer
+	_, err := entry.WriteTo(&buf)
+	if err != nil {
+		return fmt.Sprintf("<%v>", err)
+	}
+	return strings.ReplaceAll(strings.TrimSpace(buf.String()), "\n", " ")
+}
+
+//programDateTimes - date time of the segments, parts and preload hints of the Segment view
+//entries to which no EXT-X-PROGRAM-DATE-TIME applies are not included
+func programDateTimes(m *m3u8reader.M3U8) map[*m3u8reader.M3U8Entry]time.Time {
+	pdts := make(map[*m3u8reader.M3U8Entry]time.Time)
+	media, err := m.MediaPlaylist()
+	if err != nil {
+		return pdts
+	}
+	add := func(entry *m3u8reader.M3U8Entry, pdt time.Time) {
+		if !pdt.IsZero() {
+			pdts[entry] = pdt
+		}
+	}
+	for _, seg := range media.Segments {
+		add(seg.Entry, seg.ProgramDateTime)
+	}
+	for _, part := range media.Parts {
+		add(part.Entry, part.ProgramDateTime)
+	}
+	for _, hint := range media.PreloadHints {
+		add(hint.Entry, hint.ProgramDateTime)
+	}
+	return pdts
+}
+
+func runDump(e *env, name string, m *m3u8reader.M3U8) (bool, error) {
+	e.header(name)
+	w := tabwriter.NewWriter(e.stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "INDEX\tTAG\tMSN\tPART\tPDT\tENTRY")
+	pdts := programDateTimes(m)
+	for i := range m.Entries {
+		entry := &m.Entries[i]
+		var msn, part, pdt string
+		if v, ok := entry.Values.Get(common.INTMediaSequenceNumber).(int64); ok {
+			msn = fmt.Sprint(v)
+		}
+		if v, ok := entry.Values.Get(common.INTPartNumber).(int64); ok {
+			part = fmt.Sprint(v)
+		}
+		if v, ok := pdts[entry]; ok {
+			pdt = v.Format(time.RFC3339Nano)
+		}
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\t%v\n", i, common.TagNames[entry.Tag], msn, part, pdt, entryText(entry))
+	}
+	return true, w.Flush()
+}
+
+func runLint(e *env, name string, m *m3u8reader.M3U8) (ok bool, err error) {
+	ok = true
+	for _, d := range m.Diagnostics {
+		fmt.Fprintf(e.stdout, "%v : %v\n", name, d)
+		if d.Severity == m3u8reader.SeverityError {
+			ok = false
+		}
+	}
+	for _, f := range validate.Validate(m) {
+		if f.Entry >= 0 {
+			fmt.Fprintf(e.stdout, "%v : entry %v %v : %v : %v : %v\n", name, f.Entry, common.TagNames[m.Entries[f.Entry].Tag],
+				f.Severity, f.Rule, f.Message)
+		} else {
+			fmt.Fprintf(e.stdout, "%v : %v : %v : %v\n", name, f.Severity, f.Rule, f.Message)
+		}
+		if f.Severity == m3u8reader.SeverityError {
+			ok = false
+		}
+	}
+	return
+}
+
+func runJSON(e *env, name string, m *m3u8reader.M3U8) (bool, error) {
+	enc := json.NewEncoder(e.stdout)
+	enc.SetIndent("", "  ")
+	return true, enc.Encode(m)
+}
+
+func runFmt(e *env, name string, m *m3u8reader.M3U8) (bool, error) {
+	e.header(name)
+	_, err := m.WriteTo(e.stdout)
+	return true, err
+}
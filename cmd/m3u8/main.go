@@ -0,0 +1,185 @@
+//m3u8 - parses HLS playlists and dumps, lints, converts to JSON or re-formats them
+//
+//Usage: m3u8 <command> [flags] [file ...]
+//Files are read from stdin when none are given
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/eswarantg/m3u8reader"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+//Exit codes
+const (
+	exitOk    = 0
+	exitFail  = 1 //parse failure or lint errors
+	exitUsage = 2
+)
+
+//parserNames - names accepted by -parser for the ParserOption values
+var parserNames = map[string]m3u8reader.ParserOption{
+	"scanner1": m3u8reader.M3U8ParserScanner1,
+	"scanner2": m3u8reader.M3U8ParserScanner2,
+	"scanner3": m3u8reader.M3U8ParserScanner3,
+	"grammar":  m3u8reader.M3U8ParserGrammar,
+	"yacc":     m3u8reader.M3U8ParserYacc,
+}
+
+//parserFlag - ParserOption by name or by value
+type parserFlag struct {
+	opt m3u8reader.ParserOption
+}
+
+func (p *parserFlag) String() string {
+	for name, opt := range parserNames {
+		if opt == p.opt {
+			return name
+		}
+	}
+	return strconv.Itoa(int(p.opt))
+}
+
+func (p *parserFlag) Set(s string) error {
+	if opt, ok := parserNames[s]; ok {
+		p.opt = opt
+		return nil
+	}
+	i, err := strconv.Atoi(s)
+	if err != nil || i < int(m3u8reader.M3U8ParserScanner1) || i > int(m3u8reader.M3U8ParserYacc) {
+		return fmt.Errorf("unknown parser %v, expected one of %v", s, strings.Join(parserList(), ", "))
+	}
+	p.opt = m3u8reader.ParserOption(i)
+	return nil
+}
+
+func parserList() []string {
+	names := make([]string, 0, len(parserNames))
+	for name := range parserNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+//command - runs on every playlist read, returns false if the playlist is to fail the run
+type command struct {
+	name  string
+	usage string
+	run   func(env *env, name string, m *m3u8reader.M3U8) (ok bool, err error)
+}
+
+var commands = []command{
+	{"dump", "table of the entries with the computed media sequence, part number and program date time", runDump},
+	{"lint", "reports the RFC 8216 violations, fails if any is an error", runLint},
+	{"json", "parsed playlist as JSON", runJSON},
+	{"fmt", "playlist written back in canonical form", runFmt},
+}
+
+//env - options and output of a run
+type env struct {
+	stdout   io.Writer
+	stderr   io.Writer
+	multiple bool //more than one playlist, output is labelled with the file name
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintf(w, "usage: m3u8 <command> [-parser %v] [-lenient] [file ...]\n", strings.Join(parserList(), "|"))
+	fmt.Fprintf(w, "reads stdin when no file is given\ncommands:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-5v %v\n", cmd.name, cmd.usage)
+	}
+}
+
+//run - returns the exit code
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return exitUsage
+	}
+	var cmd *command
+	for i := range commands {
+		if commands[i].name == args[0] {
+			cmd = &commands[i]
+		}
+	}
+	if cmd == nil {
+		if args[0] != "help" && args[0] != "-h" && args[0] != "-help" {
+			fmt.Fprintf(stderr, "unknown command %v\n", args[0])
+		}
+		usage(stderr)
+		return exitUsage
+	}
+	flags := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	parser := &parserFlag{opt: m3u8reader.M3U8ParserScanner3}
+	flags.Var(parser, "parser", "parser to use : "+strings.Join(parserList(), ", ")+" or the ParserOption value")
+	lenient := flags.Bool("lenient", false, "skip the entries failing to parse and report them")
+	err := flags.Parse(args[1:])
+	if err != nil {
+		return exitUsage
+	}
+	e := &env{stdout: stdout, stderr: stderr, multiple: flags.NArg() > 1}
+	files := flags.Args()
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+	parsers.AttrKVPairsSyncPool = false
+	code := exitOk
+	for _, file := range files {
+		m, err := readPlaylist(file, stdin, parser.opt, *lenient)
+		if err != nil {
+			fmt.Fprintf(stderr, "%v : %v\n", file, err)
+			code = exitFail
+			continue
+		}
+		if cmd.name != "lint" {
+			//lint reports them along with its findings
+			for _, d := range m.Diagnostics {
+				fmt.Fprintf(stderr, "%v : %v\n", file, d)
+			}
+		}
+		ok, err := cmd.run(e, file, m)
+		if err != nil {
+			fmt.Fprintf(stderr, "%v : %v\n", file, err)
+			code = exitFail
+			continue
+		}
+		if !ok {
+			code = exitFail
+		}
+	}
+	return code
+}
+
+//readPlaylist - file "-" is stdin
+func readPlaylist(file string, stdin io.Reader, opt m3u8reader.ParserOption, lenient bool) (m *m3u8reader.M3U8, err error) {
+	src := stdin
+	if file != "-" {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		src = f
+	}
+	m = &m3u8reader.M3U8{}
+	m.SetParserOption(opt)
+	m.SetLenient(lenient)
+	_, err = m.Read(src)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
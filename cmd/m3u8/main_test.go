@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/eswarantg/m3u8reader"
+	"github.com/eswarantg/m3u8reader/common"
+)
+
+var mediaData = strings.Join([]string{
+	"#EXTM3U",
+	"#EXT-X-VERSION:3",
+	"#EXT-X-TARGETDURATION:4",
+	"#EXT-X-MEDIA-SEQUENCE:10",
+	"#EXT-X-PROGRAM-DATE-TIME:2022-01-20T12:16:00.000Z",
+	"#EXTINF:4.0,",
+	"seg10.ts",
+	"#EXTINF:4.5,",
+	"seg11.ts",
+	"",
+}, "\n")
+
+func Test_Run(t *testing.T) {
+	tests := []struct {
+		args     []string
+		data     string
+		code     int
+		contains []string
+	}{
+		{[]string{"dump"}, mediaData, exitOk, []string{"MSN", "11", "2022-01-20T12:16:04Z", "#EXTINF:4.5, seg11.ts"}},
+		{[]string{"dump", "-parser", "yacc"}, mediaData, exitOk, []string{"2022-01-20T12:16:04Z"}},
+		{[]string{"dump", "-parser", "3"}, mediaData, exitOk, []string{"seg10.ts"}},
+		{[]string{"lint"}, mediaData, exitFail, []string{"EXTINF-TARGETDURATION"}},
+		{[]string{"lint"}, strings.Replace(mediaData, "4.5", "4.0", 1), exitOk, nil},
+		{[]string{"lint", "-lenient"}, strings.Replace(mediaData, "4.5", "abc", 1), exitFail, []string{"line 8, col 1", "bad value"}},
+		{[]string{"json"}, mediaData, exitOk, []string{"\"EXT-X-MEDIA-SEQUENCE\"", "\"seg11.ts\""}},
+		{[]string{"fmt"}, mediaData, exitOk, []string{"#EXTM3U\n#EXT-X-VERSION:3\n", "#EXTINF:4.5,\nseg11.ts\n"}},
+		{[]string{"fmt"}, strings.Replace(mediaData, "4.5", "abc", 1), exitFail, nil},
+		{[]string{"dump", "-parser", "none"}, mediaData, exitUsage, nil},
+		{[]string{"unknown"}, mediaData, exitUsage, nil},
+		{nil, mediaData, exitUsage, nil},
+	}
+	for i, test := range tests {
+		var stdout, stderr bytes.Buffer
+		code := run(test.args, strings.NewReader(test.data), &stdout, &stderr)
+		if code != test.code {
+			t.Errorf("%v : %v : exit code expected %v : got %v : %v", i, test.args, test.code, code, stderr.String())
+		}
+		for _, s := range test.contains {
+			if !strings.Contains(stdout.String(), s) {
+				t.Errorf("%v : %v : output expected to contain %q : got %v", i, test.args, s, stdout.String())
+			}
+		}
+	}
+}
+
+func Test_RunDumpNoPDT(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	data := strings.Replace(mediaData, "#EXT-X-PROGRAM-DATE-TIME:2022-01-20T12:16:00.000Z\n", "", 1)
+	code := run([]string{"dump"}, strings.NewReader(data), &stdout, &stderr)
+	if code != exitOk {
+		t.Fatalf("exit code expected %v : got %v : %v", exitOk, code, stderr.String())
+	}
+	//no EXT-X-PROGRAM-DATE-TIME, no date time
+	if !strings.Contains(stdout.String(), "seg11.ts") || strings.Contains(stdout.String(), "0001-01-01") {
+		t.Errorf("dump : got %v", stdout.String())
+	}
+}
+
+func Test_RunJSON(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"json"}, strings.NewReader(mediaData), &stdout, &stderr)
+	if code != exitOk {
+		t.Fatalf("exit code expected %v : got %v : %v", exitOk, code, stderr.String())
+	}
+	m := m3u8reader.M3U8{}
+	err := json.Unmarshal(stdout.Bytes(), &m)
+	if err != nil {
+		t.Fatalf("json : %v", err)
+	}
+	if len(m.Entries) != 7 || m.Entries[6].Tag != common.M3U8ExtInf || m.Entries[6].Values.Get(common.INTMediaSequenceNumber) != int64(11) {
+		t.Errorf("entries : got %v", m.String())
+	}
+}
@@ -31,6 +31,10 @@ var TagNames = [...]string{
 	"EXT-X-SESSION-KEY",
 	"EXT-X-SESSION-DATA",
 	"EXT-X-START",
+	"EXT-X-GAP",
+	"EXT-X-BITRATE",
+	"EXT-X-DEFINE",
+	"EXT-X-CONTENT-STEERING",
 }
 
 //Internal Identification Number of each Tag
@@ -67,6 +71,10 @@ const (
 	M3U8ExtXSesionKey
 	M3U8ExtXSessionData
 	M3U8ExtXStart
+	M3U8ExtXGap
+	M3U8ExtXBitrate
+	M3U8ExtXDefine
+	M3U8ExtXContentSteering
 )
 
 var TagToTagId map[string]TagId = map[string]TagId{
@@ -99,6 +107,10 @@ var TagToTagId map[string]TagId = map[string]TagId{
 	"EXT-X-SESSION-KEY":            M3U8ExtXSesionKey,
 	"EXT-X-SESSION-DATA":           M3U8ExtXSessionData,
 	"EXT-X-START":                  M3U8ExtXStart,
+	"EXT-X-GAP":                    M3U8ExtXGap,
+	"EXT-X-BITRATE":                M3U8ExtXBitrate,
+	"EXT-X-DEFINE":                 M3U8ExtXDefine,
+	"EXT-X-CONTENT-STEERING":       M3U8ExtXContentSteering,
 }
 
 var AttrNames = [...]string{
@@ -159,6 +171,16 @@ var AttrNames = [...]string{
 	"mediaSequenceNumber",
 	"partNumber",
 	"PROGRAM-ID",
+	"GAP",
+	"IMPORT",
+	"QUERYPARAM",
+	"SERVER-URI",
+	"PATHWAY-ID",
+	"HOLD-BACK",
+	"CAN-SKIP-DATERANGES",
+	"RECENTLY-REMOVED-DATERANGES",
+	"VIDEO-RANGE",
+	"SCORE",
 }
 
 //To avoid storing/comparing Attr
@@ -222,6 +244,16 @@ const (
 	INTMediaSequenceNumber
 	INTPartNumber
 	M3U8ProgramId
+	M3U8Gap
+	M3U8Import
+	M3U8QueryParam
+	M3U8ServerUri
+	M3U8PathwayId
+	M3U8HoldBack
+	M3U8CanSkipDateRanges
+	M3U8RecentlyRemovedDateRanges
+	M3U8VideoRange
+	M3U8Score
 )
 
 var AttrToAttrId map[string]AttrId = map[string]AttrId{
@@ -282,4 +314,16 @@ var AttrToAttrId map[string]AttrId = map[string]AttrId{
 	"mediaSequenceNumber": INTMediaSequenceNumber,
 	"partNumber":          INTPartNumber,
 	"PROGRAM-ID":          M3U8ProgramId,
+	"GAP":                 M3U8Gap,
+	"IMPORT":              M3U8Import,
+	"QUERYPARAM":          M3U8QueryParam,
+	"SERVER-URI":          M3U8ServerUri,
+	"PATHWAY-ID":          M3U8PathwayId,
+	//EXT-X-SERVER-CONTROL and EXT-X-SKIP
+	"HOLD-BACK":                   M3U8HoldBack,
+	"CAN-SKIP-DATERANGES":         M3U8CanSkipDateRanges,
+	"RECENTLY-REMOVED-DATERANGES": M3U8RecentlyRemovedDateRanges,
+	//EXT-X-STREAM-INF and EXT-X-I-FRAME-STREAM-INF
+	"VIDEO-RANGE": M3U8VideoRange,
+	"SCORE":       M3U8Score,
 }
@@ -0,0 +1,36 @@
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//Resolution - decimal-resolution value <WIDTH>x<HEIGHT>
+type Resolution struct {
+	Width  int64
+	Height int64
+}
+
+func (r Resolution) String() string {
+	return fmt.Sprintf("%vx%v", r.Width, r.Height)
+}
+
+//ParseResolution - parses decimal-resolution <WIDTH>x<HEIGHT>
+func ParseResolution(s string) (r Resolution, err error) {
+	pos := strings.IndexByte(s, 'x')
+	if pos <= 0 {
+		err = fmt.Errorf("invalid resolution \"%v\" expected <WIDTH>x<HEIGHT>", s)
+		return
+	}
+	r.Width, err = strconv.ParseInt(s[:pos], 10, 64)
+	if err != nil {
+		err = fmt.Errorf("invalid resolution width \"%v\" : %w", s, err)
+		return
+	}
+	r.Height, err = strconv.ParseInt(s[pos+1:], 10, 64)
+	if err != nil {
+		err = fmt.Errorf("invalid resolution height \"%v\" : %w", s, err)
+	}
+	return
+}
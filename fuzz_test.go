@@ -0,0 +1,45 @@
+//go:build go1.18
+
+package m3u8reader_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/eswarantg/m3u8reader"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+//FuzzM3U8 - parsing and building the playlists is to return an error and not panic on any input
+func FuzzM3U8(f *testing.F) {
+	files, err := filepath.Glob("test/*.m3u8")
+	if err != nil || len(files) == 0 {
+		f.Fatalf("no test files : %v", err)
+	}
+	for i, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			f.Fatalf("%v : %v", file, err)
+		}
+		f.Add(data, uint8(i%5), i%2 == 0, i%3 == 0)
+	}
+	parsers.AttrKVPairsSyncPool = false
+	f.Fuzz(func(t *testing.T, data []byte, opt uint8, lossless bool, lenient bool) {
+		manifest := m3u8reader.M3U8{}
+		manifest.SetParserOption(m3u8reader.ParserOption(opt % 5))
+		manifest.SetLossless(lossless)
+		manifest.SetLenient(lenient)
+		_, err := manifest.ParseData(data)
+		if err != nil {
+			return
+		}
+		_ = manifest.String()
+		if manifest.IsMasterPlaylist() {
+			manifest.MasterPlaylist()
+			return
+		}
+		manifest.MediaPlaylist()
+		manifest.Segments()
+	})
+}
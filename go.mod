@@ -1,3 +1,5 @@
 module github.com/eswarantg/m3u8reader
 
 go 1.17
+
+require gopkg.in/yaml.v3 v3.0.1
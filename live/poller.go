@@ -0,0 +1,230 @@
+package live
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/eswarantg/m3u8reader"
+)
+
+//DefaultMaxBackoff - cap on the reload interval after consecutive errors
+const DefaultMaxBackoff = 30 * time.Second
+
+//errorBackoffBase - first retry interval when the target duration is not yet known
+const errorBackoffBase = time.Second
+
+//ReloadInterval - wait before the next reload, measured from the start of the last load
+//RFC 8216 section 6.3.4 : target duration if the playlist changed, half of it if not
+//On errors half the target duration is doubled for every consecutive error, up to maxBackoff
+func ReloadInterval(targetDuration time.Duration, changed bool, errors int, maxBackoff time.Duration) time.Duration {
+	if errors == 0 {
+		if changed {
+			return targetDuration
+		}
+		return targetDuration / 2
+	}
+	wait := targetDuration / 2
+	if wait <= 0 {
+		wait = errorBackoffBase
+	}
+	for i := 1; i < errors && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxBackoff {
+		wait = maxBackoff
+	}
+	return wait
+}
+
+//Poller - reloads a live Media Playlist and emits the segments added
+type Poller struct {
+	HTTPClient   *http.Client
+	ParserOption m3u8reader.ParserOption
+	//MaxBackoff - DefaultMaxBackoff if 0
+	MaxBackoff time.Duration
+	//MaxErrors - Run returns after so many consecutive errors, 0 to retry for ever
+	MaxErrors int
+	//OnError - called for every reload failing, Run goes on with backoff
+	OnError func(err error)
+	//Blocking - reload right away with _HLS_msn/_HLS_part if the playlist has CAN-BLOCK-RELOAD=YES
+	Blocking bool
+
+	uri      string
+	segments chan m3u8reader.Segment
+	after    func(d time.Duration) <-chan time.Time
+
+	mu             sync.Mutex
+	playlist       *m3u8reader.M3U8
+	body           []byte
+	targetDuration time.Duration
+	nextSequence   int64 //first Media Sequence Number not yet emitted, -1 before the first load
+}
+
+//NewPoller - playlistURL is the absolute URL of the Media Playlist
+func NewPoller(playlistURL string) (*Poller, error) {
+	u, err := url.Parse(playlistURL)
+	if err != nil {
+		return nil, err
+	}
+	if !u.IsAbs() {
+		return nil, fmt.Errorf("playlist URL %v is not absolute", playlistURL)
+	}
+	return &Poller{
+		uri:          u.String(),
+		segments:     make(chan m3u8reader.Segment),
+		after:        time.After,
+		nextSequence: -1,
+	}, nil
+}
+
+//Segments - segments in the order of their Media Sequence Number, closed when Run returns
+//All the segments of the first load are emitted, only the ones added after
+//Run waits for the segments to be received
+func (p *Poller) Segments() <-chan m3u8reader.Segment {
+	return p.segments
+}
+
+//Playlist - last playlist loaded, nil if not yet loaded
+func (p *Poller) Playlist() *m3u8reader.M3U8 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.playlist
+}
+
+//TargetDuration - EXT-X-TARGETDURATION of the last playlist loaded
+func (p *Poller) TargetDuration() time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.targetDuration
+}
+
+//fetch - body of the playlist and its URL after any redirect
+func (p *Poller) fetch(ctx context.Context, reqURL string) (body []byte, base *url.URL, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	httpClient := p.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("playlist %v : status %v", reqURL, resp.Status)
+	}
+	body, err = io.ReadAll(resp.Body)
+	return body, resp.Request.URL, err
+}
+
+//load - fetches the playlist and emits the new segments
+//changed is false if the playlist is the same as the last one loaded
+func (p *Poller) load(ctx context.Context, reqURL string) (changed bool, ended bool, err error) {
+	body, base, err := p.fetch(ctx, reqURL)
+	if err != nil {
+		return
+	}
+	p.mu.Lock()
+	changed = !bytes.Equal(body, p.body)
+	p.mu.Unlock()
+	if !changed {
+		return
+	}
+	m := &m3u8reader.M3U8{}
+	m.SetParserOption(p.ParserOption)
+	m.SetBaseURL(base)
+	_, err = m.ParseData(body)
+	if err != nil {
+		return false, false, fmt.Errorf("playlist %v : %w", p.uri, err)
+	}
+	if m.IsMasterPlaylist() {
+		return false, false, fmt.Errorf("playlist %v : not a Media Playlist", p.uri)
+	}
+	media, err := m.MediaPlaylist()
+	if err != nil {
+		return false, false, fmt.Errorf("playlist %v : %w", p.uri, err)
+	}
+	p.mu.Lock()
+	p.playlist, p.body, p.targetDuration = m, body, media.TargetDuration
+	next := p.nextSequence
+	p.mu.Unlock()
+	for _, seg := range media.Segments {
+		if next >= 0 && seg.SequenceNumber < next {
+			continue
+		}
+		select {
+		case p.segments <- seg:
+		case <-ctx.Done():
+			return true, false, ctx.Err()
+		}
+		next = seg.SequenceNumber + 1
+		p.mu.Lock()
+		p.nextSequence = next
+		p.mu.Unlock()
+	}
+	return true, media.EndList, nil
+}
+
+//nextBlocking - blocking reload of the last playlist loaded, false if the server doesn't support it
+func (p *Poller) nextBlocking() (reqURL string, ok bool) {
+	reload, err := NextReload(p.uri, p.Playlist(), 0, false)
+	if err != nil || !reload.Blocking {
+		return
+	}
+	return reload.URL, true
+}
+
+//Run - loads the playlist and reloads it till EXT-X-ENDLIST or ctx is done
+//Returns nil on EXT-X-ENDLIST, the last error if MaxErrors is reached, ctx.Err() otherwise
+func (p *Poller) Run(ctx context.Context) error {
+	defer close(p.segments)
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultMaxBackoff
+	}
+	errors := 0
+	reqURL := p.uri
+	for {
+		start := time.Now()
+		changed, ended, err := p.load(ctx, reqURL)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			errors++
+			if p.OnError != nil {
+				p.OnError(err)
+			}
+			if p.MaxErrors > 0 && errors >= p.MaxErrors {
+				return err
+			}
+		} else {
+			errors = 0
+		}
+		if ended {
+			return nil
+		}
+		if p.Blocking && changed {
+			//the server holds the request till the next part, no need to wait
+			if next, ok := p.nextBlocking(); ok {
+				reqURL = next
+				continue
+			}
+		}
+		wait := ReloadInterval(p.TargetDuration(), changed, errors, maxBackoff) - time.Since(start)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-p.after(wait):
+		}
+	}
+}
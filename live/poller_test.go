@@ -0,0 +1,223 @@
+package live
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+func Test_ReloadInterval(t *testing.T) {
+	tests := []struct {
+		targetDuration time.Duration
+		changed        bool
+		errors         int
+		expected       time.Duration
+	}{
+		{6 * time.Second, true, 0, 6 * time.Second},
+		{6 * time.Second, false, 0, 3 * time.Second},
+		{6 * time.Second, false, 1, 3 * time.Second},
+		{6 * time.Second, false, 2, 6 * time.Second},
+		{6 * time.Second, false, 3, 12 * time.Second},
+		{6 * time.Second, false, 10, 30 * time.Second},
+		{0, false, 1, time.Second},
+		{0, false, 3, 4 * time.Second},
+	}
+	for i, test := range tests {
+		got := ReloadInterval(test.targetDuration, test.changed, test.errors, DefaultMaxBackoff)
+		if got != test.expected {
+			t.Errorf("%v : interval expected %v : got %v", i, test.expected, got)
+		}
+	}
+}
+
+//livePlaylist - media playlist with the segments first to last
+func livePlaylist(first int, last int, ended bool) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "#EXTM3U\n#EXT-X-VERSION:3\n#EXT-X-TARGETDURATION:4\n#EXT-X-MEDIA-SEQUENCE:%v\n", first)
+	for i := first; i <= last; i++ {
+		fmt.Fprintf(&sb, "#EXTINF:4.0,\nseg%v.ts\n", i)
+	}
+	if ended {
+		sb.WriteString("#EXT-X-ENDLIST\n")
+	}
+	return sb.String()
+}
+
+//origin - serves the responses in order, the last one for ever
+//an empty response is served as an error
+func origin(responses []string) *httptest.Server {
+	var mu sync.Mutex
+	n := 0
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		resp := responses[n]
+		if n < len(responses)-1 {
+			n++
+		}
+		mu.Unlock()
+		if resp == "" {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, resp)
+	}))
+}
+
+//recordWaits - the poller doesn't wait, the intervals are recorded instead
+func recordWaits(p *Poller) *[]time.Duration {
+	var waits []time.Duration
+	p.after = func(d time.Duration) <-chan time.Time {
+		waits = append(waits, d)
+		ch := make(chan time.Time, 1)
+		ch <- time.Now()
+		return ch
+	}
+	return &waits
+}
+
+func checkWaits(t *testing.T, name string, expected []time.Duration, got []time.Duration) {
+	if len(got) != len(expected) {
+		t.Errorf("%v : waits expected %v : got %v", name, expected, got)
+		return
+	}
+	for i := range expected {
+		//less the time taken by the load
+		if got[i] > expected[i] || got[i] < expected[i]-time.Second {
+			t.Errorf("%v : %v : wait expected %v : got %v", name, i, expected[i], got[i])
+		}
+	}
+}
+
+func Test_Poller(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	server := origin([]string{
+		livePlaylist(0, 2, false),
+		livePlaylist(0, 2, false),
+		livePlaylist(1, 3, false),
+		"",
+		livePlaylist(2, 4, true),
+	})
+	defer server.Close()
+	p, err := NewPoller(server.URL + "/live/index.m3u8")
+	if err != nil {
+		t.Fatalf("NewPoller : %v", err)
+	}
+	p.HTTPClient = server.Client()
+	var errs []error
+	p.OnError = func(err error) {
+		errs = append(errs, err)
+	}
+	waits := recordWaits(p)
+	var uris []string
+	done := make(chan error)
+	go func() {
+		done <- p.Run(context.Background())
+	}()
+	for seg := range p.Segments() {
+		uris = append(uris, fmt.Sprintf("%v:%v", seg.SequenceNumber, seg.URI))
+	}
+	err = <-done
+	if err != nil {
+		t.Errorf("Run : %v", err)
+	}
+	expected := "[0:seg0.ts 1:seg1.ts 2:seg2.ts 3:seg3.ts 4:seg4.ts]"
+	if fmt.Sprint(uris) != expected {
+		t.Errorf("segments expected %v : got %v", expected, uris)
+	}
+	if len(errs) != 1 {
+		t.Errorf("errors expected 1 : got %v", errs)
+	}
+	checkWaits(t, "poller", []time.Duration{4 * time.Second, 2 * time.Second, 4 * time.Second, 2 * time.Second}, *waits)
+	if p.TargetDuration() != 4*time.Second || len(p.Playlist().Entries) == 0 {
+		t.Errorf("playlist : got %v %v", p.TargetDuration(), p.Playlist())
+	}
+}
+
+func Test_PollerErrors(t *testing.T) {
+	server := origin([]string{""})
+	defer server.Close()
+	p, err := NewPoller(server.URL + "/index.m3u8")
+	if err != nil {
+		t.Fatalf("NewPoller : %v", err)
+	}
+	p.HTTPClient = server.Client()
+	p.MaxErrors = 3
+	waits := recordWaits(p)
+	go func() {
+		for range p.Segments() {
+		}
+	}()
+	err = p.Run(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "503") {
+		t.Errorf("status error expected : got %v", err)
+	}
+	checkWaits(t, "errors", []time.Duration{time.Second, 2 * time.Second}, *waits)
+
+	_, err = NewPoller("/relative/index.m3u8")
+	if err == nil {
+		t.Errorf("relative URL : error expected")
+	}
+}
+
+func Test_PollerCancel(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	server := origin([]string{livePlaylist(0, 2, false)})
+	defer server.Close()
+	p, err := NewPoller(server.URL + "/index.m3u8")
+	if err != nil {
+		t.Fatalf("NewPoller : %v", err)
+	}
+	p.HTTPClient = server.Client()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- p.Run(ctx)
+	}()
+	<-p.Segments()
+	cancel()
+	for range p.Segments() {
+	}
+	if err = <-done; err != context.Canceled {
+		t.Errorf("cancel : expected %v : got %v", context.Canceled, err)
+	}
+}
+
+func Test_PollerRedirect(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	mux := http.NewServeMux()
+	mux.HandleFunc("/live/index.m3u8", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/edge/live/index.m3u8", http.StatusFound)
+	})
+	mux.HandleFunc("/edge/live/index.m3u8", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, livePlaylist(0, 2, true))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	p, err := NewPoller(server.URL + "/live/index.m3u8")
+	if err != nil {
+		t.Fatalf("NewPoller : %v", err)
+	}
+	p.HTTPClient = server.Client()
+	go func() {
+		for range p.Segments() {
+		}
+	}()
+	err = p.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run : %v", err)
+	}
+	//relative URIs are resolved against the URL after the redirect
+	m := p.Playlist()
+	u, err := m.ResolveURI("seg0.ts")
+	expected := server.URL + "/edge/live/seg0.ts"
+	if err != nil || u.String() != expected {
+		t.Errorf("redirect : expected %v : got %v %v", expected, u, err)
+	}
+}
@@ -0,0 +1,79 @@
+package live
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/eswarantg/m3u8reader"
+)
+
+//Delivery Directives of the playlist request
+const (
+	QueryMSN  = "_HLS_msn"
+	QueryPart = "_HLS_part"
+	QuerySkip = "_HLS_skip"
+)
+
+//Values of _HLS_skip
+const (
+	SkipSegments   = "YES" //skip the older segments
+	SkipDateRanges = "v2"  //skip the older segments and the EXT-X-DATERANGE tags already received
+)
+
+//Reload - next request of a Low-Latency playlist
+type Reload struct {
+	URL      string
+	Blocking bool   //_HLS_msn is present, the server holds the response till the playlist has it
+	MSN      int64  //_HLS_msn if Blocking
+	Part     int64  //_HLS_part if Blocking, -1 if not requested
+	Skip     string //_HLS_skip, empty if a full playlist is requested
+}
+
+//NextReload - request following the playlist m loaded from playlistURL
+//Directives already in playlistURL are replaced, other query parameters are kept
+//If CAN-BLOCK-RELOAD=YES, _HLS_msn and _HLS_part ask for the segment or part following the last one of m
+//If delta is true, _HLS_skip asks for a Playlist Delta Update when CAN-SKIP-UNTIL is present
+//and m was loaded (age) within half of it, v2 if CAN-SKIP-DATERANGES=YES
+func NextReload(playlistURL string, m *m3u8reader.M3U8, age time.Duration, delta bool) (reload Reload, err error) {
+	u, err := url.Parse(playlistURL)
+	if err != nil {
+		return
+	}
+	if m.IsMasterPlaylist() {
+		err = fmt.Errorf("playlist %v : not a Media Playlist", playlistURL)
+		return
+	}
+	media, err := m.MediaPlaylist()
+	if err != nil {
+		return
+	}
+	q := u.Query()
+	q.Del(QueryMSN)
+	q.Del(QueryPart)
+	q.Del(QuerySkip)
+	reload.Part = -1
+	sc := media.ServerControl
+	if sc != nil && sc.CanBlockReload {
+		reload.Blocking = true
+		msn, part := m.NextPart()
+		reload.MSN = msn
+		q.Set(QueryMSN, strconv.FormatInt(msn, 10))
+		if media.PartTarget > 0 {
+			reload.Part = part
+			q.Set(QueryPart, strconv.FormatInt(part, 10))
+		}
+	}
+	if delta && sc != nil && sc.CanSkipUntil > 0 && age >= 0 && age <= sc.CanSkipUntil/2 {
+		reload.Skip = SkipSegments
+		if sc.CanSkipDateRanges {
+			reload.Skip = SkipDateRanges
+		}
+		q.Set(QuerySkip, reload.Skip)
+	}
+	//Encode sorts the parameters, as recommended for the cache of the responses
+	u.RawQuery = q.Encode()
+	reload.URL = u.String()
+	return
+}
@@ -0,0 +1,252 @@
+package live
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/eswarantg/m3u8reader"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+const llPartsPerSegment = 4
+
+//llPlaylist - LL-HLS media playlist once n parts are produced
+//the last 3 complete segments are listed, with the parts of the last one and of the one in progress
+func llPlaylist(n int, serverControl string, ended bool) string {
+	complete, partial := n/llPartsPerSegment, n%llPartsPerSegment
+	first := complete - 3
+	if first < 0 {
+		first = 0
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "#EXTM3U\n#EXT-X-VERSION:9\n#EXT-X-TARGETDURATION:4\n%v\n#EXT-X-PART-INF:PART-TARGET=1\n", serverControl)
+	fmt.Fprintf(&sb, "#EXT-X-MEDIA-SEQUENCE:%v\n#EXT-X-MAP:URI=\"init.mp4\"\n", first)
+	writeParts := func(msn int, count int) {
+		for i := 0; i < count; i++ {
+			fmt.Fprintf(&sb, "#EXT-X-PART:DURATION=1.0,URI=\"seg%v.p%v.mp4\"\n", msn, i)
+		}
+	}
+	for msn := first; msn < complete; msn++ {
+		if msn == complete-1 {
+			writeParts(msn, llPartsPerSegment)
+		}
+		fmt.Fprintf(&sb, "#EXTINF:4.0,\nseg%v.mp4\n", msn)
+	}
+	writeParts(complete, partial)
+	if ended {
+		sb.WriteString("#EXT-X-ENDLIST\n")
+	} else {
+		fmt.Fprintf(&sb, "#EXT-X-PRELOAD-HINT:TYPE=PART,URI=\"seg%v.p%v.mp4\"\n", complete, partial)
+	}
+	return sb.String()
+}
+
+const llServerControl = "#EXT-X-SERVER-CONTROL:CAN-BLOCK-RELOAD=YES,PART-HOLD-BACK=3,CAN-SKIP-UNTIL=24"
+
+//parseString - as in the tests of m3u8reader
+func parseString(t *testing.T, data string) *m3u8reader.M3U8 {
+	parsers.AttrKVPairsSyncPool = false
+	manifest := &m3u8reader.M3U8{}
+	manifest.SetBuffer(make([]byte, 4096))
+	_, err := manifest.ParseData([]byte(data))
+	if err != nil {
+		t.Fatalf("ParseData : %v", err)
+	}
+	return manifest
+}
+
+func Test_NextReload(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	tests := []struct {
+		name     string
+		url      string
+		playlist string
+		age      time.Duration
+		delta    bool
+		expected string
+	}{
+		{"part", "https://origin/live.m3u8", llPlaylist(22, llServerControl, false), 0, false,
+			"https://origin/live.m3u8?_HLS_msn=5&_HLS_part=2"},
+		{"segment end", "https://origin/live.m3u8", llPlaylist(20, llServerControl, false), 0, false,
+			"https://origin/live.m3u8?_HLS_msn=5&_HLS_part=0"},
+		{"no parts", "https://origin/live.m3u8",
+			"#EXTM3U\n#EXT-X-TARGETDURATION:4\n#EXT-X-SERVER-CONTROL:CAN-BLOCK-RELOAD=YES\n#EXT-X-MEDIA-SEQUENCE:7\n#EXTINF:4.0,\nseg7.ts\n",
+			0, false, "https://origin/live.m3u8?_HLS_msn=8"},
+		{"no blocking", "https://origin/live.m3u8", llPlaylist(22, "#EXT-X-SERVER-CONTROL:CAN-SKIP-UNTIL=24", false), 0, true,
+			"https://origin/live.m3u8?_HLS_skip=YES"},
+		{"skip", "https://origin/live.m3u8", llPlaylist(22, llServerControl, false), 12 * time.Second, true,
+			"https://origin/live.m3u8?_HLS_msn=5&_HLS_part=2&_HLS_skip=YES"},
+		{"skip date ranges", "https://origin/live.m3u8",
+			llPlaylist(22, llServerControl+",CAN-SKIP-DATERANGES=YES", false), time.Second, true,
+			"https://origin/live.m3u8?_HLS_msn=5&_HLS_part=2&_HLS_skip=v2"},
+		{"too old to skip", "https://origin/live.m3u8", llPlaylist(22, llServerControl, false), 13 * time.Second, true,
+			"https://origin/live.m3u8?_HLS_msn=5&_HLS_part=2"},
+		{"no delta", "https://origin/live.m3u8", llPlaylist(22, llServerControl, false), 0, false,
+			"https://origin/live.m3u8?_HLS_msn=5&_HLS_part=2"},
+		{"query kept", "https://origin/live.m3u8?token=abc&_HLS_msn=1&_HLS_part=3&_HLS_skip=YES",
+			llPlaylist(22, llServerControl, false), 0, false,
+			"https://origin/live.m3u8?_HLS_msn=5&_HLS_part=2&token=abc"},
+		{"not live", "https://origin/live.m3u8", livePlaylist(0, 2, false), 0, true,
+			"https://origin/live.m3u8"},
+	}
+	for _, test := range tests {
+		m := parseString(t, test.playlist)
+		reload, err := NextReload(test.url, m, test.age, test.delta)
+		if err != nil {
+			t.Errorf("%v : NextReload : %v", test.name, err)
+			continue
+		}
+		if reload.URL != test.expected {
+			t.Errorf("%v : URL expected %v : got %v", test.name, test.expected, reload.URL)
+		}
+		if reload.Blocking != strings.Contains(test.expected, QueryMSN) {
+			t.Errorf("%v : blocking expected %v : got %v", test.name, !reload.Blocking, reload.Blocking)
+		}
+	}
+
+	m := parseString(t, "#EXTM3U\n#EXT-X-STREAM-INF:BANDWIDTH=1000\nlow.m3u8\n")
+	_, err := NextReload("https://origin/master.m3u8", m, 0, false)
+	if err == nil {
+		t.Errorf("master playlist : error expected")
+	}
+}
+
+//llOrigin - simulated LL-HLS origin producing the parts as they are requested
+//A blocking request is held till the part asked for is produced, here it is produced right away
+//The playlist ends once total parts are produced
+type llOrigin struct {
+	mu       sync.Mutex
+	produced int
+	total    int
+	requests []string //_HLS_msn/_HLS_part of the requests, "-" for the ones not blocking
+}
+
+func (o *llOrigin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if q.Get(QueryMSN) == "" {
+		if q.Get(QueryPart) != "" {
+			http.Error(w, "_HLS_part without _HLS_msn", http.StatusBadRequest)
+			return
+		}
+		o.requests = append(o.requests, "-")
+		o.produced++
+	} else {
+		msn, err := strconv.Atoi(q.Get(QueryMSN))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		part := llPartsPerSegment - 1 //whole segment
+		if q.Get(QueryPart) != "" {
+			part, err = strconv.Atoi(q.Get(QueryPart))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+		o.requests = append(o.requests, fmt.Sprintf("%v.%v", msn, part))
+		want := msn*llPartsPerSegment + part + 1
+		if want > o.produced+1 {
+			//too far in the future
+			http.Error(w, "_HLS_msn out of range", http.StatusBadRequest)
+			return
+		}
+		if want > o.produced {
+			o.produced = want
+		}
+	}
+	if o.produced > o.total {
+		o.produced = o.total
+	}
+	fmt.Fprint(w, llPlaylist(o.produced, llServerControl, o.produced == o.total))
+}
+
+func Test_PollerBlocking(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	o := &llOrigin{produced: 10, total: 17}
+	server := httptest.NewServer(o)
+	defer server.Close()
+	p, err := NewPoller(server.URL + "/live.m3u8")
+	if err != nil {
+		t.Fatalf("NewPoller : %v", err)
+	}
+	p.HTTPClient = server.Client()
+	p.Blocking = true
+	p.MaxErrors = 1
+	waits := recordWaits(p)
+	var uris []string
+	done := make(chan error)
+	go func() {
+		done <- p.Run(context.Background())
+	}()
+	for seg := range p.Segments() {
+		uris = append(uris, fmt.Sprintf("%v:%v", seg.SequenceNumber, seg.URI))
+	}
+	err = <-done
+	if err != nil {
+		t.Errorf("Run : %v", err)
+	}
+	expected := "[0:seg0.mp4 1:seg1.mp4 2:seg2.mp4 3:seg3.mp4]"
+	if fmt.Sprint(uris) != expected {
+		t.Errorf("segments expected %v : got %v", expected, uris)
+	}
+	//one request per part, from the part following the first playlist
+	expected = "[- 2.3 3.0 3.1 3.2 3.3 4.0]"
+	if fmt.Sprint(o.requests) != expected {
+		t.Errorf("requests expected %v : got %v", expected, o.requests)
+	}
+	if len(*waits) != 0 {
+		t.Errorf("waits expected none : got %v", *waits)
+	}
+}
+
+func Test_PollerNotBlocking(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	server := origin([]string{livePlaylist(0, 2, false), livePlaylist(0, 3, true)})
+	defer server.Close()
+	var queries []string
+	var mu sync.Mutex
+	client := server.Client()
+	transport := client.Transport
+	client.Transport = roundTripper(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		queries = append(queries, req.URL.RawQuery)
+		mu.Unlock()
+		return transport.RoundTrip(req)
+	})
+	p, err := NewPoller(server.URL + "/live.m3u8")
+	if err != nil {
+		t.Fatalf("NewPoller : %v", err)
+	}
+	p.HTTPClient = client
+	p.Blocking = true
+	waits := recordWaits(p)
+	go func() {
+		for range p.Segments() {
+		}
+	}()
+	err = p.Run(context.Background())
+	if err != nil {
+		t.Errorf("Run : %v", err)
+	}
+	//no CAN-BLOCK-RELOAD, the playlist is reloaded as usual
+	if fmt.Sprint(queries) != "[ ]" {
+		t.Errorf("queries expected none : got %q", queries)
+	}
+	checkWaits(t, "not blocking", []time.Duration{4 * time.Second}, *waits)
+}
+
+type roundTripper func(req *http.Request) (*http.Response, error)
+
+func (f roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -1,8 +1,10 @@
 package m3u8reader
 
 import (
+	"bufio"
 	"fmt"
 	"io"
+	"net/url"
 	"time"
 
 	"github.com/eswarantg/m3u8reader/common"
@@ -36,6 +38,11 @@ type M3U8 struct {
 	nextPartNumber          int64
 	parserOption            ParserOption
 	buffer                  []byte
+	lossless                bool
+	lenient                 bool
+	Diagnostics             []Diagnostic //problems found in lenient mode
+	baseURL                 *url.URL
+	queryInherit            QueryInherit
 }
 
 func (m *M3U8) Done() {
@@ -51,6 +58,14 @@ func (m *M3U8) SetBuffer(buffer []byte) {
 	m.buffer = buffer
 }
 
+//getBuffer - buffer set by SetBuffer, allocated if not set
+func (m *M3U8) getBuffer() []byte {
+	if len(m.buffer) == 0 {
+		m.buffer = make([]byte, bufio.MaxScanTokenSize)
+	}
+	return m.buffer
+}
+
 func (m *M3U8) String() string {
 	toret := ""
 	for _, entry := range m.Entries {
@@ -83,6 +98,12 @@ func (m *M3U8) LastPartTime() time.Time {
 	return m.lastPartWCTime
 }
 
+//NextPart - Media Sequence Number and Part Number following the last segment or part of the playlist
+//These are the _HLS_msn and _HLS_part of the next blocking playlist reload
+func (m *M3U8) NextPart() (msn int64, part int64) {
+	return m.nextMediaSequenceNumber, m.nextPartNumber
+}
+
 func (m *M3U8) Init() {
 	m.Entries = make([]M3U8Entry, 0, 30)
 	m.MediaSequenceNumber = 0
@@ -90,6 +111,12 @@ func (m *M3U8) Init() {
 	m.lastEntryWCTime = time.Time{}
 	m.preloadHintEntry = nil
 	m.lastPartWCTime = time.Time{}
+	m.lastPartEntry = nil
+	m.targetDuration = 0
+	m.partTarget = 0
+	m.nextMediaSequenceNumber = 0
+	m.nextPartNumber = 0
+	m.Diagnostics = nil
 }
 func (m *M3U8) getParser() parsers.Parser {
 	switch m.parserOption {
@@ -108,15 +135,31 @@ func (m *M3U8) getParser() parsers.Parser {
 
 func (m *M3U8) ParseData(data []byte) (n int, err error) {
 	m.Init()
+	if m.lossless {
+		return m.parseLossless(data)
+	}
+	if m.lenient {
+		return m.parseLenient(data)
+	}
 	p := m.getParser()
-	n, err = p.ParseData(data, m, m.buffer)
+	n, err = p.ParseData(data, m, m.getBuffer())
 	return
 }
 
 func (m *M3U8) Read(src io.Reader) (n int, err error) {
+	if m.lossless || m.lenient {
+		//records are parsed again from the data, read all of it
+		var data []byte
+		data, err = io.ReadAll(src)
+		if err != nil {
+			m.Init()
+			return len(data), err
+		}
+		return m.ParseData(data)
+	}
 	m.Init()
 	p := m.getParser()
-	n, err = p.Parse(src, m, m.buffer)
+	n, err = p.Parse(src, m, m.getBuffer())
 	return
 }
 
@@ -192,33 +235,46 @@ func (m *M3U8) PostRecord(tag common.TagId, kvpairs *parsers.AttrKVPairs) error
 	return m.postRecordEntry(entry)
 }
 
+//GetVideoMediaPlaylist - EXT-X-STREAM-INF with the highest BANDWIDTH not above maxBitRateBps, nil if none
+//Deprecated: use MasterPlaylist().SelectVariants which also takes AVERAGE-BANDWIDTH, CODECS, RESOLUTION,
+//FRAME-RATE, HDCP-LEVEL, VIDEO-RANGE and SCORE into account
 func (m *M3U8) GetVideoMediaPlaylist(maxBitRateBps int64) (toret *M3U8Entry, err error) {
-	toret = nil
-	var entryObj M3U8Entry
+	master, err := m.MasterPlaylist()
+	if err != nil {
+		return nil, err
+	}
 	curSelectBW := int64(-1)
-	for _, entry := range m.Entries {
-		if entry.Tag == common.M3U8ExtXStreamInf {
-			entryBW := entry.Values.Get(common.M3U8Bandwidth).(int64)
-			if entryBW <= maxBitRateBps && entryBW > curSelectBW {
-				entryObj = entry
-				toret = &entryObj
-				curSelectBW = entryBW
-			}
+	for _, v := range master.Variants {
+		if v.Bandwidth <= maxBitRateBps && v.Bandwidth > curSelectBW {
+			toret = v.Entry
+			curSelectBW = v.Bandwidth
 		}
 	}
-	return toret, err
+	return toret, nil
 }
 
+//GetAudioMediaPlaylist - AUDIO rendition of the Variant with the language best matching lang, nil if none
+//Deprecated: use MasterPlaylist().SelectRendition which also honours DEFAULT, AUTOSELECT and CHARACTERISTICS
 func (m *M3U8) GetAudioMediaPlaylist(vidEntry M3U8Entry, lang string) (toret *M3U8Entry, err error) {
-	toret = nil
-	for _, entry := range m.Entries {
-		if entry.Tag == common.M3U8ExtXMedia {
-			if lang == entry.Values.Get(common.M3U8Language).(string) {
-				if vidEntry.Values.Get(common.M3U8Audio).(string) == entry.Values.Get(common.M3U8GroupId).(string) {
-					toret = &entry
-					break
-				}
-			}
+	if vidEntry.Tag != common.M3U8ExtXStreamInf || vidEntry.Values == nil {
+		return nil, fmt.Errorf("not an EXT-X-STREAM-INF")
+	}
+	master, err := m.MasterPlaylist()
+	if err != nil {
+		return nil, err
+	}
+	r := newAttrReader(&vidEntry)
+	groupId := r.str(common.M3U8Audio)
+	if r.err != nil {
+		return nil, r.err
+	}
+	best := -1
+	for _, rendition := range master.Renditions {
+		if rendition.Type != MediaTypeAudio || rendition.GroupId != groupId || groupId == "" {
+			continue
+		}
+		if match := languageMatch(lang, rendition.Language); match >= 0 && (best < 0 || match < best) {
+			toret, best = rendition.Entry, match
 		}
 	}
 	return
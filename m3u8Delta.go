@@ -0,0 +1,402 @@
+package m3u8reader
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+//isSegmentTag - tags applying to the segments, copied along with the skipped segments
+func isSegmentTag(tag common.TagId) bool {
+	switch tag {
+	case common.M3U8ExtInf, common.M3U8ExtXByteRange, common.M3U8ExtXDiscontinuity, common.M3U8ExtXKey,
+		common.M3U8ExtXMap, common.M3U8ExtXIProgramDateTime, common.M3U8ExtXGap, common.M3U8ExtXBitrate,
+		common.M3U8ExtXPart, common.M3U8ExtXDataRange:
+		return true
+	}
+	return false
+}
+
+//clone - entry with its own Values, computed values are set again when posted
+func (m *M3U8Entry) clone() M3U8Entry {
+	entry := M3U8Entry{Tag: m.Tag, Values: parsers.NewAttrKVPairs()}
+	for k, v := range m.Values.Map() {
+		entry.Values.Store(k, v)
+	}
+	if m.Raw != nil {
+		entry.Raw = append([]byte(nil), m.Raw...)
+		entry.rawValues = m.rawValues
+	}
+	if m.AttrOrder != nil {
+		entry.AttrOrder = append([]common.AttrId(nil), m.AttrOrder...)
+	}
+	return entry
+}
+
+func keyFormatOf(entry *M3U8Entry) string {
+	if format, ok := entry.Values.Get(common.M3U8KeyFormat).(string); ok && format != "" {
+		return format
+	}
+	return defaultKeyFormat
+}
+
+func dateRangeId(entry *M3U8Entry) string {
+	id, _ := entry.Values.Get(common.M3U8Id).(string)
+	return id
+}
+
+//deltaState - EXT-X-KEY and EXT-X-MAP in effect at an entry of the previous playlist
+type deltaState struct {
+	keys   []*M3U8Entry
+	curMap *M3U8Entry
+	pdt    bool //EXT-X-PROGRAM-DATE-TIME seen, the program date time of the segments is computed otherwise
+}
+
+func (s *deltaState) post(entry *M3U8Entry) {
+	switch entry.Tag {
+	case common.M3U8ExtXKey:
+		if method, _ := entry.Values.Get(common.M3U8Method).(string); method == "NONE" {
+			s.keys = nil
+			return
+		}
+		keys := make([]*M3U8Entry, 0, len(s.keys)+1)
+		for _, k := range s.keys {
+			if keyFormatOf(k) != keyFormatOf(entry) {
+				keys = append(keys, k)
+			}
+		}
+		s.keys = append(keys, entry)
+	case common.M3U8ExtXMap:
+		s.curMap = entry
+	case common.M3U8ExtXIProgramDateTime:
+		s.pdt = true
+	}
+}
+
+//skippedEntries - entries of prev for the segments first to first+count-1
+//The keys, map and program date time in effect are added before the first segment if not in its tags
+func (prev *M3U8) skippedEntries(first int64, count int64) (entries []M3U8Entry, err error) {
+	if count <= 0 {
+		return
+	}
+	var state deltaState
+	blockStart := 0
+	copyStart, firstExtinf := -1, -1
+	found := int64(0)
+	for i := range prev.Entries {
+		entry := &prev.Entries[i]
+		if entry.Tag != common.M3U8ExtInf {
+			continue
+		}
+		seq, _ := entry.Values.Get(common.INTMediaSequenceNumber).(int64)
+		if seq < first {
+			for j := blockStart; j <= i; j++ {
+				state.post(&prev.Entries[j])
+			}
+		} else if seq < first+count {
+			if copyStart < 0 {
+				copyStart, firstExtinf = blockStart, i
+			}
+			found++
+			if found == count {
+				entries = prev.stateEntries(&state, copyStart, firstExtinf)
+				for j := copyStart; j <= i; j++ {
+					if isSegmentTag(prev.Entries[j].Tag) {
+						entries = append(entries, prev.Entries[j].clone())
+					}
+				}
+				return
+			}
+		}
+		blockStart = i + 1
+	}
+	err = fmt.Errorf("previous playlist has %v of the segments %v to %v skipped", found, first, first+count-1)
+	return
+}
+
+//stateEntries - EXT-X-KEY, EXT-X-MAP and EXT-X-PROGRAM-DATE-TIME for the first segment copied
+//extinf is the index of its EXTINF, start the index of its first tag
+//EXT-X-PROGRAM-DATE-TIME is only added if the playlist has one applying to the segment
+func (prev *M3U8) stateEntries(state *deltaState, start int, extinf int) (entries []M3U8Entry) {
+	formats := make(map[string]bool)
+	hasMap, hasPDT, keysRemoved := false, false, false
+	for j := start; j < extinf; j++ {
+		entry := &prev.Entries[j]
+		switch entry.Tag {
+		case common.M3U8ExtXKey:
+			formats[keyFormatOf(entry)] = true
+			//METHOD=NONE removes the keys in effect
+			if method, _ := entry.Values.Get(common.M3U8Method).(string); method == "NONE" {
+				keysRemoved = true
+			}
+		case common.M3U8ExtXMap:
+			hasMap = true
+		case common.M3U8ExtXIProgramDateTime:
+			hasPDT = true
+		}
+	}
+	for _, k := range state.keys {
+		if !keysRemoved && !formats[keyFormatOf(k)] {
+			entries = append(entries, k.clone())
+		}
+	}
+	if !hasMap && state.curMap != nil {
+		entries = append(entries, state.curMap.clone())
+	}
+	if pdt, ok := prev.Entries[extinf].Values.Get(common.INTProgramDateTime).(time.Time); ok && state.pdt && !hasPDT {
+		entry := M3U8Entry{Tag: common.M3U8ExtXIProgramDateTime, Values: parsers.NewAttrKVPairs()}
+		entry.Values.Store(common.INTUnknownAttr, pdt)
+		entries = append(entries, entry)
+	}
+	return
+}
+
+//ApplyDelta - reconstructs the full playlist from a Playlist Delta Update
+//The EXT-X-SKIP tag is replaced by the skipped segments of prev, the playlist last loaded in full,
+//along with their tags and the EXT-X-KEY, EXT-X-MAP and EXT-X-PROGRAM-DATE-TIME in effect
+//If RECENTLY-REMOVED-DATERANGES is present (_HLS_skip=v2) the EXT-X-DATERANGE tags of prev are
+//added but for the ones removed
+//Nothing is done if the playlist has no EXT-X-SKIP
+func (m *M3U8) ApplyDelta(prev *M3U8) (err error) {
+	skipIdx := -1
+	for i := range m.Entries {
+		if m.Entries[i].Tag == common.M3U8XSkip {
+			skipIdx = i
+			break
+		}
+	}
+	if skipIdx < 0 {
+		return nil
+	}
+	if prev == nil {
+		return fmt.Errorf("previous playlist not available")
+	}
+	for i := range prev.Entries {
+		if prev.Entries[i].Tag == common.M3U8XSkip {
+			return fmt.Errorf("previous playlist is a delta update")
+		}
+	}
+	skip := &m.Entries[skipIdx]
+	count, err := skip.Values.GetInt64(skip.Tag, common.M3U8SkippedSegments)
+	if err != nil {
+		return
+	}
+	copied, err := prev.skippedEntries(m.MediaSequenceNumber, count)
+	if err != nil {
+		return
+	}
+	var removedIds []string
+	skippedDateRanges := skip.Values.Exists(common.M3U8RecentlyRemovedDateRanges)
+	if skippedDateRanges {
+		var ids string
+		ids, err = skip.Values.GetString(skip.Tag, common.M3U8RecentlyRemovedDateRanges)
+		if err != nil {
+			return
+		}
+		removedIds = strings.Split(ids, "\t")
+	}
+	copied = m.mergeDateRanges(prev, copied, skippedDateRanges, removedIds)
+	entries := make([]M3U8Entry, 0, len(m.Entries)+len(copied))
+	entries = append(entries, m.Entries[:skipIdx]...)
+	entries = append(entries, copied...)
+	entries = append(entries, m.Entries[skipIdx+1:]...)
+	skip.Done()
+	//computed values are set again, as when parsing
+	diagnostics := m.Diagnostics
+	m.Init()
+	m.Diagnostics = diagnostics
+	for i := range entries {
+		err = m.postRecordEntry(entries[i])
+		if err != nil {
+			return fmt.Errorf("entry %v : %w", i, err)
+		}
+	}
+	return
+}
+
+//mergeDateRanges - EXT-X-DATERANGE tags copied are dropped if in the delta or in removedIds
+//If skippedDateRanges the ones of prev not in the delta are added before the copied entries
+func (m *M3U8) mergeDateRanges(prev *M3U8, copied []M3U8Entry, skippedDateRanges bool, removedIds []string) []M3U8Entry {
+	drop := make(map[string]bool)
+	for _, id := range removedIds {
+		if id != "" {
+			drop[id] = true
+		}
+	}
+	for i := range m.Entries {
+		if m.Entries[i].Tag == common.M3U8ExtXDataRange {
+			drop[dateRangeId(&m.Entries[i])] = true
+		}
+	}
+	var merged []M3U8Entry
+	if skippedDateRanges {
+		inCopied := make(map[string]bool)
+		for i := range copied {
+			if copied[i].Tag == common.M3U8ExtXDataRange {
+				inCopied[dateRangeId(&copied[i])] = true
+			}
+		}
+		for i := range prev.Entries {
+			entry := &prev.Entries[i]
+			if entry.Tag == common.M3U8ExtXDataRange && !inCopied[dateRangeId(entry)] && !drop[dateRangeId(entry)] {
+				merged = append(merged, entry.clone())
+			}
+		}
+	}
+	for i := range copied {
+		if copied[i].Tag == common.M3U8ExtXDataRange && drop[dateRangeId(&copied[i])] {
+			continue
+		}
+		merged = append(merged, copied[i])
+	}
+	return merged
+}
+
+//skipBoundary - number of segments ending at least canSkipUntil before the end of the playlist
+func (m *M3U8) skipBoundary(canSkipUntil float64) (count int64, err error) {
+	var durations []float64
+	total := 0.0
+	for i := range m.Entries {
+		entry := &m.Entries[i]
+		if entry.Tag != common.M3U8ExtInf {
+			continue
+		}
+		var f float64
+		f, err = entry.Values.GetFloat64(entry.Tag, common.INTUnknownAttr)
+		if err != nil {
+			return
+		}
+		durations = append(durations, f)
+		total += f
+	}
+	end := 0.0
+	//the last segment is never skipped
+	for i := 0; i < len(durations)-1; i++ {
+		end += durations[i]
+		//tolerance for the sum of the decimal durations
+		if end > total-canSkipUntil+0.001 {
+			break
+		}
+		count++
+	}
+	return
+}
+
+//DeltaUpdate - Playlist Delta Update of the playlist, the response to _HLS_skip=YES or v2
+//The segments ending at least CAN-SKIP-UNTIL before the end of the playlist are replaced by EXT-X-SKIP,
+//the EXT-X-KEY, EXT-X-MAP and EXT-X-PROGRAM-DATE-TIME in effect are repeated for the first segment kept
+//If skipDateRanges (v2), EXT-X-DATERANGE tags starting before the first segment kept are skipped as well
+//and removedIds, the IDs of the EXT-X-DATERANGE tags recently removed, are in RECENTLY-REMOVED-DATERANGES
+//A copy of the playlist is returned if no segment can be skipped
+func (m *M3U8) DeltaUpdate(skipDateRanges bool, removedIds []string) (delta *M3U8, err error) {
+	media, err := m.MediaPlaylist()
+	if err != nil {
+		return
+	}
+	if media.ServerControl == nil || media.ServerControl.CanSkipUntil <= 0 {
+		err = fmt.Errorf("CAN-SKIP-UNTIL not available, Playlist Delta Updates not supported")
+		return
+	}
+	if skipDateRanges && !media.ServerControl.CanSkipDateRanges {
+		err = fmt.Errorf("CAN-SKIP-DATERANGES not available")
+		return
+	}
+	count, err := m.skipBoundary(media.ServerControl.CanSkipUntil.Seconds())
+	if err != nil {
+		return
+	}
+	delta = &M3U8{parserOption: m.parserOption, lossless: m.lossless, baseURL: m.baseURL, queryInherit: m.queryInherit}
+	delta.Init()
+	post := func(entry M3U8Entry) {
+		if err == nil {
+			err = delta.postRecordEntry(entry)
+		}
+	}
+	if count == 0 {
+		for i := range m.Entries {
+			post(m.Entries[i].clone())
+		}
+		return
+	}
+	//first segment kept and the index of its first tag
+	var state deltaState
+	var boundary time.Time
+	seq, keptStart, keptExtinf := int64(0), 0, 0
+	for i := range m.Entries {
+		entry := &m.Entries[i]
+		if entry.Tag != common.M3U8ExtInf {
+			continue
+		}
+		if seq == count {
+			keptExtinf = i
+			boundary, _ = entry.Values.Get(common.INTProgramDateTime).(time.Time)
+			break
+		}
+		for j := keptStart; j <= i; j++ {
+			state.post(&m.Entries[j])
+		}
+		keptStart = i + 1
+		seq++
+	}
+	//v2 : date ranges starting before the boundary, or positioned before it without program date time
+	skipped := func(i int) bool {
+		entry := &m.Entries[i]
+		if entry.Tag != common.M3U8ExtXDataRange {
+			return i < keptStart && isSegmentTag(entry.Tag)
+		}
+		if !skipDateRanges {
+			return false
+		}
+		if boundary.IsZero() {
+			return i < keptStart
+		}
+		start, _ := entry.Values.Get(common.M3U8StartDate).(time.Time)
+		return start.Before(boundary)
+	}
+	//EXT-X-SKIP requires version 9, RECENTLY-REMOVED-DATERANGES version 10
+	version := int64(9)
+	if skipDateRanges {
+		version = 10
+	}
+	hasVersion := false
+	for i := range m.Entries {
+		hasVersion = hasVersion || m.Entries[i].Tag == common.M3U8ExtXVersion
+	}
+	for i := 0; i < keptStart; i++ {
+		if !skipped(i) {
+			post(m.Entries[i].clone())
+		}
+		if i == 0 && !hasVersion {
+			entry := M3U8Entry{Tag: common.M3U8ExtXVersion, Values: parsers.NewAttrKVPairs()}
+			entry.Values.Store(common.INTUnknownAttr, version)
+			post(entry)
+		}
+	}
+	skip := M3U8Entry{Tag: common.M3U8XSkip, Values: parsers.NewAttrKVPairs()}
+	skip.Values.Store(common.M3U8SkippedSegments, count)
+	if skipDateRanges {
+		skip.Values.Store(common.M3U8RecentlyRemovedDateRanges, strings.Join(removedIds, "\t"))
+	}
+	post(skip)
+	for _, entry := range m.stateEntries(&state, keptStart, keptExtinf) {
+		post(entry)
+	}
+	for i := keptStart; i < len(m.Entries); i++ {
+		if !skipped(i) {
+			post(m.Entries[i].clone())
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range delta.Entries {
+		entry := &delta.Entries[i]
+		if v, ok := entry.Values.Get(common.INTUnknownAttr).(int64); ok && entry.Tag == common.M3U8ExtXVersion && v < version {
+			entry.StoreKV(common.INTUnknownAttr, version)
+		}
+	}
+	return
+}
@@ -0,0 +1,298 @@
+package m3u8reader_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eswarantg/m3u8reader"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+const deltaHeader = `#EXTM3U
+#EXT-X-VERSION:9
+#EXT-X-TARGETDURATION:4
+#EXT-X-SERVER-CONTROL:CAN-BLOCK-RELOAD=YES,CAN-SKIP-UNTIL=12,CAN-SKIP-DATERANGES=YES
+`
+
+const deltaPrev = deltaHeader + `#EXT-X-MEDIA-SEQUENCE:10
+#EXT-X-MAP:URI="init.mp4"
+#EXT-X-KEY:METHOD=AES-128,URI="key1"
+#EXT-X-PROGRAM-DATE-TIME:2024-01-01T00:00:00Z
+#EXT-X-DATERANGE:ID="ad1",START-DATE="2024-01-01T00:00:00Z",DURATION=8
+#EXTINF:4.0,
+seg10.mp4
+#EXTINF:4.0,
+seg11.mp4
+#EXT-X-KEY:METHOD=AES-128,URI="key2"
+#EXTINF:4.0,
+seg12.mp4
+#EXT-X-DATERANGE:ID="ad2",START-DATE="2024-01-01T00:00:12Z",DURATION=4
+#EXTINF:4.0,
+seg13.mp4
+#EXTINF:4.0,
+seg14.mp4
+#EXTINF:4.0,
+seg15.mp4
+`
+
+const deltaTail = `#EXTINF:4.0,
+seg14.mp4
+#EXTINF:4.0,
+seg15.mp4
+#EXTINF:4.0,
+seg16.mp4
+`
+
+//deltaSkipped - segments 11 to 13 as in deltaPrev, with the tags in effect
+const deltaSkipped = `#EXT-X-KEY:METHOD=AES-128,URI="key1"
+#EXT-X-MAP:URI="init.mp4"
+#EXT-X-PROGRAM-DATE-TIME:2024-01-01T00:00:04Z
+#EXTINF:4.0,
+seg11.mp4
+#EXT-X-KEY:METHOD=AES-128,URI="key2"
+#EXTINF:4.0,
+seg12.mp4
+#EXT-X-DATERANGE:ID="ad2",START-DATE="2024-01-01T00:00:12Z",DURATION=4
+#EXTINF:4.0,
+seg13.mp4
+`
+
+func Test_ApplyDelta(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	tests := []struct {
+		name     string
+		delta    string
+		expected string
+	}{
+		{"skip", deltaHeader + "#EXT-X-MEDIA-SEQUENCE:11\n#EXT-X-SKIP:SKIPPED-SEGMENTS=3\n" + deltaTail,
+			deltaHeader + "#EXT-X-MEDIA-SEQUENCE:11\n" + deltaSkipped + deltaTail},
+		{"skip date ranges", deltaHeader + "#EXT-X-MEDIA-SEQUENCE:11\n#EXT-X-SKIP:SKIPPED-SEGMENTS=3,RECENTLY-REMOVED-DATERANGES=\"\"\n" + deltaTail,
+			deltaHeader + "#EXT-X-MEDIA-SEQUENCE:11\n" +
+				"#EXT-X-DATERANGE:ID=\"ad1\",START-DATE=\"2024-01-01T00:00:00Z\",DURATION=8\n" + deltaSkipped + deltaTail},
+		{"date ranges removed", deltaHeader + "#EXT-X-MEDIA-SEQUENCE:11\n#EXT-X-SKIP:SKIPPED-SEGMENTS=3,RECENTLY-REMOVED-DATERANGES=\"ad1\tad2\"\n" + deltaTail,
+			deltaHeader + "#EXT-X-MEDIA-SEQUENCE:11\n" +
+				strings.Replace(deltaSkipped, "#EXT-X-DATERANGE:ID=\"ad2\",START-DATE=\"2024-01-01T00:00:12Z\",DURATION=4\n", "", 1) + deltaTail},
+		{"skip all but the last", deltaHeader + "#EXT-X-MEDIA-SEQUENCE:10\n#EXT-X-SKIP:SKIPPED-SEGMENTS=5\n#EXTINF:4.0,\nseg15.mp4\n",
+			deltaPrev},
+		{"not a delta", deltaHeader + "#EXT-X-MEDIA-SEQUENCE:14\n" + deltaTail,
+			deltaHeader + "#EXT-X-MEDIA-SEQUENCE:14\n" + deltaTail},
+	}
+	for _, test := range tests {
+		prev := parseString(t, deltaPrev)
+		m := parseString(t, test.delta)
+		err := m.ApplyDelta(prev)
+		if err != nil {
+			t.Errorf("%v : ApplyDelta : %v", test.name, err)
+			continue
+		}
+		expected := parseString(t, test.expected)
+		want, _ := expected.Marshal()
+		got, err := m.Marshal()
+		if err != nil || string(got) != string(want) {
+			t.Errorf("%v : expected %v : got %v %v", test.name, string(want), string(got), err)
+		}
+		wantMedia, _ := expected.MediaPlaylist()
+		gotMedia, err := m.MediaPlaylist()
+		if err != nil || len(gotMedia.Segments) != len(wantMedia.Segments) {
+			t.Errorf("%v : segments expected %v : got %v %v", test.name, len(wantMedia.Segments), gotMedia, err)
+			continue
+		}
+		for i, seg := range gotMedia.Segments {
+			want := wantMedia.Segments[i]
+			if seg.SequenceNumber != want.SequenceNumber || seg.URI != want.URI || !seg.ProgramDateTime.Equal(want.ProgramDateTime) ||
+				len(seg.Keys) != len(want.Keys) || (seg.Map == nil) != (want.Map == nil) {
+				t.Errorf("%v : segment %v expected %v : got %v", test.name, i, want, seg)
+			}
+		}
+		wantMSN, wantPart := expected.NextPart()
+		gotMSN, gotPart := m.NextPart()
+		if gotMSN != wantMSN || gotPart != wantPart {
+			t.Errorf("%v : next part expected %v.%v : got %v.%v", test.name, wantMSN, wantPart, gotMSN, gotPart)
+		}
+	}
+}
+
+func Test_ApplyDeltaErrors(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	delta := deltaHeader + "#EXT-X-MEDIA-SEQUENCE:11\n#EXT-X-SKIP:SKIPPED-SEGMENTS=3\n" + deltaTail
+	tests := []struct {
+		name string
+		prev *m3u8reader.M3U8
+	}{
+		{"no previous", nil},
+		{"not covered", parseString(t, strings.Replace(deltaPrev, "MEDIA-SEQUENCE:10", "MEDIA-SEQUENCE:12", 1))},
+		{"partly covered", parseString(t, deltaHeader+"#EXT-X-MEDIA-SEQUENCE:10\n#EXTINF:4.0,\nseg10.mp4\n#EXTINF:4.0,\nseg11.mp4\n")},
+		{"previous delta", parseString(t, delta)},
+	}
+	for _, test := range tests {
+		m := parseString(t, delta)
+		err := m.ApplyDelta(test.prev)
+		if err == nil {
+			t.Errorf("%v : error expected", test.name)
+		}
+	}
+}
+
+func Test_DeltaUpdate(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	//segments 13 to 15 are within CAN-SKIP-UNTIL=12 of the end
+	const kept = `#EXT-X-KEY:METHOD=AES-128,URI="key2"
+#EXT-X-MAP:URI="init.mp4"
+#EXT-X-PROGRAM-DATE-TIME:2024-01-01T00:00:12Z
+#EXT-X-DATERANGE:ID="ad2",START-DATE="2024-01-01T00:00:12Z",DURATION=4
+#EXTINF:4.0,
+seg13.mp4
+#EXTINF:4.0,
+seg14.mp4
+#EXTINF:4.0,
+seg15.mp4
+`
+	tests := []struct {
+		name           string
+		skipDateRanges bool
+		removedIds     []string
+		expected       string
+	}{
+		{"skip", false, nil, deltaHeader + `#EXT-X-MEDIA-SEQUENCE:10
+#EXT-X-DATERANGE:ID="ad1",START-DATE="2024-01-01T00:00:00Z",DURATION=8
+#EXT-X-SKIP:SKIPPED-SEGMENTS=3
+` + kept},
+		{"skip date ranges", true, []string{"ad0", "ad00"}, strings.Replace(deltaHeader, "VERSION:9", "VERSION:10", 1) + "#EXT-X-MEDIA-SEQUENCE:10\n" +
+			"#EXT-X-SKIP:SKIPPED-SEGMENTS=3,RECENTLY-REMOVED-DATERANGES=\"ad0\tad00\"\n" + kept},
+	}
+	for _, test := range tests {
+		full := parseString(t, deltaPrev)
+		delta, err := full.DeltaUpdate(test.skipDateRanges, test.removedIds)
+		if err != nil {
+			t.Errorf("%v : DeltaUpdate : %v", test.name, err)
+			continue
+		}
+		got, err := delta.Marshal()
+		if err != nil {
+			t.Errorf("%v : Marshal : %v", test.name, err)
+			continue
+		}
+		expected := parseString(t, test.expected)
+		want, _ := expected.Marshal()
+		if string(got) != string(want) {
+			t.Errorf("%v : expected %v : got %v", test.name, string(want), string(got))
+		}
+		//the delta as read by a client is merged back onto the full playlist
+		m := parseString(t, string(got))
+		err = m.ApplyDelta(full)
+		if err != nil {
+			t.Errorf("%v : ApplyDelta : %v", test.name, err)
+			continue
+		}
+		wantMedia, _ := full.MediaPlaylist()
+		gotMedia, err := m.MediaPlaylist()
+		if err != nil || len(gotMedia.Segments) != len(wantMedia.Segments) || len(gotMedia.DateRanges) != len(wantMedia.DateRanges) {
+			t.Errorf("%v : merged expected %v : got %v %v", test.name, wantMedia, gotMedia, err)
+			continue
+		}
+		for i, seg := range gotMedia.Segments {
+			want := wantMedia.Segments[i]
+			if seg.SequenceNumber != want.SequenceNumber || seg.URI != want.URI || !seg.ProgramDateTime.Equal(want.ProgramDateTime) ||
+				len(seg.Keys) != len(want.Keys) || seg.Keys[0].URI != want.Keys[0].URI || seg.Map == nil {
+				t.Errorf("%v : merged segment %v expected %v : got %v", test.name, i, want, seg)
+			}
+		}
+	}
+}
+
+func Test_DeltaUpdateNoSkip(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	full := parseString(t, strings.Replace(deltaPrev, "CAN-SKIP-UNTIL=12", "CAN-SKIP-UNTIL=36", 1))
+	delta, err := full.DeltaUpdate(true, nil)
+	want, _ := full.Marshal()
+	got, _ := delta.Marshal()
+	if err != nil || string(got) != string(want) {
+		t.Errorf("no skip : expected %v : got %v %v", string(want), string(got), err)
+	}
+
+	tests := []struct {
+		name           string
+		playlist       string
+		skipDateRanges bool
+	}{
+		{"no CAN-SKIP-UNTIL", strings.Replace(deltaPrev, ",CAN-SKIP-UNTIL=12", "", 1), false},
+		{"no CAN-SKIP-DATERANGES", strings.Replace(deltaPrev, ",CAN-SKIP-DATERANGES=YES", "", 1), true},
+		{"master playlist", "#EXTM3U\n#EXT-X-STREAM-INF:BANDWIDTH=1000\nlow.m3u8\n", false},
+	}
+	for _, test := range tests {
+		m := parseString(t, test.playlist)
+		_, err := m.DeltaUpdate(test.skipDateRanges, nil)
+		if err == nil {
+			t.Errorf("%v : error expected", test.name)
+		}
+	}
+}
+
+//noPDTPrev - without EXT-X-PROGRAM-DATE-TIME nor EXT-X-VERSION
+const noPDTPrev = `#EXTM3U
+#EXT-X-TARGETDURATION:4
+#EXT-X-SERVER-CONTROL:CAN-SKIP-UNTIL=12
+#EXT-X-MEDIA-SEQUENCE:10
+#EXTINF:4.0,
+seg10.mp4
+#EXTINF:4.0,
+seg11.mp4
+#EXTINF:4.0,
+seg12.mp4
+#EXTINF:4.0,
+seg13.mp4
+#EXTINF:4.0,
+seg14.mp4
+#EXTINF:4.0,
+seg15.mp4
+#EXTINF:4.0,
+seg16.mp4
+`
+
+func Test_DeltaUpdateNoPDT(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	full := parseString(t, noPDTPrev)
+	delta, err := full.DeltaUpdate(false, nil)
+	if err != nil {
+		t.Fatalf("DeltaUpdate : %v", err)
+	}
+	got, _ := delta.Marshal()
+	expected := "#EXTM3U\n#EXT-X-VERSION:9\n#EXT-X-TARGETDURATION:4\n#EXT-X-SERVER-CONTROL:CAN-SKIP-UNTIL=12\n#EXT-X-MEDIA-SEQUENCE:10\n" +
+		"#EXT-X-SKIP:SKIPPED-SEGMENTS=4\n#EXTINF:4.0,\nseg14.mp4\n#EXTINF:4.0,\nseg15.mp4\n#EXTINF:4.0,\nseg16.mp4\n"
+	want, _ := parseString(t, expected).Marshal()
+	if string(got) != string(want) {
+		t.Errorf("no program date time : expected %v : got %v", string(want), string(got))
+	}
+	m := parseString(t, string(got))
+	err = m.ApplyDelta(full)
+	merged, _ := m.Marshal()
+	if err != nil || strings.Contains(string(merged), "PROGRAM-DATE-TIME") {
+		t.Errorf("no program date time : merged without EXT-X-PROGRAM-DATE-TIME expected : got %v %v", string(merged), err)
+	}
+}
+
+func Test_ApplyDeltaNoPDT(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	prev := parseString(t, noPDTPrev)
+	//segments 12 to 14 skipped, starting after the first segment of prev
+	m := parseString(t, "#EXTM3U\n#EXT-X-VERSION:9\n#EXT-X-TARGETDURATION:4\n#EXT-X-MEDIA-SEQUENCE:12\n#EXT-X-SKIP:SKIPPED-SEGMENTS=3\n"+
+		"#EXTINF:4.0,\nseg15.mp4\n#EXTINF:4.0,\nseg16.mp4\n#EXTINF:4.0,\nseg17.mp4\n")
+	err := m.ApplyDelta(prev)
+	if err != nil {
+		t.Fatalf("ApplyDelta : %v", err)
+	}
+	got, _ := m.Marshal()
+	expected := "#EXTM3U\n#EXT-X-VERSION:9\n#EXT-X-TARGETDURATION:4\n#EXT-X-MEDIA-SEQUENCE:12\n" +
+		"#EXTINF:4.0,\nseg12.mp4\n#EXTINF:4.0,\nseg13.mp4\n#EXTINF:4.0,\nseg14.mp4\n" +
+		"#EXTINF:4.0,\nseg15.mp4\n#EXTINF:4.0,\nseg16.mp4\n#EXTINF:4.0,\nseg17.mp4\n"
+	want, _ := parseString(t, expected).Marshal()
+	if string(got) != string(want) {
+		t.Errorf("no program date time : expected %v : got %v", string(want), string(got))
+	}
+	media, _ := m.MediaPlaylist()
+	for _, seg := range media.Segments {
+		if !seg.ProgramDateTime.IsZero() {
+			t.Errorf("%v : no program date time expected : got %v", seg.URI, seg.ProgramDateTime)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package m3u8reader
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+//encodedEntry - M3U8Entry as encoded in JSON and YAML, tag and attributes by their names
+type encodedEntry struct {
+	Tag       string               `json:"tag" yaml:"tag"`
+	Values    *parsers.AttrKVPairs `json:"values,omitempty" yaml:"values,omitempty"`
+	Raw       string               `json:"raw,omitempty" yaml:"raw,omitempty"`
+	AttrOrder []string             `json:"attrOrder,omitempty" yaml:"attrOrder,omitempty"`
+	Span      string               `json:"span,omitempty" yaml:"span,omitempty"`
+	Inner     bool                 `json:"inner,omitempty" yaml:"inner,omitempty"`
+}
+
+//encodedM3U8 - M3U8 as encoded in JSON and YAML
+type encodedM3U8 struct {
+	Lossless bool           `json:"lossless,omitempty" yaml:"lossless,omitempty"`
+	Entries  []encodedEntry `json:"entries" yaml:"entries"`
+}
+
+func (m *M3U8Entry) encode() encodedEntry {
+	e := encodedEntry{Tag: common.TagNames[m.Tag], Span: string(m.span), Inner: m.inner}
+	if m.rawValid() {
+		e.Raw = string(m.Raw)
+	}
+	if len(m.Values.Map()) > 0 {
+		e.Values = m.Values
+	}
+	for _, attrId := range m.AttrOrder {
+		e.AttrOrder = append(e.AttrOrder, common.AttrNames[attrId])
+	}
+	return e
+}
+
+//decode - values are typed as per the tag
+func (e *encodedEntry) decode() (entry M3U8Entry, err error) {
+	tag, ok := common.TagToTagId[e.Tag]
+	if !ok {
+		err = fmt.Errorf("unknown tag %v", e.Tag)
+		return
+	}
+	entry = M3U8Entry{Tag: tag, Values: e.Values}
+	if entry.Values == nil {
+		entry.Values = parsers.NewAttrKVPairs()
+	}
+	err = parsers.TypeValues(tag, entry.Values)
+	if err != nil {
+		return
+	}
+	if len(e.Raw) > 0 {
+		entry.setRaw([]byte(e.Raw))
+	}
+	if len(e.Span) > 0 {
+		entry.span = []byte(e.Span)
+	}
+	entry.inner = e.Inner
+	for _, name := range e.AttrOrder {
+		attrId, ok := common.AttrToAttrId[name]
+		if !ok {
+			err = fmt.Errorf("%v : unknown attribute %v", e.Tag, name)
+			return
+		}
+		entry.AttrOrder = append(entry.AttrOrder, attrId)
+	}
+	return
+}
+
+//MarshalJSON - {"tag":<name>,"values":{<attribute name>:<value>}}
+//raw and attrOrder are present for entries read in lossless mode, span and inner for the tags read between a tag and its URI line
+func (m *M3U8Entry) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.encode())
+}
+
+func (m *M3U8Entry) UnmarshalJSON(data []byte) error {
+	var e encodedEntry
+	err := json.Unmarshal(data, &e)
+	if err != nil {
+		return err
+	}
+	*m, err = e.decode()
+	return err
+}
+
+//MarshalYAML - same layout as MarshalJSON
+func (m *M3U8Entry) MarshalYAML() (interface{}, error) {
+	return m.encode(), nil
+}
+
+func (m *M3U8Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedEntry
+	err := unmarshal(&e)
+	if err != nil {
+		return err
+	}
+	*m, err = e.decode()
+	return err
+}
+
+func (m *M3U8) encode() encodedM3U8 {
+	e := encodedM3U8{Lossless: m.lossless, Entries: make([]encodedEntry, 0, len(m.Entries))}
+	for i := range m.Entries {
+		e.Entries = append(e.Entries, m.Entries[i].encode())
+	}
+	return e
+}
+
+//decode - entries are posted as if parsed, computed values are set again
+func (m *M3U8) decode(e encodedM3U8) error {
+	m.Init()
+	m.lossless = e.Lossless
+	for i := range e.Entries {
+		entry, err := e.Entries[i].decode()
+		if err == nil {
+			err = m.postRecordEntry(entry)
+		}
+		if err != nil {
+			return fmt.Errorf("entry %v : %w", i, err)
+		}
+	}
+	return nil
+}
+
+//MarshalJSON - {"lossless":true,"entries":[<M3U8Entry>...]}
+//lossless is present only in lossless mode, in which WriteTo writes the raw entries as is
+func (m *M3U8) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.encode())
+}
+
+//UnmarshalJSON - replaces the entries, the playlist state is computed as when parsing
+func (m *M3U8) UnmarshalJSON(data []byte) error {
+	var e encodedM3U8
+	err := json.Unmarshal(data, &e)
+	if err != nil {
+		return err
+	}
+	return m.decode(e)
+}
+
+//MarshalYAML - same layout as MarshalJSON
+func (m *M3U8) MarshalYAML() (interface{}, error) {
+	return m.encode(), nil
+}
+
+func (m *M3U8) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedM3U8
+	err := unmarshal(&e)
+	if err != nil {
+		return err
+	}
+	return m.decode(e)
+}
@@ -0,0 +1,199 @@
+package m3u8reader_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eswarantg/m3u8reader"
+	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/parsers"
+	"gopkg.in/yaml.v3"
+)
+
+func Test_JSONRoundTrip(t *testing.T) {
+	files, err := filepath.Glob("test/*.m3u8")
+	if err != nil {
+		t.Fatalf("Glob : %v", err)
+	}
+	parsers.AttrKVPairsSyncPool = false
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Errorf("Unable to open file %v", file)
+			continue
+		}
+		for _, lossless := range []bool{false, true} {
+			manifest := m3u8reader.M3U8{}
+			manifest.SetLossless(lossless)
+			_, err = manifest.ParseData(data)
+			if err != nil {
+				t.Errorf("%v : %v", file, err)
+				continue
+			}
+			js, err := json.Marshal(&manifest)
+			if err != nil {
+				t.Errorf("%v : lossless %v : json %v", file, lossless, err)
+				continue
+			}
+			decoded := m3u8reader.M3U8{}
+			err = json.Unmarshal(js, &decoded)
+			if err != nil {
+				t.Errorf("%v : lossless %v : decode %v", file, lossless, err)
+				continue
+			}
+			want, _ := manifest.Marshal()
+			got, err := decoded.Marshal()
+			if err != nil || string(got) != string(want) {
+				t.Errorf("%v : lossless %v : expected %v : got %v %v", file, lossless, string(want), string(got), err)
+			}
+			if decoded.TargetDuration() != manifest.TargetDuration() || decoded.PartTarget() != manifest.PartTarget() ||
+				!decoded.LastSegmentTime().Equal(manifest.LastSegmentTime()) {
+				t.Errorf("%v : lossless %v : playlist state differs", file, lossless)
+			}
+		}
+	}
+}
+
+func Test_YAMLRoundTrip(t *testing.T) {
+	files, err := filepath.Glob("test/*.m3u8")
+	if err != nil {
+		t.Fatalf("Glob : %v", err)
+	}
+	parsers.AttrKVPairsSyncPool = false
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Errorf("Unable to open file %v", file)
+			continue
+		}
+		for _, lossless := range []bool{false, true} {
+			manifest := m3u8reader.M3U8{}
+			manifest.SetLossless(lossless)
+			_, err = manifest.ParseData(data)
+			if err != nil {
+				t.Errorf("%v : %v", file, err)
+				continue
+			}
+			y, err := yaml.Marshal(&manifest)
+			if err != nil {
+				t.Errorf("%v : lossless %v : yaml %v", file, lossless, err)
+				continue
+			}
+			decoded := m3u8reader.M3U8{}
+			err = yaml.Unmarshal(y, &decoded)
+			if err != nil {
+				t.Errorf("%v : lossless %v : decode %v", file, lossless, err)
+				continue
+			}
+			want, _ := manifest.Marshal()
+			got, err := decoded.Marshal()
+			if err != nil || string(got) != string(want) {
+				t.Errorf("%v : lossless %v : expected %v : got %v %v", file, lossless, string(want), string(got), err)
+			}
+		}
+	}
+}
+
+func Test_JSONValues(t *testing.T) {
+	data := strings.Join([]string{
+		"#EXTM3U",
+		"#EXT-X-PROGRAM-DATE-TIME:2022-01-20T12:16:00.000Z",
+		"#EXT-X-KEY:METHOD=AES-128,URI=\"key.bin\",IV=0x0102",
+		"#EXT-X-BYTERANGE:100@20",
+		"#EXTINF:4.5,title",
+		"seg1.ts",
+		"",
+	}, "\n")
+	parsers.AttrKVPairsSyncPool = false
+	manifest := m3u8reader.M3U8{}
+	_, err := manifest.ParseData([]byte(data))
+	if err != nil {
+		t.Fatalf("ParseData : %v", err)
+	}
+	js, err := json.Marshal(&manifest.Entries[4])
+	if err != nil {
+		t.Fatalf("json : %v", err)
+	}
+	expected := `{"tag":"EXTINF","values":{"#":4.5,"TITLE":"title","URI":"seg1.ts","mediaSequenceNumber":0,"programDataTime":"2022-01-20T12:16:00Z"}}`
+	if string(js) != expected {
+		t.Errorf("json expected %v : got %v", expected, string(js))
+	}
+	js, _ = json.Marshal(&manifest)
+	decoded := m3u8reader.M3U8{}
+	err = json.Unmarshal(js, &decoded)
+	if err != nil {
+		t.Fatalf("decode : %v", err)
+	}
+	tests := []struct {
+		entry  int
+		attrId common.AttrId
+		value  interface{}
+	}{
+		{1, common.INTUnknownAttr, time.Date(2022, 1, 20, 12, 16, 0, 0, time.UTC)},
+		{2, common.M3U8IV, [16]byte{14: 1, 15: 2}},
+		{3, common.INTUnknownAttr, [2]int64{100, 20}},
+		{4, common.INTUnknownAttr, 4.5},
+		{4, common.INTMediaSequenceNumber, int64(0)},
+	}
+	for i, test := range tests {
+		got := decoded.Entries[test.entry].Values.Get(test.attrId)
+		if tm, ok := got.(time.Time); ok {
+			got = tm.UTC()
+		}
+		if got != test.value {
+			t.Errorf("%v : %v expected %T(%v) : got %T(%v)", i, common.AttrNames[test.attrId], test.value, test.value, got, got)
+		}
+	}
+
+	errTests := []string{
+		`{"entries":[{"tag":"EXT-X-UNKNOWN"}]}`,
+		`{"entries":[{"tag":"EXTINF","values":{"#":"abc"}}]}`,
+		`{"entries":[{"tag":"EXT-X-KEY","values":{"NOT-AN-ATTR":"x"}}]}`,
+		`{"entries":[{"tag":"EXT-X-STREAM-INF","values":{"BANDWIDTH":1.5}}]}`,
+		`{"entries":[{"tag":"EXT-X-MEDIA","values":{"DEFAULT":"MAYBE"}}]}`,
+		`{"entries":[{"tag":"EXT-X-MEDIA","values":{"NAME":true}}]}`,
+	}
+	for i, test := range errTests {
+		err = json.Unmarshal([]byte(test), &decoded)
+		if err == nil {
+			t.Errorf("%v : error expected for %v", i, test)
+		}
+	}
+}
+
+func Test_YAMLValues(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	//values as decoded by a YAML library into interface{}
+	pdt := time.Date(2022, 1, 20, 12, 16, 0, 0, time.UTC)
+	values := map[string]interface{}{
+		"BANDWIDTH":       1280000,
+		"FRAME-RATE":      29.97,
+		"DEFAULT":         true,
+		"START-DATE":      pdt,
+		"programDataTime": "2022-01-20T12:16:00Z",
+	}
+	kv := parsers.NewAttrKVPairs()
+	err := kv.UnmarshalYAML(func(v interface{}) error {
+		*(v.(*map[string]interface{})) = values
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("UnmarshalYAML : %v", err)
+	}
+	if kv.Get(common.M3U8Bandwidth) != int64(1280000) || kv.Get(common.M3U8FrameRate) != 29.97 ||
+		kv.Get(common.M3U8Default) != true || kv.Get(common.M3U8StartDate) != pdt || kv.Get(common.INTProgramDateTime) != pdt {
+		t.Errorf("values : got %v", kv)
+	}
+	encoded, err := kv.MarshalYAML()
+	if err != nil {
+		t.Fatalf("MarshalYAML : %v", err)
+	}
+	m := encoded.(map[string]interface{})
+	if m["BANDWIDTH"] != int64(1280000) || m["START-DATE"] != "2022-01-20T12:16:00Z" {
+		t.Errorf("encoded : got %v", m)
+	}
+}
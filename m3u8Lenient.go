@@ -0,0 +1,80 @@
+package m3u8reader
+
+import (
+	"fmt"
+
+	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+//Severity - of a Diagnostic
+type Severity int
+
+const (
+	//SeverityWarning - playlist not as per the specification, the entries are kept
+	SeverityWarning Severity = iota
+	//SeverityError - record could not be parsed and is skipped
+	SeverityError
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	}
+	return fmt.Sprintf("Severity(%d)", int(s))
+}
+
+//Diagnostic - problem found in the playlist in lenient mode
+//Position and tag are that of the ParseError
+type Diagnostic struct {
+	Severity Severity
+	*parsers.ParseError
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%v : %v", d.Severity, d.ParseError)
+}
+
+//SetLenient - when enabled records that fail to parse don't abort the parsing
+//They are skipped and a Diagnostic is added to Diagnostics
+//In lossless mode the records skipped are kept as M3U8UNKNOWNTAG entries
+func (m *M3U8) SetLenient(lenient bool) {
+	m.lenient = lenient
+}
+
+//addDiagnostic - err is expected to be a ParseError, others are reported as ErrSyntax
+func (m *M3U8) addDiagnostic(severity Severity, err error) {
+	pe, ok := err.(*parsers.ParseError)
+	if !ok {
+		pe = parsers.NewParseError(parsers.ErrSyntax, common.M3U8UNKNOWNTAG, parsers.NoAttr, err)
+	}
+	m.Diagnostics = append(m.Diagnostics, Diagnostic{Severity: severity, ParseError: pe})
+}
+
+//checkHeader - the parsers accept playlists not starting with EXTM3U, report it
+func (m *M3U8) checkHeader(data []byte) {
+	line, _ := nextLine(data)
+	if lineTag(line) == common.M3U8FormatIdentifier {
+		return
+	}
+	err := parsers.NewParseError(parsers.ErrSyntax, common.M3U8FormatIdentifier, parsers.NoAttr,
+		fmt.Errorf("playlist expected to start with the tag"))
+	m.addDiagnostic(SeverityWarning, parsers.Locate(err, parsers.ErrSyntax, common.M3U8FormatIdentifier, parsers.StartPosition()))
+}
+
+//parseLenient - data is parsed in one go, record by record only if that fails
+//so that valid playlists don't pay for the lenient mode
+func (m *M3U8) parseLenient(data []byte) (n int, err error) {
+	p := m.getParser()
+	n, err = p.ParseData(data, m, m.getBuffer())
+	if err == nil {
+		m.checkHeader(data)
+		return
+	}
+	m.Done()
+	m.Init()
+	return m.parseRecords(data, false)
+}
@@ -0,0 +1,284 @@
+package m3u8reader
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+//Every record is parsed on its own after this header
+//so that any parser can be used irrespective of its handling of the start of data
+var losslessHeader = []byte("#EXTM3U\n")
+
+//losslessHandler - records the raw bytes of one record along with the parsed entry
+type losslessHandler struct {
+	m          *M3U8
+	raw        []byte
+	span       []byte
+	inner      bool
+	attrOrder  []common.AttrId
+	skipHeader bool
+	posted     int
+}
+
+func (h *losslessHandler) PostRecord(tag common.TagId, kvpairs *parsers.AttrKVPairs) error {
+	if h.skipHeader && tag == common.M3U8FormatIdentifier {
+		h.skipHeader = false
+		if kvpairs != nil {
+			kvpairs.Done()
+		}
+		return nil
+	}
+	if kvpairs == nil {
+		kvpairs = parsers.NewAttrKVPairs()
+	}
+	entry := M3U8Entry{Tag: tag, Values: kvpairs}
+	if h.posted == 0 && h.raw != nil {
+		entry.setRaw(h.raw)
+		entry.AttrOrder = h.attrOrder
+		entry.span = h.span
+		entry.inner = h.inner
+	}
+	h.posted++
+	return h.m.postRecordEntry(entry)
+}
+
+//SetLossless - when enabled every input line is kept as M3U8Entry
+//Comments, blank lines and unknown tags are kept as M3U8UNKNOWNTAG entries
+//Entries hold the raw bytes read, which are written back as is unless modified
+func (m *M3U8) SetLossless(lossless bool) {
+	m.lossless = lossless
+}
+
+//nextLine - returns the line including the line terminator and the remaining data
+func nextLine(data []byte) (line []byte, remain []byte) {
+	pos := bytes.IndexByte(data, '\n')
+	if pos < 0 {
+		return data, nil
+	}
+	return data[:pos+1], data[pos+1:]
+}
+
+//trimEOL - removes the line terminator
+func trimEOL(line []byte) []byte {
+	line = bytes.TrimSuffix(line, []byte{'\n'})
+	return bytes.TrimSuffix(line, []byte{'\r'})
+}
+
+//lineTag - returns the TagId of the line, M3U8UNKNOWNTAG if not a known tag
+func lineTag(line []byte) common.TagId {
+	line = trimEOL(line)
+	if !bytes.HasPrefix(line, []byte("#EXT")) {
+		return common.M3U8UNKNOWNTAG
+	}
+	name := line[1:]
+	if pos := bytes.IndexByte(name, ':'); pos >= 0 {
+		name = name[:pos]
+	}
+	tagId, ok := common.TagToTagId[string(name)]
+	if !ok {
+		return common.M3U8UNKNOWNTAG
+	}
+	return tagId
+}
+
+//groupsURI - the tag is followed by an URI line
+func groupsURI(tagId common.TagId) bool {
+	return tagLayouts[tagId] == layoutExtInf || tagLayouts[tagId] == layoutAttributeListURI
+}
+
+//lossRecord - next record of the data
+type lossRecord struct {
+	tagId common.TagId
+	raw   []byte   //bytes of the record, for tags with an URI line the tag line, blank lines and URI line
+	parse []byte   //bytes parsed, raw without the blank lines
+	inner [][]byte //lines starting with # between the tag line and its URI line, records of their own
+	span  []byte   //tag line to URI line as in the data, set if inner
+}
+
+//nextRecord - splits the next record from data
+//A record is a single line, except for tags followed by an URI line
+//Blank lines and tag lines between the tag and its URI line are allowed, e.g. #EXT-X-CUE-OUT after #EXTINF
+//The tag lines are kept as records of their own, see lossRecord.inner
+func nextRecord(data []byte) (rec lossRecord, remain []byte) {
+	line, remain := nextLine(data)
+	rec.tagId = lineTag(line)
+	rec.raw, rec.parse = line, line
+	if !groupsURI(rec.tagId) {
+		return
+	}
+	var inner [][]byte
+	var blanks []byte
+	for rest := remain; len(rest) > 0; {
+		var next []byte
+		next, rest = nextLine(rest)
+		text := trimEOL(next)
+		switch {
+		case len(text) == 0:
+			blanks = append(blanks, next...)
+		case text[0] == '#':
+			if groupsURI(lineTag(next)) {
+				//URI line missing, the tag is parsed on its own
+				return
+			}
+			inner = append(inner, next)
+		default:
+			n := len(data) - len(rest)
+			if len(inner) > 0 || len(blanks) > 0 {
+				rec.raw = append(append(append([]byte(nil), line...), blanks...), next...)
+				rec.parse = append(append([]byte(nil), line...), next...)
+			} else {
+				rec.raw, rec.parse = data[:n], data[:n]
+			}
+			if len(inner) > 0 {
+				rec.inner, rec.span = inner, data[:n]
+			}
+			return rec, rest
+		}
+	}
+	return
+}
+
+//attributeOrder - returns the order of the attributes as present in the tag line
+func attributeOrder(line []byte) []common.AttrId {
+	line = trimEOL(line)
+	pos := bytes.IndexByte(line, ':')
+	if pos < 0 {
+		return nil
+	}
+	line = line[pos+1:]
+	order := make([]common.AttrId, 0, 8)
+	inQuotes := false
+	start := 0
+	for i := 0; i <= len(line); i++ {
+		if i < len(line) {
+			if line[i] == '"' {
+				inQuotes = !inQuotes
+			}
+			if inQuotes || line[i] != ',' {
+				continue
+			}
+		}
+		attr := line[start:i]
+		start = i + 1
+		if eq := bytes.IndexByte(attr, '='); eq > 0 {
+			if attrId, ok := common.AttrToAttrId[string(attr[:eq])]; ok {
+				order = append(order, attrId)
+			}
+		}
+	}
+	return order
+}
+
+//relocate - position of the ParseError in the record parsed to that in the data
+//The record is parsed after losslessHeader
+func relocate(err error, recordPos parsers.Position) {
+	var pe *parsers.ParseError
+	if !errors.As(err, &pe) || pe.Line < 2 {
+		return
+	}
+	pe.Line += recordPos.Line - 2
+	pe.Offset += recordPos.Offset - len(losslessHeader)
+}
+
+func (m *M3U8) parseLossless(data []byte) (n int, err error) {
+	//Entries keep slices of the raw data, don't hold on to caller's buffer
+	data = append([]byte(nil), data...)
+	return m.parseRecords(data, true)
+}
+
+//postRaw - record kept as is in an M3U8UNKNOWNTAG entry
+func (m *M3U8) postRaw(record []byte, inner bool) error {
+	kv := parsers.NewAttrKVPairs()
+	kv.Store(common.INTUnknownAttr, string(trimEOL(record)))
+	entry := M3U8Entry{Tag: common.M3U8UNKNOWNTAG, Values: kv, inner: inner}
+	entry.setRaw(record)
+	return m.postRecordEntry(entry)
+}
+
+//parseRecords - parses every record on its own
+//keepRaw : entries hold the raw record and unknown records are kept (lossless)
+//In lenient mode records failing are skipped with a Diagnostic, kept as is if keepRaw
+func (m *M3U8) parseRecords(data []byte, keepRaw bool) (n int, err error) {
+	if m.lenient {
+		m.checkHeader(data)
+	}
+	chunk := make([]byte, 0, 1024)
+	pos := parsers.StartPosition()
+	for len(data) > 0 {
+		var rec lossRecord
+		rec, data = nextRecord(data)
+		recordPos := pos
+		if rec.span != nil {
+			pos = pos.Advance(rec.span)
+			n += len(rec.span)
+		} else {
+			pos = pos.Advance(rec.raw)
+			n += len(rec.raw)
+		}
+		//the tag lines between the tag and its URI line apply to the same segment, they come first
+		line, rest := nextLine(rec.span)
+		innerPos := recordPos.Advance(line)
+		for len(rec.inner) > 0 {
+			line, rest = nextLine(rest)
+			if text := trimEOL(line); len(text) > 0 {
+				err = m.parseRecord(&chunk, lossRecord{tagId: lineTag(line), raw: line, parse: line}, innerPos, keepRaw, true)
+				if err != nil {
+					return
+				}
+				rec.inner = rec.inner[1:]
+			}
+			innerPos = innerPos.Advance(line)
+		}
+		err = m.parseRecord(&chunk, rec, recordPos, keepRaw, false)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+//parseRecord - parses the record at recordPos, inner if between a tag and its URI line
+func (m *M3U8) parseRecord(chunk *[]byte, rec lossRecord, recordPos parsers.Position, keepRaw bool, inner bool) (err error) {
+	if rec.tagId == common.M3U8UNKNOWNTAG {
+		if !keepRaw {
+			return
+		}
+		return m.postRaw(rec.raw, inner)
+	}
+	h := &losslessHandler{m: m, skipHeader: true}
+	if keepRaw {
+		h.raw, h.span, h.inner = rec.raw, rec.span, inner
+		if tagLayouts[rec.tagId] == layoutAttributeList || tagLayouts[rec.tagId] == layoutAttributeListURI {
+			h.attrOrder = attributeOrder(rec.raw)
+		}
+	}
+	*chunk = append((*chunk)[:0], losslessHeader...)
+	*chunk = append(*chunk, rec.parse...)
+	p := m.getParser()
+	_, err = p.ParseData(*chunk, h, m.getBuffer())
+	if err == nil {
+		return
+	}
+	relocate(err, recordPos)
+	if !m.lenient {
+		return
+	}
+	err = parsers.Locate(err, parsers.ErrSyntax, rec.tagId, recordPos)
+	if h.posted > 0 {
+		m.addDiagnostic(SeverityWarning, err)
+		return nil
+	}
+	m.addDiagnostic(SeverityError, err)
+	if rec.tagId == common.M3U8ExtInf {
+		//segment skipped still has its media sequence number
+		m.nextMediaSequenceNumber++
+		m.nextPartNumber = 0
+	}
+	if keepRaw {
+		return m.postRaw(rec.raw, inner)
+	}
+	return nil
+}
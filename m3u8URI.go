@@ -0,0 +1,78 @@
+package m3u8reader
+
+import (
+	"fmt"
+	"net/url"
+)
+
+//QueryInherit - how the query of the base URL is carried over to the URIs resolved against it
+type QueryInherit int
+
+const (
+	//QueryInheritNone - RFC 3986 section 5.2, the query is only inherited by an empty reference
+	QueryInheritNone QueryInherit = iota
+	//QueryInheritIfEmpty - the query of the base is used by the URIs without one
+	QueryInheritIfEmpty
+	//QueryInheritMerge - the parameters of the base missing in the URI are added to it
+	QueryInheritMerge
+)
+
+//SetBaseURL - URL the playlist was loaded from, the relative URIs are resolved against it
+//Per RFC 8216bis section 4.1 this is the URL after any redirect
+func (m *M3U8) SetBaseURL(base *url.URL) {
+	m.baseURL = base
+}
+
+//BaseURL - set by SetBaseURL, nil if not set
+func (m *M3U8) BaseURL() *url.URL {
+	return m.baseURL
+}
+
+//SetQueryInherit - QueryInheritNone by default
+//Used for CDN tokens passed in the query of the playlist URL and expected on every request
+//The query is only carried over to URIs on the same scheme and host as the base
+func (m *M3U8) SetQueryInherit(inherit QueryInherit) {
+	m.queryInherit = inherit
+}
+
+//ResolveURI - uri resolved against the base URL
+//Relative URIs require the base URL, absolute ones only inherit its query (see SetQueryInherit)
+func (m *M3U8) ResolveURI(uri string) (resolved *url.URL, err error) {
+	ref, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("URI %v : %w", uri, err)
+	}
+	resolved = ref
+	if !ref.IsAbs() {
+		if m.baseURL == nil {
+			return nil, fmt.Errorf("URI %v is relative and base URL not set", uri)
+		}
+		resolved = m.baseURL.ResolveReference(ref)
+	}
+	if m.baseURL == nil || m.queryInherit == QueryInheritNone || m.baseURL.RawQuery == "" ||
+		resolved.Scheme != m.baseURL.Scheme || resolved.Host != m.baseURL.Host {
+		return
+	}
+	switch {
+	case resolved.RawQuery == "":
+		resolved.RawQuery = m.baseURL.RawQuery
+	case m.queryInherit == QueryInheritMerge:
+		query := resolved.Query()
+		for k, v := range m.baseURL.Query() {
+			if _, ok := query[k]; !ok {
+				query[k] = v
+			}
+		}
+		resolved.RawQuery = query.Encode()
+	}
+	return
+}
+
+//ResolvedURI - URI of the entry resolved against the base URL, see M3U8Entry.URI and ResolveURI
+func (m *M3U8) ResolvedURI(entry *M3U8Entry) (*url.URL, error) {
+	uri, err := entry.URI()
+	if err != nil {
+		return nil, err
+	}
+	return m.ResolveURI(uri)
+}
@@ -0,0 +1,156 @@
+package m3u8reader_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/eswarantg/m3u8reader"
+	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+const uriMaster = `#EXTM3U
+#EXT-X-VERSION:9
+#EXT-X-CONTENT-STEERING:SERVER-URI="/steering?video=1"
+#EXT-X-SESSION-DATA:DATA-ID="com.example.title",URI="data/title.json"
+#EXT-X-SESSION-DATA:DATA-ID="com.example.lang",VALUE="en"
+#EXT-X-SESSION-KEY:METHOD=SAMPLE-AES,URI="skd://key1",KEYFORMAT="com.apple.streamingkeydelivery"
+#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID="aud",NAME="English",LANGUAGE="en",URI="audio/en.m3u8"
+#EXT-X-MEDIA:TYPE=CLOSED-CAPTIONS,GROUP-ID="cc",NAME="English",LANGUAGE="en",INSTREAM-ID="CC1"
+#EXT-X-STREAM-INF:BANDWIDTH=1000000,AUDIO="aud"
+video/low.m3u8?bitrate=1
+#EXT-X-I-FRAME-STREAM-INF:BANDWIDTH=100000,URI="../iframes/low.m3u8"
+`
+
+const uriMedia = `#EXTM3U
+#EXT-X-VERSION:9
+#EXT-X-TARGETDURATION:4
+#EXT-X-PART-INF:PART-TARGET=1
+#EXT-X-MAP:URI="init.mp4"
+#EXT-X-KEY:METHOD=AES-128,URI="https://keys.example.com/key1?token=k"
+#EXTINF:4.0,
+seg0.mp4
+#EXT-X-PART:DURATION=1.0,URI="seg1.p0.mp4"
+#EXT-X-KEY:METHOD=NONE
+#EXT-X-PRELOAD-HINT:TYPE=PART,URI="seg1.p1.mp4"
+#EXT-X-RENDITION-REPORT:URI="/live/audio.m3u8",LAST-MSN=1,LAST-PART=0
+#EXT-X-RENDITION-REPORT:URI="https://cdn.example.com/live/video.m3u8",LAST-MSN=1,LAST-PART=0
+`
+
+func Test_ResolvedURI(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	base, _ := url.Parse("https://cdn.example.com/live/main.m3u8?token=abc")
+	tests := []struct {
+		name     string
+		playlist string
+		inherit  m3u8reader.QueryInherit
+		expected map[common.TagId][]string //resolved URI of the entries of the tag in order, "" if none
+	}{
+		{"master", uriMaster, m3u8reader.QueryInheritNone, map[common.TagId][]string{
+			common.M3U8ExtXContentSteering: {"https://cdn.example.com/steering?video=1"},
+			common.M3U8ExtXSessionData:     {"https://cdn.example.com/live/data/title.json", ""},
+			common.M3U8ExtXSesionKey:       {"skd://key1"},
+			common.M3U8ExtXMedia:           {"https://cdn.example.com/live/audio/en.m3u8", ""},
+			common.M3U8ExtXStreamInf:       {"https://cdn.example.com/live/video/low.m3u8?bitrate=1"},
+			common.M3U8ExtXIFrameStreamInf: {"https://cdn.example.com/iframes/low.m3u8"},
+		}},
+		{"master inherit", uriMaster, m3u8reader.QueryInheritIfEmpty, map[common.TagId][]string{
+			common.M3U8ExtXContentSteering: {"https://cdn.example.com/steering?video=1"},
+			common.M3U8ExtXSessionData:     {"https://cdn.example.com/live/data/title.json?token=abc", ""},
+			common.M3U8ExtXSesionKey:       {"skd://key1"},
+			common.M3U8ExtXMedia:           {"https://cdn.example.com/live/audio/en.m3u8?token=abc", ""},
+			common.M3U8ExtXStreamInf:       {"https://cdn.example.com/live/video/low.m3u8?bitrate=1"},
+			common.M3U8ExtXIFrameStreamInf: {"https://cdn.example.com/iframes/low.m3u8?token=abc"},
+		}},
+		{"master merge", uriMaster, m3u8reader.QueryInheritMerge, map[common.TagId][]string{
+			common.M3U8ExtXContentSteering: {"https://cdn.example.com/steering?token=abc&video=1"},
+			common.M3U8ExtXStreamInf:       {"https://cdn.example.com/live/video/low.m3u8?bitrate=1&token=abc"},
+		}},
+		{"media", uriMedia, m3u8reader.QueryInheritNone, map[common.TagId][]string{
+			common.M3U8ExtXMap:             {"https://cdn.example.com/live/init.mp4"},
+			common.M3U8ExtXKey:             {"https://keys.example.com/key1?token=k", ""},
+			common.M3U8ExtInf:              {"https://cdn.example.com/live/seg0.mp4"},
+			common.M3U8ExtXPart:            {"https://cdn.example.com/live/seg1.p0.mp4"},
+			common.M3U8ExtXPreLoadHint:     {"https://cdn.example.com/live/seg1.p1.mp4"},
+			common.M3U8ExtXRenditionReport: {"https://cdn.example.com/live/audio.m3u8", "https://cdn.example.com/live/video.m3u8"},
+		}},
+		{"media inherit", uriMedia, m3u8reader.QueryInheritIfEmpty, map[common.TagId][]string{
+			common.M3U8ExtXMap: {"https://cdn.example.com/live/init.mp4?token=abc"},
+			//not carried over to another host
+			common.M3U8ExtXKey:             {"https://keys.example.com/key1?token=k", ""},
+			common.M3U8ExtInf:              {"https://cdn.example.com/live/seg0.mp4?token=abc"},
+			common.M3U8ExtXPart:            {"https://cdn.example.com/live/seg1.p0.mp4?token=abc"},
+			common.M3U8ExtXPreLoadHint:     {"https://cdn.example.com/live/seg1.p1.mp4?token=abc"},
+			//absolute URI on the same scheme and host
+			common.M3U8ExtXRenditionReport: {"https://cdn.example.com/live/audio.m3u8?token=abc", "https://cdn.example.com/live/video.m3u8?token=abc"},
+		}},
+	}
+	for _, test := range tests {
+		m := &m3u8reader.M3U8{}
+		m.SetBaseURL(base)
+		m.SetQueryInherit(test.inherit)
+		_, err := m.ParseData([]byte(test.playlist))
+		if err != nil {
+			t.Fatalf("%v : ParseData : %v", test.name, err)
+		}
+		got := make(map[common.TagId][]string)
+		for i := range m.Entries {
+			entry := &m.Entries[i]
+			if _, ok := test.expected[entry.Tag]; !ok {
+				continue
+			}
+			var uri string
+			u, err := m.ResolvedURI(entry)
+			if err == nil {
+				uri = u.String()
+			}
+			got[entry.Tag] = append(got[entry.Tag], uri)
+		}
+		for tag, expected := range test.expected {
+			if len(got[tag]) != len(expected) {
+				t.Errorf("%v : %v : expected %v : got %v", test.name, common.TagNames[tag], expected, got[tag])
+				continue
+			}
+			for i := range expected {
+				if got[tag][i] != expected[i] {
+					t.Errorf("%v : %v %v : expected %v : got %v", test.name, common.TagNames[tag], i, expected[i], got[tag][i])
+				}
+			}
+		}
+	}
+}
+
+func Test_ResolveURI(t *testing.T) {
+	m := &m3u8reader.M3U8{}
+	_, err := m.ResolveURI("seg0.mp4")
+	if err == nil {
+		t.Errorf("relative URI without base URL : error expected")
+	}
+	u, err := m.ResolveURI("https://cdn.example.com/seg0.mp4")
+	if err != nil || u.String() != "https://cdn.example.com/seg0.mp4" {
+		t.Errorf("absolute URI without base URL : got %v %v", u, err)
+	}
+	base, _ := url.Parse("http://a/b/c/d;p?q")
+	m.SetBaseURL(base)
+	//RFC 3986 section 5.4
+	tests := []struct {
+		uri      string
+		expected string
+	}{
+		{"g", "http://a/b/c/g"},
+		{"./g", "http://a/b/c/g"},
+		{"/g", "http://a/g"},
+		{"//g", "http://g"},
+		{"?y", "http://a/b/c/d;p?y"},
+		{"g?y", "http://a/b/c/g?y"},
+		{"", "http://a/b/c/d;p?q"},
+		{"../../g", "http://a/g"},
+		{"../../../g", "http://a/g"},
+	}
+	for _, test := range tests {
+		u, err := m.ResolveURI(test.uri)
+		if err != nil || u.String() != test.expected {
+			t.Errorf("%v : expected %v : got %v %v", test.uri, test.expected, u, err)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package m3u8reader
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+//How the value(s) of a Tag are laid out after the "#TAG"
+type tagLayout int
+
+const (
+	layoutAttributeList    tagLayout = iota //#TAG:ATTR=VAL,ATTR=VAL
+	layoutNoValue                           //#TAG
+	layoutSingleValue                       //#TAG:<value>
+	layoutExtInf                            //#EXTINF:<duration>,[<title>]\n<URI>
+	layoutAttributeListURI                  //#TAG:ATTR=VAL,ATTR=VAL\n<URI>
+)
+
+//Tags not listed here are written as attribute lists
+var tagLayouts = map[common.TagId]tagLayout{
+	common.M3U8FormatIdentifier:          layoutNoValue,
+	common.M3U8ExtXVersion:               layoutSingleValue,
+	common.M3U8ExtXIndependentSegments:   layoutNoValue,
+	common.M3U8ExtXStreamInf:             layoutAttributeListURI,
+	common.M3U8TargetDuration:            layoutSingleValue,
+	common.M3U8ExtXMediaSequence:         layoutSingleValue,
+	common.M3U8ExtInf:                    layoutExtInf,
+	common.M3U8ExtXIProgramDateTime:      layoutSingleValue,
+	common.M3U8ExtXDiscontinuity:         layoutNoValue,
+	common.M3U8ExtXEndList:               layoutNoValue,
+	common.M3U8ExtXPlaylistType:          layoutSingleValue,
+	common.M3U8ExtXByteRange:             layoutSingleValue,
+	common.M3U8ExtXDiscontinuitySequence: layoutSingleValue,
+	common.M3U8ExtXIFramesOnly:           layoutNoValue,
+	common.M3U8ExtXGap:                   layoutNoValue,
+	common.M3U8ExtXBitrate:               layoutSingleValue,
+}
+
+//Attributes whose value is a quoted-string as per RFC 8216 section 4.2
+//All others are written as is (enumerated-string, decimal, hex, resolution)
+var quotedStringAttrs = map[common.AttrId]bool{
+	common.M3U8Codecs:                    true,
+	common.M3U8Audio:                     true,
+	common.M3U8GroupId:                   true,
+	common.M3U8Name:                      true,
+	common.M3U8Language:                  true,
+	common.M3U8Channels:                  true,
+	common.M3U8Uri:                       true,
+	common.M3U8KeyFormat:                 true,
+	common.M3U8KeyFormatVersions:         true,
+	common.M3U8ByteRange:                 true,
+	common.M3U8Id:                        true,
+	common.M3U8Class:                     true,
+	common.M3U8StartDate:                 true,
+	common.M3U8EndDate:                   true,
+	common.M3U8AssocLanguage:             true,
+	common.M3U8InStreamId:                true,
+	common.M3U8Characteristics:           true,
+	common.M3U8Video:                     true,
+	common.M3U8Subtitles:                 true,
+	common.M3U8ClosedCaptions:            true,
+	common.M3U8DataId:                    true,
+	common.M3U8Value:                     true,
+	common.M3U8Import:                    true,
+	common.M3U8QueryParam:                true,
+	common.M3U8ServerUri:                 true,
+	common.M3U8PathwayId:                 true,
+	common.M3U8RecentlyRemovedDateRanges: true,
+}
+
+//Attributes used only within the library, never written out
+var internalAttrs = map[common.AttrId]bool{
+	common.INTUnknownAttr:         true,
+	common.INTProgramDateTime:     true,
+	common.INTMediaSequenceNumber: true,
+	common.INTPartNumber:          true,
+	common.M3U8Title:              true,
+}
+
+//formatAttrValue - formats the value and quotes it if the attribute requires it
+func formatAttrValue(attrId common.AttrId, val interface{}) (string, error) {
+	str, err := parsers.FormatValue(val)
+	if err != nil {
+		return "", fmt.Errorf("%v : %w", common.AttrNames[attrId], err)
+	}
+	if !quotedStringAttrs[attrId] {
+		return str, nil
+	}
+	//CLOSED-CAPTIONS is an enumerated-string when NONE
+	if attrId == common.M3U8ClosedCaptions && str == "NONE" {
+		return str, nil
+	}
+	return "\"" + str + "\"", nil
+}
+
+//writeAttributeList - writes ATTR=VAL pairs in AttrOrder followed by the rest in AttrId order
+func (m *M3U8Entry) writeAttributeList(w *bufio.Writer) error {
+	written := make(map[common.AttrId]bool, len(m.AttrOrder))
+	writeAttr := func(attrId common.AttrId) error {
+		if internalAttrs[attrId] || written[attrId] {
+			return nil
+		}
+		val := m.Values.Get(attrId)
+		if val == nil {
+			return nil
+		}
+		str, err := formatAttrValue(attrId, val)
+		if err != nil {
+			return fmt.Errorf("%v:%w", common.TagNames[m.Tag], err)
+		}
+		if len(written) > 0 {
+			w.WriteByte(',')
+		}
+		written[attrId] = true
+		w.WriteString(common.AttrNames[attrId])
+		w.WriteByte('=')
+		w.WriteString(str)
+		return nil
+	}
+	for _, attrId := range m.AttrOrder {
+		if err := writeAttr(attrId); err != nil {
+			return err
+		}
+	}
+	for i := range common.AttrNames {
+		if err := writeAttr(common.AttrId(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//writeOpenValue - writes a value which is not part of attribute list
+func (m *M3U8Entry) writeOpenValue(w *bufio.Writer, attrId common.AttrId, optional bool) error {
+	val := m.Values.Get(attrId)
+	if val == nil {
+		if optional {
+			return nil
+		}
+		return fmt.Errorf("%v:%v value not found", common.TagNames[m.Tag], common.AttrNames[attrId])
+	}
+	str, err := parsers.FormatValue(val)
+	if err != nil {
+		return fmt.Errorf("%v:%v : %w", common.TagNames[m.Tag], common.AttrNames[attrId], err)
+	}
+	w.WriteString(str)
+	return nil
+}
+
+func (m *M3U8Entry) write(w *bufio.Writer) (err error) {
+	if m.rawValid() {
+		w.Write(m.Raw)
+		return
+	}
+	if m.Tag == common.M3U8UNKNOWNTAG {
+		//comment, blank line or unknown tag kept in lossless mode
+		err = m.writeOpenValue(w, common.INTUnknownAttr, false)
+		if err == nil {
+			w.WriteByte('\n')
+		}
+		return
+	}
+	w.WriteByte('#')
+	w.WriteString(common.TagNames[m.Tag])
+	switch tagLayouts[m.Tag] {
+	case layoutNoValue:
+	case layoutSingleValue:
+		w.WriteByte(':')
+		err = m.writeOpenValue(w, common.INTUnknownAttr, false)
+	case layoutExtInf:
+		w.WriteByte(':')
+		err = m.writeOpenValue(w, common.INTUnknownAttr, false)
+		if err != nil {
+			return
+		}
+		w.WriteByte(',')
+		err = m.writeOpenValue(w, common.M3U8Title, true)
+		if err != nil {
+			return
+		}
+		w.WriteByte('\n')
+		err = m.writeOpenValue(w, common.M3U8Uri, false)
+	case layoutAttributeListURI:
+		w.WriteByte(':')
+		err = m.writeAttributeList(w)
+		if err != nil {
+			return
+		}
+		w.WriteByte('\n')
+		err = m.writeOpenValue(w, common.INTUnknownAttr, false)
+	default:
+		w.WriteByte(':')
+		err = m.writeAttributeList(w)
+	}
+	if err != nil {
+		return
+	}
+	w.WriteByte('\n')
+	return
+}
+
+//WriteTo - writes the entry as playlist text including the trailing newline
+func (m *M3U8Entry) WriteTo(w io.Writer) (n int64, err error) {
+	cw := &countWriter{w: w}
+	bw := bufio.NewWriter(cw)
+	err = m.write(bw)
+	if err == nil {
+		err = bw.Flush()
+	}
+	return cw.n, err
+}
+
+//WriteTo - writes the playlist as RFC 8216 text
+//#EXTM3U is added if the first entry is not the format identifier, except in lossless mode
+//In lossless mode unmodified entries are written back byte for byte
+func (m *M3U8) WriteTo(w io.Writer) (n int64, err error) {
+	cw := &countWriter{w: w}
+	bw := bufio.NewWriter(cw)
+	if !m.lossless && (len(m.Entries) == 0 || m.Entries[0].Tag != common.M3U8FormatIdentifier) {
+		bw.WriteString("#EXTM3U\n")
+	}
+	for i := 0; i < len(m.Entries); i++ {
+		if span := m.innerSpan(i); span != nil {
+			//tag lines read between a tag and its URI line are written back in place
+			bw.Write(span)
+			for m.Entries[i].inner {
+				i++
+			}
+			continue
+		}
+		err = m.Entries[i].write(bw)
+		if err != nil {
+			return cw.n, err
+		}
+	}
+	err = bw.Flush()
+	return cw.n, err
+}
+
+//innerSpan - bytes read for the entries from i, inner entries and the tag they precede, nil if any is modified
+//Written back in place of the entries in lossless mode
+func (m *M3U8) innerSpan(i int) []byte {
+	if !m.Entries[i].inner {
+		return nil
+	}
+	for ; i < len(m.Entries) && m.Entries[i].inner; i++ {
+		if !m.Entries[i].rawValid() {
+			return nil
+		}
+	}
+	if i == len(m.Entries) || !m.Entries[i].rawValid() {
+		return nil
+	}
+	return m.Entries[i].span
+}
+
+//Marshal - returns the playlist as RFC 8216 text
+func (m *M3U8) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+	_, err := m.WriteTo(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,280 @@
+package m3u8reader_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/eswarantg/m3u8reader"
+	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+func Test_MarshalRoundTrip(t *testing.T) {
+	tests := []string{
+		"test/LLHLS.m3u8",
+		"test/cdvr-sub-manifest.m3u8",
+		"test/hls-ts-main.m3u8",
+		"test/index_new.m3u8",
+		"test/ll_hls_delta_update.m3u8",
+		"test/ll_hls_gap.m3u8",
+		"test/ll_hls_pl.m3u8",
+		"test/lowLatencyHLS.m3u8",
+		"test/main-manifest.m3u8",
+		"test/media_bytes.m3u8",
+		"test/sub.m3u8",
+		"test/tv5_TS-50002_1_video.m3u8",
+	}
+	parsers.AttrKVPairsSyncPool = false
+	buffer := make([]byte, 4096)
+	for i, file := range tests {
+		fmt.Printf("\n********* Test %v - %v ************", i, file)
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Errorf("Unable to open file %v", file)
+			continue
+		}
+		manifest := m3u8reader.M3U8{}
+		manifest.SetBuffer(buffer)
+		_, err = manifest.ParseData(data)
+		if err != nil {
+			t.Errorf("%v : %v", file, err)
+			continue
+		}
+		out, err := manifest.Marshal()
+		if err != nil {
+			t.Errorf("%v : Marshal %v", file, err)
+			continue
+		}
+		reparsed := m3u8reader.M3U8{}
+		reparsed.SetBuffer(buffer)
+		_, err = reparsed.ParseData(out)
+		if err != nil {
+			t.Errorf("%v : reparse %v\n%v", file, err, string(out))
+			continue
+		}
+		if len(manifest.Entries) != len(reparsed.Entries) {
+			t.Errorf("%v : entries expected %v : got %v", file, len(manifest.Entries), len(reparsed.Entries))
+			continue
+		}
+		for j := range manifest.Entries {
+			exp := manifest.Entries[j]
+			got := reparsed.Entries[j]
+			if exp.Tag != got.Tag {
+				t.Errorf("%v : %v : tag expected %v : got %v", file, j, common.TagNames[exp.Tag], common.TagNames[got.Tag])
+				continue
+			}
+			if !reflect.DeepEqual(exp.Values.Map(), got.Values.Map()) {
+				t.Errorf("%v : %v : values expected %v : got %v", file, j, exp.String(), got.String())
+			}
+		}
+	}
+}
+
+func Test_MarshalQuoting(t *testing.T) {
+	kv := parsers.NewAttrKVPairs()
+	kv.Store(common.M3U8Type, "AUDIO")
+	kv.Store(common.M3U8GroupId, "aac")
+	kv.Store(common.M3U8Name, "English")
+	kv.Store(common.M3U8Default, "YES")
+	kv.Store(common.M3U8Uri, "audio/en.m3u8")
+	media := m3u8reader.M3U8Entry{Tag: common.M3U8ExtXMedia, Values: kv}
+
+	kv = parsers.NewAttrKVPairs()
+	kv.Store(common.M3U8Bandwidth, int64(1280000))
+	kv.Store(common.M3U8Resolution, "640x360")
+	kv.Store(common.M3U8FrameRate, float64(29.97))
+	kv.Store(common.M3U8Codecs, "avc1.4d401e,mp4a.40.2")
+	kv.Store(common.M3U8Audio, "aac")
+	kv.Store(common.M3U8ClosedCaptions, "NONE")
+	kv.Store(common.INTUnknownAttr, "low/index.m3u8")
+	variant := m3u8reader.M3U8Entry{Tag: common.M3U8ExtXStreamInf, Values: kv}
+
+	manifest := m3u8reader.M3U8{Entries: []m3u8reader.M3U8Entry{media, variant}}
+	out, err := manifest.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal : %v", err)
+	}
+	expected := strings.Join([]string{
+		"#EXTM3U",
+		"#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID=\"aac\",NAME=\"English\",DEFAULT=YES,URI=\"audio/en.m3u8\"",
+		"#EXT-X-STREAM-INF:BANDWIDTH=1280000,RESOLUTION=640x360,FRAME-RATE=29.97,CODECS=\"avc1.4d401e,mp4a.40.2\",AUDIO=\"aac\",CLOSED-CAPTIONS=NONE",
+		"low/index.m3u8",
+		"",
+	}, "\n")
+	if string(out) != expected {
+		t.Errorf("expected\n%v\ngot\n%v", expected, string(out))
+	}
+}
+
+func Test_LosslessRoundTrip(t *testing.T) {
+	files, err := filepath.Glob("test/*.m3u8")
+	if err != nil {
+		t.Fatalf("Glob : %v", err)
+	}
+	parsers.AttrKVPairsSyncPool = false
+	buffer := make([]byte, 4096)
+	files = append(files, "innerTags")
+	for i, file := range files {
+		fmt.Printf("\n********* Test %v - %v ************", i, file)
+		data := []byte(innerTags)
+		if file != "innerTags" {
+			data, err = os.ReadFile(file)
+			if err != nil {
+				t.Errorf("Unable to open file %v", file)
+				continue
+			}
+		}
+		manifest := m3u8reader.M3U8{}
+		manifest.SetBuffer(buffer)
+		manifest.SetLossless(true)
+		_, err = manifest.ParseData(data)
+		if err != nil {
+			t.Errorf("%v : %v", file, err)
+			continue
+		}
+		out, err := manifest.Marshal()
+		if err != nil {
+			t.Errorf("%v : Marshal %v", file, err)
+			continue
+		}
+		if !bytes.Equal(data, out) {
+			t.Errorf("%v : lossless output differs\n%v", file, string(out))
+		}
+	}
+}
+
+func Test_LosslessModified(t *testing.T) {
+	data := strings.Join([]string{
+		"#EXTM3U",
+		"#EXT-X-VERSION:3",
+		"#EXT-X-TARGETDURATION:10",
+		"#EXT-X-ALLOW-CACHE:NO",
+		"#EXTVLCOPT:network-caching=1000",
+		"",
+		"# vendor comment",
+		"#EXT-X-KEY:URI=\"https://keys.example.com/k1\",METHOD=AES-128",
+		"#EXT-X-CUE-OUT:DURATION=30",
+		"#EXTINF:10.0,",
+		"seg1.ts",
+		"#EXT-X-CUE-IN",
+		"#EXTINF:10.0,",
+		"seg2.ts",
+		"",
+	}, "\r\n")
+	manifest := m3u8reader.M3U8{}
+	manifest.SetBuffer(make([]byte, 4096))
+	manifest.SetLossless(true)
+	_, err := manifest.ParseData([]byte(data))
+	if err != nil {
+		t.Fatalf("ParseData : %v", err)
+	}
+	unknown := 0
+	for i := range manifest.Entries {
+		entry := &manifest.Entries[i]
+		switch entry.Tag {
+		case common.M3U8UNKNOWNTAG:
+			unknown++
+		case common.M3U8ExtXKey:
+			entry.StoreKV(common.M3U8Uri, "https://keys.example.com/k2")
+		case common.M3U8TargetDuration:
+			//changed without StoreKV
+			entry.Values.Store(common.INTUnknownAttr, int64(8))
+		}
+	}
+	if unknown != 6 {
+		t.Errorf("unknown entries expected %v : got %v", 6, unknown)
+	}
+	out, err := manifest.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal : %v", err)
+	}
+	expected := strings.Replace(data,
+		"#EXT-X-KEY:URI=\"https://keys.example.com/k1\",METHOD=AES-128\r\n",
+		"#EXT-X-KEY:URI=\"https://keys.example.com/k2\",METHOD=AES-128\n", 1)
+	expected = strings.Replace(expected, "#EXT-X-TARGETDURATION:10\r\n", "#EXT-X-TARGETDURATION:8\n", 1)
+	if string(out) != expected {
+		t.Errorf("expected\n%q\ngot\n%q", expected, string(out))
+	}
+	//modified entries have no raw bytes in JSON
+	js, _ := json.Marshal(&manifest)
+	decoded := m3u8reader.M3U8{}
+	err = json.Unmarshal(js, &decoded)
+	out, _ = decoded.Marshal()
+	if err != nil || string(out) != expected {
+		t.Errorf("json : expected\n%q\ngot\n%q %v", expected, string(out), err)
+	}
+}
+
+//innerTags - tag lines and blank lines between EXTINF and the URI line
+const innerTags = `#EXTM3U
+#EXT-X-VERSION:3
+#EXT-X-TARGETDURATION:10
+#EXT-X-MEDIA-SEQUENCE:1
+#EXTINF:10.0,
+#EXT-X-CUE-OUT:30
+seg1.ts
+#EXTINF:10.0,
+
+seg2.ts
+#EXTINF:10.0,
+# vendor comment
+#EXT-X-KEY:METHOD=AES-128,URI="key.bin"
+
+seg3.ts
+#EXTINF:10.0,
+#EXT-X-CUE-IN
+seg4.ts
+#EXT-X-ENDLIST
+`
+
+func Test_LosslessInnerTags(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	data := []byte(innerTags)
+	for _, opt := range []m3u8reader.ParserOption{m3u8reader.M3U8ParserScanner1, m3u8reader.M3U8ParserScanner2,
+		m3u8reader.M3U8ParserScanner3, m3u8reader.M3U8ParserGrammar, m3u8reader.M3U8ParserYacc} {
+		manifest := m3u8reader.M3U8{}
+		manifest.SetParserOption(opt)
+		manifest.SetLossless(true)
+		_, err := manifest.ParseData(data)
+		if err != nil {
+			t.Errorf("parser %v : ParseData : %v", opt, err)
+			continue
+		}
+		out, _ := manifest.Marshal()
+		if !bytes.Equal(data, out) {
+			t.Errorf("parser %v : lossless output differs\n%v", opt, string(out))
+		}
+		//kept through JSON
+		js, _ := json.Marshal(&manifest)
+		decoded := m3u8reader.M3U8{}
+		err = json.Unmarshal(js, &decoded)
+		out, _ = decoded.Marshal()
+		if err != nil || !bytes.Equal(data, out) {
+			t.Errorf("parser %v : json output differs\n%v %v", opt, string(out), err)
+		}
+		//the tags between EXTINF and the URI apply to the segment
+		media, err := manifest.MediaPlaylist()
+		if err != nil || len(media.Segments) != 4 || len(media.Segments[2].Keys) != 1 || len(media.Segments[1].Keys) != 0 {
+			t.Errorf("parser %v : segments : got %v %v", opt, media, err)
+			continue
+		}
+		for i := range manifest.Entries {
+			entry := &manifest.Entries[i]
+			if entry.Tag == common.M3U8UNKNOWNTAG && entry.Values.Get(common.INTUnknownAttr) == "#EXT-X-CUE-OUT:30" {
+				entry.StoreKV(common.INTUnknownAttr, "#EXT-X-CUE-OUT:60")
+			}
+		}
+		//the tag modified is written before the EXTINF
+		out, _ = manifest.Marshal()
+		expected := strings.Replace(string(data), "#EXTINF:10.0,\n#EXT-X-CUE-OUT:30\n", "#EXT-X-CUE-OUT:60\n#EXTINF:10.0,\n", 1)
+		if string(out) != expected {
+			t.Errorf("parser %v : expected\n%v\ngot\n%v", opt, expected, string(out))
+		}
+	}
+}
@@ -45,14 +45,10 @@ func NewAttrKVPairsDebug(label string) (ret *AttrKVPairs) {
 	}
 	var ok bool
 	obj := AttrKVPairsPool.Get()
-	if obj == nil {
-		panic(fmt.Sprintf("\nAttrKVPairsPool returned nil : invoked at %v", label))
-		//return
-	}
 	ret, ok = obj.(*AttrKVPairs)
-	if !ok {
-		panic(fmt.Sprintf("\nAttrKVPairsPool returned bad object type %v invoked at %v", reflect.TypeOf(obj).Kind(), label))
-		//return
+	if !ok || ret == nil {
+		//AttrKVPairsPool.New replaced or returning bad objects, don't fail the parsing for it
+		return &AttrKVPairs{m: make(map[common.AttrId]interface{}, 10)}
 	}
 	//fmt.Printf("NewAttrKVPairsDebug return valid for %v\n", label)
 	return ret
@@ -104,10 +100,14 @@ func (a *AttrKVPairs) Exists(k common.AttrId) bool {
 	_, ok = a.m[k]
 	return ok
 }
+//StoreDebug - the map is allocated on first use, &AttrKVPairs{} can be stored to
 func (a *AttrKVPairs) StoreDebug(label string, k common.AttrId, v interface{}) {
 	if a == nil {
 		panic(fmt.Sprintf("\nAttrKVPairs not allocated at %v", label))
 	}
+	if a.m == nil {
+		a.m = make(map[common.AttrId]interface{}, 5)
+	}
 	a.m[k] = v
 }
 
@@ -0,0 +1,156 @@
+package parsers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/eswarantg/m3u8reader/common"
+)
+
+//JSON and YAML encoding of AttrKVPairs
+//Attributes are keyed by common.AttrNames
+//Numbers, booleans and strings are encoded as such, the other kinds as their text in the playlist
+//YAML methods follow gopkg.in/yaml Marshaler and (obsolete) Unmarshaler, no import needed (tested with yaml.v3)
+
+//kindOf - kind of the typed value
+func kindOf(val interface{}) (kind ValueKind, ok bool) {
+	switch val.(type) {
+	case string:
+		return KindString, true
+	case int64:
+		return KindInt64, true
+	case float64:
+		return KindFloat64, true
+	case bool:
+		return KindBool, true
+	case time.Time:
+		return KindTime, true
+	case [16]byte:
+		return KindIV, true
+	case [2]int64:
+		return KindByteRange, true
+	case common.Resolution:
+		return KindResolution, true
+	}
+	return
+}
+
+func encodeValue(val interface{}) interface{} {
+	switch val.(type) {
+	case []byte, time.Time, [16]byte, [2]int64, common.Resolution:
+		if s, err := FormatValue(val); err == nil {
+			return s
+		}
+	}
+	return val
+}
+
+//decodeValue - val as decoded by encoding/json (UseNumber) or yaml into interface{}
+//The open value (INTUnknownAttr) is kept as text as its kind depends on the tag, see TypeValues
+func decodeValue(attrId common.AttrId, val interface{}) (ret interface{}, err error) {
+	switch v := val.(type) {
+	case json.Number:
+		if i, e := v.Int64(); e == nil {
+			val = i
+		} else {
+			val, err = v.Float64()
+		}
+	case int:
+		val = int64(v)
+	case uint64:
+		if v > math.MaxInt64 {
+			err = fmt.Errorf("%v out of range", v)
+		}
+		val = int64(v)
+	case nil:
+		err = fmt.Errorf("no value")
+	}
+	if err != nil {
+		return nil, NewParseError(ErrBadValue, common.M3U8UNKNOWNTAG, attrId, err)
+	}
+	ret, err = ConvertValue(common.M3U8UNKNOWNTAG, attrId, val)
+	if err != nil {
+		return
+	}
+	kind := AttrKind(common.M3U8UNKNOWNTAG, attrId)
+	if s, ok := ret.(string); ok && strings.Contains(s, VariableRef) {
+		return
+	}
+	if got, ok := kindOf(ret); !ok || got != kind {
+		err = NewParseError(ErrBadValue, common.M3U8UNKNOWNTAG, attrId, fmt.Errorf("unexpected %T value", val))
+	}
+	return
+}
+
+//encode - values keyed by the attribute names
+func (a *AttrKVPairs) encode() map[string]interface{} {
+	ret := make(map[string]interface{}, len(a.m))
+	for attrId, val := range a.m {
+		ret[common.AttrNames[attrId]] = encodeValue(val)
+	}
+	return ret
+}
+
+//decode - stores the values keyed by the attribute names
+func (a *AttrKVPairs) decode(values map[string]interface{}) error {
+	if a == nil {
+		return fmt.Errorf("AttrKVPairs not allocated")
+	}
+	if a.m == nil {
+		a.m = make(map[common.AttrId]interface{}, len(values))
+	}
+	for name, val := range values {
+		attrId, ok := common.AttrToAttrId[name]
+		if !ok {
+			return fmt.Errorf("unknown attribute %v", name)
+		}
+		typed, err := decodeValue(attrId, val)
+		if err != nil {
+			return err
+		}
+		a.m[attrId] = typed
+	}
+	return nil
+}
+
+//MarshalJSON - object of the attribute names and values
+func (a *AttrKVPairs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(a.encode())
+}
+
+//UnmarshalJSON - values are added to the ones present
+func (a *AttrKVPairs) UnmarshalJSON(data []byte) error {
+	var values map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	err := dec.Decode(&values)
+	if err != nil {
+		return err
+	}
+	return a.decode(values)
+}
+
+//MarshalYAML - mapping of the attribute names and values
+func (a *AttrKVPairs) MarshalYAML() (interface{}, error) {
+	if a == nil {
+		return nil, nil
+	}
+	return a.encode(), nil
+}
+
+//UnmarshalYAML - values are added to the ones present
+func (a *AttrKVPairs) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var values map[string]interface{}
+	err := unmarshal(&values)
+	if err != nil {
+		return err
+	}
+	return a.decode(values)
+}
@@ -0,0 +1,248 @@
+package parsers
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eswarantg/m3u8reader/common"
+)
+
+//ValueKind - data type an attribute value is stored as in AttrKVPairs
+type ValueKind int
+
+const (
+	KindString     ValueKind = iota //string
+	KindInt64                       //int64
+	KindFloat64                     //float64
+	KindBool                        //bool from YES/NO
+	KindTime                        //time.Time
+	KindIV                          //[16]byte from hexadecimal-sequence
+	KindByteRange                   //[2]int64 length, offset (-1 when not specified)
+	KindResolution                  //common.Resolution from decimal-resolution
+)
+
+//Kind of each attribute, attributes not listed are KindString
+var attrKinds = map[common.AttrId]ValueKind{
+	common.M3U8Bandwidth:          KindInt64,
+	common.M3U8AverageBandwidth:   KindInt64,
+	common.M3U8FrameRate:          KindFloat64,
+	common.M3U8Default:            KindBool,
+	common.M3U8AutoSelect:         KindBool,
+	common.M3U8CanBlockReload:     KindBool,
+	common.M3U8CanSkipUntil:       KindFloat64,
+	common.M3U8PartHoldBack:       KindFloat64,
+	common.M3U8PartTarget:         KindFloat64,
+	common.M3U8SkippedSegments:    KindInt64,
+	common.M3U8Duration:           KindFloat64,
+	common.M3U8Independent:        KindBool,
+	common.M3U8LastMsn:            KindInt64,
+	common.M3U8LastPart:           KindInt64,
+	common.M3U8IV:                 KindIV,
+	common.M3U8ByteRange:          KindByteRange,
+	common.M3U8Resolution:         KindResolution,
+	common.M3U8StartDate:          KindTime,
+	common.M3U8EndDate:            KindTime,
+	common.M3U8PlannedDuration:    KindFloat64,
+	common.M3U8EndOnNext:          KindBool,
+	common.M3U8Forced:             KindBool,
+	common.M3U8TimeOffset:         KindFloat64,
+	common.M3U8Precise:            KindBool,
+	common.M3U8ByteRangeStart:     KindInt64,
+	common.M3U8ByteRangeLength:    KindInt64,
+	common.M3U8ProgramId:          KindInt64,
+	common.M3U8Gap:                KindBool,
+	common.M3U8HoldBack:           KindFloat64,
+	common.M3U8CanSkipDateRanges:  KindBool,
+	common.M3U8Score:              KindFloat64,
+	common.INTProgramDateTime:     KindTime,
+	common.INTMediaSequenceNumber: KindInt64,
+	common.INTPartNumber:          KindInt64,
+}
+
+//Kind of the value of the tags with a single value (not an attribute list)
+var openValueKinds = map[common.TagId]ValueKind{
+	common.M3U8ExtXVersion:               KindInt64,
+	common.M3U8TargetDuration:            KindInt64,
+	common.M3U8ExtXMediaSequence:         KindInt64,
+	common.M3U8ExtXDiscontinuitySequence: KindInt64,
+	common.M3U8ExtXBitrate:               KindInt64,
+	common.M3U8ExtInf:                    KindFloat64,
+	common.M3U8ExtXIProgramDateTime:      KindTime,
+	common.M3U8ExtXByteRange:             KindByteRange,
+}
+
+//AttrKind - kind of the value stored for attrId in tag
+func AttrKind(tag common.TagId, attrId common.AttrId) ValueKind {
+	if attrId == common.INTUnknownAttr {
+		return openValueKinds[tag]
+	}
+	return attrKinds[attrId]
+}
+
+//ParseByteRange - <n>[@<o>] offset is -1 when not specified
+func ParseByteRange(s string) (ret [2]int64, err error) {
+	parts := strings.Split(s, "@")
+	switch len(parts) {
+	case 2:
+		ret[1], err = strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return
+		}
+	case 1:
+		ret[1] = -1
+	default:
+		err = fmt.Errorf("byteRange expected 1 part or 2 parts with @ seperator")
+		return
+	}
+	ret[0], err = strconv.ParseInt(parts[0], 10, 64)
+	return
+}
+
+//ParseIV - hexadecimal-sequence of 128 bits, shorter values are zero padded on the left
+func ParseIV(s string) (ret [16]byte, err error) {
+	if !strings.HasPrefix(s, "0x") && !strings.HasPrefix(s, "0X") {
+		err = fmt.Errorf("hexadecimal-sequence expected prefix 0x")
+		return
+	}
+	s = s[2:]
+	if len(s) == 0 || len(s) > 2*len(ret) {
+		err = fmt.Errorf("hexadecimal-sequence expected 1 to %v digits", 2*len(ret))
+		return
+	}
+	s = strings.Repeat("0", 2*len(ret)-len(s)) + s
+	_, err = hex.Decode(ret[:], []byte(s))
+	return
+}
+
+//ParseBool - enumerated-string YES/NO
+func ParseBool(s string) (bool, error) {
+	switch s {
+	case "YES":
+		return true, nil
+	case "NO":
+		return false, nil
+	}
+	return false, fmt.Errorf("expected YES or NO")
+}
+
+//VariableRef - values containing a variable reference are kept as text
+//till the variables are substituted (EXT-X-DEFINE)
+const VariableRef = "{$"
+
+func convertText(kind ValueKind, s string) (interface{}, error) {
+	if strings.Contains(s, VariableRef) {
+		return s, nil
+	}
+	switch kind {
+	case KindInt64:
+		return strconv.ParseInt(s, 10, 64)
+	case KindFloat64:
+		return strconv.ParseFloat(s, 64)
+	case KindBool:
+		return ParseBool(s)
+	case KindTime:
+		return time.Parse(time.RFC3339Nano, s)
+	case KindIV:
+		return ParseIV(s)
+	case KindByteRange:
+		return ParseByteRange(s)
+	case KindResolution:
+		return common.ParseResolution(s)
+	}
+	return s, nil
+}
+
+//ConvertValue - converts the value to the kind of the attribute
+//Text values ([]byte or string) are parsed, values already of the kind are returned as is
+//Errors are ParseError of kind ErrBadValue
+func ConvertValue(tag common.TagId, attrId common.AttrId, val interface{}) (ret interface{}, err error) {
+	kind := AttrKind(tag, attrId)
+	switch v := val.(type) {
+	case []byte:
+		ret, err = convertText(kind, string(v))
+	case string:
+		ret, err = convertText(kind, v)
+	case int64:
+		switch kind {
+		case KindInt64:
+			ret = v
+		case KindFloat64:
+			ret = float64(v)
+		case KindString:
+			ret = strconv.FormatInt(v, 10)
+		case KindByteRange:
+			ret = [2]int64{v, -1}
+		default:
+			err = fmt.Errorf("unexpected int64 value")
+		}
+	case float64:
+		switch kind {
+		case KindFloat64:
+			ret = v
+		case KindString:
+			ret = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			err = fmt.Errorf("unexpected float64 value")
+		}
+	default:
+		//already typed
+		ret = val
+	}
+	if err != nil {
+		err = NewParseError(ErrBadValue, tag, attrId, fmt.Errorf("\"%v\" : %w", val, err))
+	}
+	return
+}
+
+//FormatValue - text form of the value as it appears in the playlist, the reverse of ConvertValue
+func FormatValue(val interface{}) (string, error) {
+	switch v := val.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		if v {
+			return "YES", nil
+		}
+		return "NO", nil
+	case time.Time:
+		return v.Format(time.RFC3339Nano), nil
+	case [2]int64:
+		//[length, offset] - offset -1 when not specified
+		if v[1] < 0 {
+			return strconv.FormatInt(v[0], 10), nil
+		}
+		return fmt.Sprintf("%v@%v", v[0], v[1]), nil
+	case [16]byte:
+		//hexadecimal-sequence IV
+		return "0x" + strings.ToUpper(hex.EncodeToString(v[:])), nil
+	case fmt.Stringer:
+		return v.String(), nil
+	}
+	return "", fmt.Errorf("unsupported value type %T", val)
+}
+
+//TypeValues - converts all the values of the entry to the kind of their attributes
+func TypeValues(tag common.TagId, kv *AttrKVPairs) error {
+	if kv == nil {
+		return nil
+	}
+	for attrId, val := range kv.m {
+		newVal, err := ConvertValue(tag, attrId, val)
+		if err != nil {
+			return err
+		}
+		kv.m[attrId] = newVal
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package parsers_test
+
+import (
+	"fmt"
+	"math/rand"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/parsers"
+	"github.com/eswarantg/m3u8reader/parsers/grammarparser"
+	"github.com/eswarantg/m3u8reader/parsers/scanparser"
+	"github.com/eswarantg/m3u8reader/parsers/yaccparser"
+)
+
+type namedParser struct {
+	name   string
+	parser parsers.Parser
+}
+
+//conformanceParsers - all the parsers
+//The first one is the reference, ScanParser3 being the parser used by M3U8
+func conformanceParsers() []namedParser {
+	return []namedParser{
+		{"ScanParser3", &scanparser.ScanParser3{}},
+		{"ScanParser1", &scanparser.ScanParser1{}},
+		{"ScanParser2", &scanparser.ScanParser2{}},
+		{"GrammarParser", &grammarparser.GrammarParser{}},
+		{"YaccParser", &yaccparser.YaccParser{}},
+	}
+}
+
+//runParser - records the entries posted, panics are reported as errors
+func runParser(p parsers.Parser, data []byte) (hdlr *RecordHandler, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic : %v", r)
+		}
+	}()
+	hdlr = &RecordHandler{}
+	_, err = p.ParseData(data, hdlr, make([]byte, 64*1024))
+	return
+}
+
+func formatValue(v interface{}) string {
+	if t, ok := v.(time.Time); ok {
+		return "time.Time(" + t.UTC().Format(time.RFC3339Nano) + ")"
+	}
+	return fmt.Sprintf("%T(%v)", v, v)
+}
+
+//diffRecords - per tag/attribute differences of got from want
+func diffRecords(want *RecordHandler, got *RecordHandler) (diffs []string) {
+	n := len(want.tags)
+	if len(got.tags) != n {
+		diffs = append(diffs, fmt.Sprintf("entries expected %v : got %v", len(want.tags), len(got.tags)))
+		if len(got.tags) < n {
+			n = len(got.tags)
+		}
+	}
+	for i := 0; i < n; i++ {
+		tag := want.tags[i]
+		if got.tags[i] != tag {
+			diffs = append(diffs, fmt.Sprintf("%v : tag expected %v : got %v", i, common.TagNames[tag], common.TagNames[got.tags[i]]))
+			//rest of the entries are out of sync
+			return
+		}
+		attrs := make(map[common.AttrId]bool)
+		for attrId := range want.values[i] {
+			attrs[attrId] = true
+		}
+		for attrId := range got.values[i] {
+			attrs[attrId] = true
+		}
+		ids := make([]int, 0, len(attrs))
+		for attrId := range attrs {
+			ids = append(ids, int(attrId))
+		}
+		sort.Ints(ids)
+		for _, id := range ids {
+			attrId := common.AttrId(id)
+			wantVal, wantOk := want.values[i][attrId]
+			gotVal, gotOk := got.values[i][attrId]
+			switch {
+			case !gotOk:
+				diffs = append(diffs, fmt.Sprintf("%v : %v:%v missing", i, common.TagNames[tag], common.AttrNames[attrId]))
+			case !wantOk:
+				diffs = append(diffs, fmt.Sprintf("%v : %v:%v unexpected %v", i, common.TagNames[tag], common.AttrNames[attrId], formatValue(gotVal)))
+			case formatValue(wantVal) != formatValue(gotVal):
+				diffs = append(diffs, fmt.Sprintf("%v : %v:%v expected %v : got %v", i, common.TagNames[tag], common.AttrNames[attrId],
+					formatValue(wantVal), formatValue(gotVal)))
+			}
+		}
+	}
+	return
+}
+
+//checkConformance - all the parsers are to produce the same entries as the reference
+//returns false if there are differences
+func checkConformance(t *testing.T, name string, data []byte) (ok bool) {
+	ok = true
+	parsers.AttrKVPairsSyncPool = false
+	var ref *RecordHandler
+	var refName string
+	for _, p := range conformanceParsers() {
+		hdlr, err := runParser(p.parser, data)
+		if err != nil {
+			t.Errorf("%v : %v : %v", name, p.name, err)
+			ok = false
+			continue
+		}
+		if ref == nil {
+			ref, refName = hdlr, p.name
+			continue
+		}
+		for _, diff := range diffRecords(ref, hdlr) {
+			t.Errorf("%v : %v vs %v : %v", name, refName, p.name, diff)
+			ok = false
+		}
+	}
+	return
+}
+
+func Test_ConformanceFiles(t *testing.T) {
+	files, err := filepath.Glob("../test/*.m3u8")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no test files : %v", err)
+	}
+	for _, file := range files {
+		data, err := ReadFile(file, t)
+		if err != nil {
+			continue
+		}
+		checkConformance(t, file, data)
+	}
+}
+
+//generatedLines - tags with values of all the kinds, picked at random to generate playlists
+var generatedLines = []string{
+	"#EXT-X-VERSION:%v",
+	"#EXT-X-INDEPENDENT-SEGMENTS",
+	"#EXT-X-TARGETDURATION:%v",
+	"#EXT-X-MEDIA-SEQUENCE:%v",
+	"#EXT-X-DISCONTINUITY-SEQUENCE:%v",
+	"#EXT-X-PLAYLIST-TYPE:EVENT",
+	"#EXT-X-SERVER-CONTROL:CAN-BLOCK-RELOAD=YES,CAN-SKIP-UNTIL=%v.0,PART-HOLD-BACK=1.5",
+	"#EXT-X-PART-INF:PART-TARGET=0.5",
+	"#EXT-X-START:TIME-OFFSET=-%v.5,PRECISE=NO",
+	"#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID=\"aac\",NAME=\"audio %v\",LANGUAGE=\"en\",DEFAULT=YES,AUTOSELECT=YES,URI=\"audio/%v.m3u8\"",
+	"#EXT-X-STREAM-INF:BANDWIDTH=%v000,AVERAGE-BANDWIDTH=%v00,CODECS=\"avc1.4d401f,mp4a.40.2\",RESOLUTION=1280x720,FRAME-RATE=29.97,AUDIO=\"aac\",VIDEO-RANGE=PQ,SCORE=%v.5\nvideo/%v.m3u8",
+	"#EXT-X-KEY:METHOD=AES-128,URI=\"key%v.bin\",IV=0x000102030405060708090A0B0C0D0E%v",
+	"#EXT-X-MAP:URI=\"init%v.mp4\",BYTERANGE=\"%v@0\"",
+	"#EXT-X-PROGRAM-DATE-TIME:2022-01-20T12:16:1%v.000Z",
+	"#EXT-X-DATERANGE:ID=\"ad%v\",START-DATE=\"2022-01-20T12:16:44.000Z\",DURATION=%v.5",
+	"#EXT-X-DISCONTINUITY",
+	"#EXT-X-GAP",
+	"#EXT-X-BITRATE:%v",
+	"#EXT-X-BYTERANGE:%v@%v",
+	"#EXT-X-PART:DURATION=0.5,URI=\"part%v.mp4\",INDEPENDENT=YES",
+	"#EXTINF:%v.0,\nseg%v.ts",
+	"#EXTINF:%v,\nseg%v.ts",
+	"#EXTINF:4.%v,title %v\nseg%v.ts?token=%v&x=a,b",
+	"#EXT-X-I-FRAME-STREAM-INF:BANDWIDTH=%v000,URI=\"iframe%v.m3u8\"",
+	"#EXT-X-SESSION-DATA:DATA-ID=\"com.example.title\",VALUE=\"title %v\",LANGUAGE=\"en\"",
+	"# comment %v",
+	"#EXT-X-UNKNOWN-TAG:%v",
+	"#EXT-X-MEDIA:TYPE=CLOSED-CAPTIONS,GROUP-ID=\"cc\",NAME=\"cc %v\",INSTREAM-ID=\"CC%v\"",
+	"#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID=\"muxed\",NAME=\"main %v\"",
+	"#EXT-X-DEFINE:NAME=\"var%v\",VALUE=\"value %v\"",
+	"#EXT-X-DEFINE:IMPORT=\"var%v\"",
+	"#EXT-X-DEFINE:QUERYPARAM=\"token%v\"",
+	"#EXT-X-CONTENT-STEERING:SERVER-URI=\"/steering?video=%v\",PATHWAY-ID=\"CDN-%v\"",
+	"#EXT-X-SESSION-KEY:METHOD=SAMPLE-AES,URI=\"skd://key%v\",KEYFORMAT=\"com.apple.streamingkeydelivery\",KEYFORMATVERSIONS=\"1\"",
+	"#EXT-X-SKIP:SKIPPED-SEGMENTS=%v",
+	"#EXT-X-SKIP:SKIPPED-SEGMENTS=%v,RECENTLY-REMOVED-DATERANGES=\"ad%v\tad%v\"",
+	"#EXT-X-MEDIA:TYPE=SUBTITLES,GROUP-ID=\"subs\",NAME=\"sub %v\",X-VENDOR-NOTE=\"a,b=%v\",LANGUAGE=\"fr\",URI=\"subs/%v.m3u8\"",
+	"",
+	"#EXT-X-PRELOAD-HINT:TYPE=PART,URI=\"part%v.mp4\"",
+	"#EXT-X-RENDITION-REPORT:URI=\"../%v/index.m3u8\",LAST-MSN=%v,LAST-PART=1",
+	"#EXT-X-RENDITION-REPORT:URI=\"../%v/index.m3u8\",LAST-MSN=%v",
+	"#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID=\"aac\",URI=\"audio/%v.m3u8\"",
+	"#EXTINF:%v.0,\n\nseg%v.ts",
+	"#EXT-X-STREAM-INF:BANDWIDTH=%v000\n\nvideo/%v.m3u8",
+	"#EXTINF:%v.0,\n#EXT-X-CUE-OUT:%v\n#EXT-X-DISCONTINUITY\n\nseg%v.ts",
+	"#EXT-X-STREAM-INF:BANDWIDTH=%v000\n# comment\n#EXT-X-SESSION-DATA:DATA-ID=\"id\",VALUE=\"%v\"\nvideo/%v.m3u8",
+	"#EXT-X-ENDLIST",
+}
+
+//generatePlaylist - playlist of n random lines
+func generatePlaylist(r *rand.Rand, n int) []byte {
+	var sb strings.Builder
+	lineEnd := "\n"
+	if r.Intn(4) == 0 {
+		lineEnd = "\r\n"
+	}
+	sb.WriteString("#EXTM3U")
+	sb.WriteString(lineEnd)
+	for i := 0; i < n; i++ {
+		line := generatedLines[r.Intn(len(generatedLines))]
+		count := strings.Count(line, "%v")
+		args := make([]interface{}, count)
+		for j := range args {
+			args[j] = r.Intn(9) + 1
+		}
+		line = strings.ReplaceAll(fmt.Sprintf(line, args...), "\n", lineEnd)
+		sb.WriteString(line)
+		sb.WriteString(lineEnd)
+	}
+	if r.Intn(2) == 0 {
+		//without the final new line
+		return []byte(strings.TrimSuffix(sb.String(), lineEnd))
+	}
+	return []byte(sb.String())
+}
+
+func Test_ConformanceGenerated(t *testing.T) {
+	r := rand.New(rand.NewSource(8216))
+	for i := 0; i < 200; i++ {
+		data := generatePlaylist(r, 1+r.Intn(20))
+		if !checkConformance(t, fmt.Sprintf("generated %v", i), data) {
+			t.Logf("generated %v :\n%v", i, string(data))
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package parsers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/eswarantg/m3u8reader/common"
+)
+
+//Kinds of the ParseError, to be checked with errors.Is
+var (
+	//ErrSyntax - data not as per the playlist format
+	ErrSyntax = errors.New("syntax error")
+	//ErrMissingAttribute - attribute or value required for the tag not present
+	ErrMissingAttribute = errors.New("missing attribute")
+	//ErrBadValue - value not valid for the type of the attribute
+	ErrBadValue = errors.New("bad value")
+	//ErrDuplicateAttribute - attribute or value present more than once for the tag
+	ErrDuplicateAttribute = errors.New("duplicate attribute")
+	//ErrHandler - error returned by the M3u8Handler for the entry
+	ErrHandler = errors.New("handler error")
+)
+
+//NoAttr - ParseError not specific to an attribute
+const NoAttr common.AttrId = -1
+
+//ParseError - error returned by the parsers with the position and the entry being read
+type ParseError struct {
+	Kind   error         //one of ErrSyntax, ErrMissingAttribute, ErrBadValue, ErrDuplicateAttribute, ErrHandler
+	Line   int           //1 based, 0 if not known
+	Column int           //1 based, 0 if not known
+	Offset int           //byte offset from the start of the data, -1 if not known
+	Tag    common.TagId  //M3U8UNKNOWNTAG if not known
+	Attr   common.AttrId //NoAttr if not specific to an attribute
+	Err    error         //underlying cause, nil if none
+}
+
+//NewParseError - error without the position, which is set by the parser with Locate
+func NewParseError(kind error, tag common.TagId, attr common.AttrId, cause error) *ParseError {
+	return &ParseError{Kind: kind, Offset: -1, Tag: tag, Attr: attr, Err: cause}
+}
+
+func (e *ParseError) Error() string {
+	var sb bytes.Buffer
+	if e.Line > 0 {
+		fmt.Fprintf(&sb, "line %v, col %v : ", e.Line, e.Column)
+	}
+	if e.Tag > common.M3U8UNKNOWNTAG && int(e.Tag) < len(common.TagNames) {
+		sb.WriteString(common.TagNames[e.Tag])
+		if e.Attr >= 0 && int(e.Attr) < len(common.AttrNames) {
+			sb.WriteString(":")
+			sb.WriteString(common.AttrNames[e.Attr])
+		}
+		sb.WriteString(" : ")
+	}
+	if e.Kind != nil {
+		sb.WriteString(e.Kind.Error())
+	}
+	if e.Err != nil {
+		sb.WriteString(" : ")
+		sb.WriteString(e.Err.Error())
+	}
+	return sb.String()
+}
+
+//Unwrap - underlying cause
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+//Is - matches the kind of the error
+func (e *ParseError) Is(target error) bool {
+	return e.Kind != nil && target == e.Kind
+}
+
+//Position - line and column of a byte offset in the data
+type Position struct {
+	Offset int //0 based
+	Line   int //1 based
+	Column int //1 based
+}
+
+//StartPosition - position of the first byte of the data
+func StartPosition() Position {
+	return Position{Line: 1, Column: 1}
+}
+
+//Advance - position after the data
+func (p Position) Advance(data []byte) Position {
+	if n := bytes.Count(data, []byte{'\n'}); n > 0 {
+		p.Line += n
+		p.Column = len(data) - bytes.LastIndexByte(data, '\n')
+	} else {
+		p.Column += len(data)
+	}
+	p.Offset += len(data)
+	return p
+}
+
+//Locate - sets the position and the tag of err if it is a ParseError without them
+//Other errors are returned as a ParseError of kind with err as the cause
+func Locate(err error, kind error, tag common.TagId, pos Position) error {
+	if err == nil {
+		return nil
+	}
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		pe = NewParseError(kind, tag, NoAttr, err)
+		err = pe
+	}
+	if pe.Line == 0 {
+		pe.Line, pe.Column, pe.Offset = pos.Line, pos.Column, pos.Offset
+	}
+	if pe.Tag == common.M3U8UNKNOWNTAG {
+		pe.Tag = tag
+	}
+	return err
+}
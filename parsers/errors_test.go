@@ -0,0 +1,79 @@
+package parsers_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+//failingHandler - fails the entries of the tag
+type failingHandler struct {
+	tag common.TagId
+	err error
+}
+
+func (h *failingHandler) PostRecord(tag common.TagId, kvpairs *parsers.AttrKVPairs) error {
+	if tag == h.tag {
+		return h.err
+	}
+	return nil
+}
+
+func Test_ParseErrors(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	errRejected := errors.New("rejected")
+	tests := []struct {
+		data string
+		kind error
+		tag  common.TagId
+		attr common.AttrId
+		line int
+	}{
+		{"#EXTM3U\n#EXT-X-VERSION:7\n#EXTINF:abc,\nseg.ts\n", parsers.ErrBadValue, common.M3U8ExtInf, common.INTUnknownAttr, 3},
+		{"#EXTM3U\n#EXT-X-KEY:METHOD=AES-128,IV=0xZZ\n", parsers.ErrBadValue, common.M3U8ExtXKey, common.M3U8IV, 2},
+		{"#EXTM3U\r\n# comment\r\n#EXT-X-VERSION:7\r\n#EXT-X-TARGETDURATION:4\r\n", parsers.ErrHandler, common.M3U8TargetDuration, parsers.NoAttr, 4},
+	}
+	for _, p := range conformanceParsers() {
+		for i, test := range tests {
+			hdlr := &failingHandler{tag: common.M3U8TargetDuration, err: errRejected}
+			_, err := p.parser.ParseData([]byte(test.data), hdlr, make([]byte, 4096))
+			var pe *parsers.ParseError
+			if !errors.As(err, &pe) {
+				t.Errorf("%v : %v : ParseError expected : got %v", p.name, i, err)
+				continue
+			}
+			if !errors.Is(err, test.kind) {
+				t.Errorf("%v : %v : kind expected %v : got %v", p.name, i, test.kind, err)
+			}
+			if pe.Tag != test.tag || pe.Line != test.line {
+				t.Errorf("%v : %v : tag/line expected %v/%v : got %v/%v", p.name, i, common.TagNames[test.tag], test.line, common.TagNames[pe.Tag], pe.Line)
+			}
+			if pe.Attr != test.attr {
+				t.Errorf("%v : %v : attr expected %v : got %v", p.name, i, test.attr, pe.Attr)
+			}
+			if test.kind == parsers.ErrHandler && !errors.Is(err, errRejected) {
+				t.Errorf("%v : %v : handler error expected : got %v", p.name, i, err)
+			}
+		}
+	}
+}
+
+func Test_Position(t *testing.T) {
+	tests := []struct {
+		data     string
+		expected parsers.Position
+	}{
+		{"", parsers.Position{Offset: 0, Line: 1, Column: 1}},
+		{"#EXTM3U", parsers.Position{Offset: 7, Line: 1, Column: 8}},
+		{"#EXTM3U\n", parsers.Position{Offset: 8, Line: 2, Column: 1}},
+		{"#EXTM3U\r\n#EXT", parsers.Position{Offset: 13, Line: 2, Column: 5}},
+	}
+	for i, test := range tests {
+		pos := parsers.StartPosition().Advance([]byte(test.data))
+		if pos != test.expected {
+			t.Errorf("%v : expected %+v : got %+v", i, test.expected, pos)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+//go:build go1.18
+
+package parsers_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/parsers"
+	"github.com/eswarantg/m3u8reader/parsers/grammarparser"
+	"github.com/eswarantg/m3u8reader/parsers/scanparser"
+	"github.com/eswarantg/m3u8reader/parsers/yaccparser"
+)
+
+//addFuzzSeeds - test/*.m3u8 files as the seed corpus
+func addFuzzSeeds(f *testing.F) {
+	files, err := filepath.Glob("../test/*.m3u8")
+	if err != nil || len(files) == 0 {
+		f.Fatalf("no test files : %v", err)
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			f.Fatalf("%v : %v", file, err)
+		}
+		f.Add(data)
+	}
+	f.Add([]byte(""))
+	f.Add([]byte("#"))
+	f.Add([]byte("#EXTM3U\n#EXTINF:"))
+	f.Add([]byte("#EXT-X-KEY:URI=\"a"))
+}
+
+//fuzzParser - the parser is to return a ParseError and not panic on any input
+func fuzzParser(f *testing.F, newParser func() parsers.Parser) {
+	addFuzzSeeds(f)
+	parsers.AttrKVPairsSyncPool = false
+	f.Fuzz(func(t *testing.T, data []byte) {
+		hdlr := &RecordHandler{}
+		_, err := newParser().ParseData(data, hdlr, make([]byte, 4096))
+		if err != nil {
+			var pe *parsers.ParseError
+			if !errors.As(err, &pe) {
+				t.Errorf("ParseError expected : got %v", err)
+			}
+			return
+		}
+		for i, tag := range hdlr.tags {
+			if tag <= common.M3U8UNKNOWNTAG || int(tag) >= len(common.TagNames) {
+				t.Errorf("%v : unexpected tag %v", i, tag)
+			}
+		}
+	})
+}
+
+func FuzzScanParser1(f *testing.F) {
+	fuzzParser(f, func() parsers.Parser { return &scanparser.ScanParser1{} })
+}
+
+func FuzzScanParser2(f *testing.F) {
+	fuzzParser(f, func() parsers.Parser { return &scanparser.ScanParser2{} })
+}
+
+func FuzzScanParser3(f *testing.F) {
+	fuzzParser(f, func() parsers.Parser { return &scanparser.ScanParser3{} })
+}
+
+func FuzzGrammarParser(f *testing.F) {
+	fuzzParser(f, func() parsers.Parser { return &grammarparser.GrammarParser{} })
+}
+
+func FuzzYaccParser(f *testing.F) {
+	fuzzParser(f, func() parsers.Parser { return &yaccparser.YaccParser{} })
+}
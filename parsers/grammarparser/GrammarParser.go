@@ -19,6 +19,7 @@ const (
 	readingAttributes
 	readingOpens
 	readingNextline
+	skippingLine
 )
 
 type GrammarParser struct {
@@ -27,21 +28,83 @@ type GrammarParser struct {
 	kv     *parsers.AttrKVPairs
 	line   int
 	col    int
+	//offset of the start of the line, from the length of the data being parsed
+	lineOffset int
+	size       int
+	//position of the # of the tag being read
+	tagPos parsers.Position
+	//tag waiting for its URI line, the tags in between are posted before it
+	pending    *parsers.AttrKVPairs
+	pendingTag common.TagId
+	pendingPos parsers.Position
 }
 
 var boolToInt map[bool]int = map[bool]int{false: 0, true: 1}
 
+//position - position of the data being read
+func (p *GrammarParser) position() parsers.Position {
+	return parsers.Position{Offset: p.lineOffset + p.col, Line: p.line + 1, Column: p.col + 1}
+}
+
+//errorf - ParseError of kind at the position being read
+func (p *GrammarParser) errorf(kind error, attrId common.AttrId, format string, args ...interface{}) error {
+	err := parsers.NewParseError(kind, p.curTag, attrId, fmt.Errorf(format, args...))
+	return parsers.Locate(err, kind, p.curTag, p.position())
+}
+
+//markLine - data is at the start of a new line
+func (p *GrammarParser) markLine(data []byte) {
+	p.lineOffset = p.size - len(data)
+}
+
+//uriAttrId - attribute of the URI line following the tag, false if the tag has none
+func uriAttrId(tagId common.TagId) (attrId common.AttrId, ok bool) {
+	for _, open := range tagMeta[tagId].openTypes {
+		if open.types&valueNextLineEnumeratedString > 0 {
+			return open.attr, true
+		}
+	}
+	return
+}
+
+//pendingError - no URI line for the tag pending
+func (p *GrammarParser) pendingError() error {
+	attrId, _ := uriAttrId(p.pendingTag)
+	err := parsers.NewParseError(parsers.ErrMissingAttribute, p.pendingTag, attrId, nil)
+	return parsers.Locate(err, parsers.ErrMissingAttribute, p.pendingTag, p.pendingPos)
+}
+
+//valueEnd - position of the delimitter ending the value, end of data if not found
+func valueEnd(data []byte, delimitters string) int {
+	pos := bytes.IndexAny(data, delimitters)
+	if pos < 0 {
+		return len(data)
+	}
+	return pos
+}
+
+//eolLength - length of the line end at the start of data, 0 if none
+func eolLength(data []byte) (n int) {
+	if n < len(data) && data[n] == '\r' {
+		n++
+	}
+	if n < len(data) && data[n] == '\n' {
+		n++
+	}
+	return
+}
+
 func (p *GrammarParser) readFloat(data []byte, attrId common.AttrId) (value float64, remain []byte, err error) {
 	//Assumption: value type is determined as valueQuotedString
 	//checking of data[0] = '"' is already done, but it is sent in with data[0]
 	pos := bytes.IndexAny(data, ",\n\r")
 	if pos <= 0 {
-		err = fmt.Errorf("line %v, Col %v : decimal float attribute %v value not found", p.line, p.col, common.AttrNames[attrId])
+		err = p.errorf(parsers.ErrBadValue, attrId, "decimal float value not found")
 		return
 	}
 	value, err = strconv.ParseFloat(string(data[0:pos]), 64)
 	if err != nil {
-		err = fmt.Errorf("line %v, Col %v : decimal float attribute %v error parsing : %w", p.line, p.col, common.AttrNames[attrId], err)
+		err = p.errorf(parsers.ErrBadValue, attrId, "decimal float error parsing : %w", err)
 		return
 	}
 	p.col += pos
@@ -55,12 +118,12 @@ func (p *GrammarParser) readInt(data []byte, attrId common.AttrId) (value int64,
 	//checking of data[0] = '"' is already done, but it is sent in with data[0]
 	pos := bytes.IndexAny(data, ",\n\r")
 	if pos <= 0 {
-		err = fmt.Errorf("line %v, Col %v : decimal int attribute %v value not found", p.line, p.col, common.AttrNames[attrId])
+		err = p.errorf(parsers.ErrBadValue, attrId, "decimal int value not found")
 		return
 	}
 	value, err = strconv.ParseInt(string(data[0:pos]), 10, 64)
 	if err != nil {
-		err = fmt.Errorf("line %v, Col %v : decimal int attribute %v error parsing : %w", p.line, p.col, common.AttrNames[attrId], err)
+		err = p.errorf(parsers.ErrBadValue, attrId, "decimal int error parsing : %w", err)
 		return
 	}
 	p.col += pos
@@ -74,12 +137,12 @@ func (p *GrammarParser) readDateTime(data []byte, attrId common.AttrId) (value t
 	//checking of data[0] = '"' is already done, but it is sent in with data[0]
 	pos := bytes.IndexAny(data, ",\n\r")
 	if pos <= 0 {
-		err = fmt.Errorf("line %v, Col %v : timeDate attribute %v value not found", p.line, p.col, common.AttrNames[attrId])
+		err = p.errorf(parsers.ErrBadValue, attrId, "timeDate value not found")
 		return
 	}
 	value, err = time.Parse(time.RFC3339Nano, string(data[0:pos]))
 	if err != nil {
-		err = fmt.Errorf("line %v, Col %v : timeDate attribute %v error parsing : %w", p.line, p.col, common.AttrNames[attrId], err)
+		err = p.errorf(parsers.ErrBadValue, attrId, "timeDate error parsing : %w", err)
 		return
 	}
 	p.col += pos
@@ -93,7 +156,7 @@ func (p *GrammarParser) readQuotedString(data []byte, attrId common.AttrId) (val
 	//checking of data[0] = '"' is already done, but it is sent in with data[0]
 	pos := bytes.IndexByte(data[1:], '"') //data[1:]- ignore first quote
 	if pos < 0 {
-		err = fmt.Errorf("line %v, Col %v : quoted string attribute %v quote not found", p.line, p.col, common.AttrNames[attrId])
+		err = p.errorf(parsers.ErrSyntax, attrId, "quoted string quote not found")
 		return
 	}
 	value = string(data[1 : pos+1]) //pos+1 - //adjust for the first quote
@@ -108,7 +171,7 @@ func (p *GrammarParser) readEnumeratedString(data []byte, attrId common.AttrId)
 	//Assumption: value type is determined as valueEnumeratedString
 	pos := bytes.IndexAny(data, ",\n\r")
 	if pos < 0 {
-		err = fmt.Errorf("line %v, Col %v : enumerated string attribute %v value not found", p.line, p.col, common.AttrNames[attrId])
+		err = p.errorf(parsers.ErrSyntax, attrId, "enumerated string value not found")
 		return
 	}
 	value = string(data[0:pos])
@@ -118,28 +181,47 @@ func (p *GrammarParser) readEnumeratedString(data []byte, attrId common.AttrId)
 	return
 }
 
+func (p *GrammarParser) readText(data []byte, attrId common.AttrId) (value string, remain []byte, err error) {
+	//Assumption: value type is determined as valueUTF8Text
+	//Text is till the end of the line, can have , or =
+	pos := valueEnd(data, "\n\r")
+	value = string(data[0:pos])
+	p.col += pos
+	data = data[pos:]
+	remain = data
+	return
+}
+
+func (p *GrammarParser) skipLine(data []byte) (remain []byte, err error) {
+	//Assumption : comment or unknown tag being read
+	pos := valueEnd(data, "\n")
+	p.col += pos
+	remain = data[pos:]
+	p.state = searchingTag
+	return
+}
+
 func (p *GrammarParser) readTag(data []byte) (remain []byte, err error) {
 	//Assumption : must be after \n# point
 	//Assumption : len(data)>0
 
 	//find the position of next delimitter
-	pos := bytes.IndexAny(data, ":\n")
-	//Consume the entire content as tag if pos == -1 (not found)
-	pos = boolToInt[pos == -1]*len(data) + boolToInt[pos != -1]*pos
-	if pos == 0 {
-		p.state = searchingTag
-		return
-	}
+	//Consume the entire content as tag if not found
+	pos := valueEnd(data, ":\r\n")
 	tagId, ok := common.TagToTagId[string(data[0:pos])]
 	if !ok {
-		err = fmt.Errorf("line %v, Col %v : unknown tag \"%v\"", p.line, p.col, string(data[0:pos]))
+		//comments and unknown tags are ignored
+		p.curTag = common.M3U8UNKNOWNTAG
+		p.state = skippingLine
+		remain = data
 		return
 	}
 	//setup the Tag
 	p.curTag = tagId
+	p.tagPos = parsers.Position{Offset: p.lineOffset, Line: p.line + 1, Column: 1}
 	//create a new map for the attributes
 	//Last record is owned by the post done
-	readBytes := pos + boolToInt[data[pos] == ':']
+	readBytes := pos + boolToInt[pos < len(data) && data[pos] == ':']
 	p.col += readBytes - 1
 	remain = data[readBytes:]
 	meta := tagMeta[p.curTag]
@@ -156,26 +238,44 @@ func (p *GrammarParser) readTag(data []byte) (remain []byte, err error) {
 func (p *GrammarParser) searchTag(data []byte) (remain []byte, err error) {
 	//Assumption : must be before \n# point
 	//Assumption : len(data)>0
-	p.curTag = common.M3U8UNKNOWNTAG
-	move1 := boolToInt[data[0] == '\r']
-	move1 += boolToInt[data[move1] == '\n'] //move1 != 0 -  EOL read
-	move2 := boolToInt[data[move1] == '\r']
-	move2 += boolToInt[data[move1+move2] == '\n'] //move2 != 0 - another EOL read
-	if move1 > 0 && move2 > 0 {                   //Empty line detected
+	move1 := eolLength(data)         //move1 != 0 -  EOL read
+	move2 := eolLength(data[move1:]) //move2 != 0 - another EOL read
+	if move1 > 0 && move2 > 0 {      //Empty line detected
 		data = data[move1:]
 		remain = data
 		p.line++
 		p.col = 0
+		p.markLine(data)
 		return
 	}
-	if move1 == 0 || data[move1] != '#' {
-		err = fmt.Errorf("line %v, Col %v : invalid characters for new Tag", p.line, p.col)
+	if move1 > 0 && move1 < len(data) && data[move1] != '#' && p.pending != nil {
+		//URI line of the tag pending, posted as the entry read
+		data = data[move1:]
+		p.line++
+		p.col = 0
+		p.markLine(data)
+		attrId, _ := uriAttrId(p.pendingTag)
+		var uri string
+		uri, data, err = p.readText(data, attrId)
+		if err != nil {
+			return
+		}
+		p.pending.Store(attrId, uri)
+		p.curTag, p.kv, p.tagPos = p.pendingTag, p.pending, p.pendingPos
+		p.pending = nil
+		remain = data
+		return
+	}
+	if move1 == 0 || move1 >= len(data) || data[move1] != '#' {
+		err = p.errorf(parsers.ErrSyntax, parsers.NoAttr, "invalid characters for new Tag")
 		return
 	}
+	p.curTag = common.M3U8UNKNOWNTAG
 	data = data[move1+1:]
 	p.state = readingTag
 	p.line++
 	p.col = 1
+	p.lineOffset = p.size - len(data) - 1 //position of #
 	remain = data
 	return
 }
@@ -190,24 +290,28 @@ func (p *GrammarParser) getValue(data []byte, attrId common.AttrId, format Value
 	switch {
 	case format&valueNextLineEnumeratedString > 0:
 		if len(data) < 2 {
-			err = fmt.Errorf("line %v, Col %v : next line enumerated string insufficient length", p.line, p.col)
+			err = p.errorf(parsers.ErrMissingAttribute, attrId, "next line enumerated string insufficient length")
 			return
 		}
-		move := boolToInt[data[0] == '\r']
-		move += boolToInt[data[move] == '\n']
+		move := eolLength(data)
 		if move == 0 {
-			err = fmt.Errorf("line %v, Col %v : next line enumerated string unable to find newline", p.line, p.col)
+			err = p.errorf(parsers.ErrMissingAttribute, attrId, "next line enumerated string unable to find newline")
 			return
 		}
+		if next := data[move:]; len(next) == 0 || next[0] == '#' || next[0] == '\r' || next[0] == '\n' {
+			//blank or tag lines before the URI line, the tag is kept pending
+			break
+		}
 		data = data[move:]
 		p.line++
 		p.col = 0
-		valueStr, data, err = p.readEnumeratedString(data, attrId)
+		p.markLine(data)
+		valueStr, data, err = p.readText(data, attrId)
 		if err == nil {
 			value = valueStr
 		}
 	case format&(valueQuotedString|valueEnumeratedString) > 0:
-		if data[0] == '"' {
+		if len(data) > 0 && data[0] == '"' {
 			valueStr, data, err = p.readQuotedString(data, attrId)
 		} else {
 			valueStr, data, err = p.readEnumeratedString(data, attrId)
@@ -216,8 +320,8 @@ func (p *GrammarParser) getValue(data []byte, attrId common.AttrId, format Value
 			value = valueStr
 		}
 	case format&valueQuotedString > 0:
-		if data[0] != '"' {
-			err = fmt.Errorf("line %v, Col %v : quoted string attribute %v quote not found", p.line, p.col, common.AttrNames[attrId])
+		if len(data) == 0 || data[0] != '"' {
+			err = p.errorf(parsers.ErrSyntax, attrId, "quoted string quote not found")
 			return
 		}
 		valueStr, data, err = p.readQuotedString(data, attrId)
@@ -225,9 +329,14 @@ func (p *GrammarParser) getValue(data []byte, attrId common.AttrId, format Value
 			value = valueStr
 		}
 	case format&valueUTF8Text > 0:
-		fallthrough //read as valueEnumeratedString for now
+		valueStr, data, err = p.readText(data, attrId)
+		if err == nil {
+			value = valueStr
+		}
 	case format&valueDecimalResolution > 0:
 		fallthrough //treat same as valueEnumeratedString for now
+	case format&valueHexaDecimalSeq > 0:
+		fallthrough //read as valueEnumeratedString, typed with the attribute
 	case format&valueEnumeratedString > 0:
 		valueStr, data, err = p.readEnumeratedString(data, attrId)
 		if err == nil {
@@ -263,14 +372,11 @@ func (p *GrammarParser) readingAttributes(data []byte) (remain []byte, err error
 		//find the position of next delimitter
 		pos := bytes.IndexAny(data, "=")
 		if pos <= 0 {
-			err = fmt.Errorf("line %v, Col %v : attribute tag not found", p.line, p.col)
-			return
-		}
-		attrId, ok := common.AttrToAttrId[string(data[0:pos])]
-		if !ok {
-			err = fmt.Errorf("line %v, Col %v : unknown attribute tag %v", p.line, p.col, string(data[0:pos]))
+			err = p.errorf(parsers.ErrSyntax, parsers.NoAttr, "attribute tag not found")
 			return
 		}
+		//unknown attributes are read and ignored
+		attrId, known := common.AttrToAttrId[string(data[0:pos])]
 		//Ignore checking if the attrId is supposed to be attribute for this Tag
 		/*
 			for _, item := range tagMeta[p.curTag].attrs {
@@ -284,14 +390,21 @@ func (p *GrammarParser) readingAttributes(data []byte) (remain []byte, err error
 		p.col += pos + 1
 		//Extract the value
 		//find the position of next delimitter
-		formats := attrMeta[attrId].types
-		if formats == nil {
-			err = fmt.Errorf("line %v, Col %v : unknown attribute %v value type not defined", p.line, p.col, common.AttrNames[attrId])
-			return
+		var format ValueType = valueQuotedString | valueEnumeratedString
+		if known {
+			formats := attrMeta[attrId].types
+			if formats == nil {
+				err = p.errorf(parsers.ErrSyntax, attrId, "value type not defined")
+				return
+			}
+			format = formats[0]
 		}
 		var value interface{}
-		value, data, err = p.getValue(data, attrId, formats[0])
-		if err == nil {
+		value, data, err = p.getValue(data, attrId, format)
+		if err != nil {
+			return
+		}
+		if known {
 			p.kv.Store(attrId, value)
 		}
 		if len(data) == 0 {
@@ -324,7 +437,11 @@ func (p *GrammarParser) readingOpens(data []byte) (remain []byte, err error) {
 	//find the position of next delimitter
 	for _, format := range formats {
 		value, data, err = p.getValue(data, format.attr, format.types)
-		if err == nil {
+		if err != nil {
+			return
+		}
+		//empty optional values and URI lines not read yet are not stored
+		if str, ok := value.(string); value != nil && (!ok || len(str) > 0 || format.types&valueOptional == 0) {
 			p.kv.Store(format.attr, value)
 		}
 		// if one more item is present
@@ -350,13 +467,55 @@ func (p *GrammarParser) Parse(rdr io.Reader, handler parsers.M3u8Handler, buffer
 	return p.ParseData(data, handler, buffer)
 }
 
+//postRecord - posts the entry read to the handler and starts a new one
+func (p *GrammarParser) postRecord(handler parsers.M3u8Handler) (err error) {
+	if handler == nil || p.curTag == common.M3U8UNKNOWNTAG {
+		return
+	}
+	if attrId, ok := uriAttrId(p.curTag); ok && !p.kv.Exists(attrId) {
+		//tag without its URI line yet
+		p.pending, p.pendingTag, p.pendingPos = p.kv, p.curTag, p.tagPos
+		p.curTag = common.M3U8UNKNOWNTAG
+		p.kv = parsers.NewAttrKVPairs() //new value
+		return
+	}
+	err = parsers.TypeValues(p.curTag, p.kv)
+	if err != nil {
+		return parsers.Locate(err, parsers.ErrBadValue, p.curTag, p.tagPos)
+	}
+	err = handler.PostRecord(p.curTag, p.kv)
+	err = parsers.Locate(err, parsers.ErrHandler, p.curTag, p.tagPos)
+	p.curTag = common.M3U8UNKNOWNTAG
+	p.kv = parsers.NewAttrKVPairs() //new value
+	return
+}
+
 func (p *GrammarParser) ParseData(data []byte, handler parsers.M3u8Handler, buffer []byte) (nBytes int, err error) {
 	origLen := len(data)
 	p.state = readingTag
-	if data[0] != '#' {
-		err = fmt.Errorf("line %v, Col %v : expected # not found", p.line, p.col)
+	p.curTag = common.M3U8UNKNOWNTAG
+	p.line = 0
+	p.col = 0
+	p.lineOffset = 0
+	p.size = len(data)
+	p.pending = nil
+	if len(data) == 0 || data[0] != '#' {
+		err = p.errorf(parsers.ErrSyntax, parsers.NoAttr, "expected # not found")
+		return
 	}
-	data = data[1:]                 //position after #
+	if data[len(data)-1] != '\n' {
+		//last line is terminated for its values to be delimitted
+		data = append(data[:len(data):len(data)], '\n')
+		origLen = len(data)
+		defer func() {
+			//line end added is not counted
+			if nBytes == origLen {
+				nBytes--
+			}
+		}()
+	}
+	data = data[1:] //position after #
+	p.col = 1
 	p.kv = parsers.NewAttrKVPairs() //new value
 Loop:
 	for err == nil {
@@ -366,13 +525,13 @@ Loop:
 		switch p.state {
 		case readingTag:
 			data, err = p.readTag(data)
+			if _, ok := uriAttrId(p.curTag); ok && p.pending != nil {
+				err = p.pendingError()
+			}
 		case searchingTag:
-			if handler != nil && p.curTag != common.M3U8UNKNOWNTAG {
-				err = handler.PostRecord(p.curTag, p.kv)
-				p.kv = parsers.NewAttrKVPairs() //new value
-				if err != nil {
-					break Loop
-				}
+			err = p.postRecord(handler)
+			if err != nil {
+				break Loop
 			}
 			if len(data) < 3 {
 				break Loop
@@ -382,7 +541,16 @@ Loop:
 			data, err = p.readingAttributes(data)
 		case readingOpens:
 			data, err = p.readingOpens(data)
+		case skippingLine:
+			data, err = p.skipLine(data)
 		}
 	}
+	if err == nil {
+		//last entry without a line after it
+		err = p.postRecord(handler)
+	}
+	if err == nil && p.pending != nil {
+		err = p.pendingError()
+	}
 	return origLen - len(data), err
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/parsers"
 )
 
 func Test_readFloat(t *testing.T) {
@@ -168,15 +169,16 @@ func Test_readTag(t *testing.T) {
 		":",
 		"",
 	}
-	remains := [...]int{1, 2, 2, 60, 32, 49, 73, 21, 0, 0, 0, 0, 0, 0}
+	remains := [...]int{1, 2, 2, 60, 32, 49, 73, 21, 6, 5, 1, 5, 1, 0}
 	tags := [...]common.TagId{common.M3U8FormatIdentifier, common.M3U8ExtXVersion, common.M3U8TargetDuration, common.M3U8ExtXServerControl, common.M3U8ExtInf, common.M3U8ExtXStreamInf, common.M3U8ExtXPart, common.M3U8ExtXPartInf,
 		common.M3U8UNKNOWNTAG, common.M3U8UNKNOWNTAG, common.M3U8UNKNOWNTAG, common.M3U8UNKNOWNTAG, common.M3U8UNKNOWNTAG, common.M3U8UNKNOWNTAG}
+	//comments and unknown tags are skipped
 	states := [...]parserState{searchingTag, readingOpens, readingOpens, readingAttributes, readingOpens, readingAttributes, readingAttributes, readingAttributes,
-		readingTag, readingTag, readingTag, readingTag, readingTag, readingTag}
+		skippingLine, skippingLine, skippingLine, skippingLine, skippingLine, skippingLine}
 	cols := [...]int{5, 13, 20, 20, 6, 16, 10, 14,
 		0, 0, 0, 0, 0, 0}
 	errors := [...]error{nil, nil, nil, nil, nil, nil, nil, nil,
-		errors.New("ERR"), errors.New("ERR"), errors.New("ERR"), errors.New("ERR"), errors.New("ERR"), errors.New("ERR")}
+		nil, nil, nil, nil, nil, nil}
 	for i, sample := range samples {
 		p.state = readingTag
 		p.curTag = common.M3U8UNKNOWNTAG
@@ -224,15 +226,15 @@ func Test_searchTag(t *testing.T) {
 	remains := [...]int{16, 23, 81, 39, 66, 84, 36,
 		0, 1, 0, 0, 0, 0}
 	tags := [...]common.TagId{common.M3U8UNKNOWNTAG, common.M3U8UNKNOWNTAG, common.M3U8UNKNOWNTAG, common.M3U8UNKNOWNTAG, common.M3U8UNKNOWNTAG, common.M3U8UNKNOWNTAG, common.M3U8UNKNOWNTAG,
-		common.M3U8UNKNOWNTAG, common.M3U8UNKNOWNTAG, common.M3U8FormatIdentifier, common.M3U8FormatIdentifier, common.M3U8FormatIdentifier, common.M3U8FormatIdentifier}
+		common.M3U8UNKNOWNTAG, common.M3U8UNKNOWNTAG, common.M3U8FormatIdentifier, common.M3U8FormatIdentifier, common.M3U8UNKNOWNTAG, common.M3U8FormatIdentifier}
 	states := [...]parserState{readingTag, readingTag, readingTag, readingTag, readingTag, readingTag, readingTag,
-		readingTag, readingTag, searchingTag, searchingTag, searchingTag, searchingTag}
+		readingTag, readingTag, searchingTag, searchingTag, readingTag, searchingTag}
 	cols := [...]int{1, 1, 1, 1, 1, 1, 1,
-		1, 1, 0, 0, 0, 0}
+		1, 1, 0, 0, 1, 0}
 	lines := [...]int{1, 1, 1, 1, 1, 1, 1,
-		1, 1, 0, 0, 0, 0}
+		1, 1, 0, 0, 1, 0}
 	errors := [...]error{nil, nil, nil, nil, nil, nil, nil,
-		nil, nil, errors.New("ERR"), errors.New("ERR"), errors.New("ERR"), errors.New("ERR")}
+		nil, nil, errors.New("ERR"), errors.New("ERR"), nil, errors.New("ERR")}
 	for i, sample := range samples {
 		p.state = searchingTag
 		p.curTag = common.M3U8FormatIdentifier //Sample
@@ -272,7 +274,7 @@ func Test_readAttributes(t *testing.T) {
 		"PART-TARGET=1.004000\n",
 	}
 	kvs := [...]map[common.AttrId]interface{}{
-		{common.M3U8CanBlockReload: "YES", common.M3U8CanSkipUntil: int64(24), common.M3U8PartHoldBack: float64(3.012)},
+		{common.M3U8CanBlockReload: "YES", common.M3U8CanSkipUntil: float64(24), common.M3U8PartHoldBack: float64(3.012)},
 		{common.M3U8Bandwidth: int64(550172), common.M3U8Resolution: "256x106"},
 		{common.M3U8Duration: float64(1.000), common.M3U8Uri: "tv5_TS-50002_1_video_91001847.0.mp4", common.M3U8Independent: "YES"},
 		{common.M3U8PartTarget: float64(1.004000)},
@@ -289,7 +291,7 @@ func Test_readAttributes(t *testing.T) {
 		p.curTag = tags[i]
 		p.line = 0
 		p.col = 0
-		p.kv = nil
+		p.kv = parsers.NewAttrKVPairs()
 		remain, err := p.readingAttributes([]byte(sample))
 		if err != nil {
 			t.Logf("\n%v: %v", i, err.Error())
@@ -312,7 +314,7 @@ func Test_readAttributes(t *testing.T) {
 		for k, v := range kvs[i] {
 			var val interface{}
 			var ok bool
-			if val = p.kv.Get(k); val != nil {
+			if val = p.kv.Get(k); val == nil {
 				t.Errorf("%v : kv expected %v : not found", i, k)
 			}
 			switch ty := reflect.ValueOf(v); ty.Kind() {
@@ -366,7 +368,7 @@ func Test_readOpens(t *testing.T) {
 	kvs := [...]map[common.AttrId]interface{}{
 		{common.INTUnknownAttr: int64(9)},
 		{common.INTUnknownAttr: int64(4)},
-		{common.INTUnknownAttr: float64(4.00000), common.M3U8Title: "", common.M3U8Uri: "fileSequence436248.m4s"},
+		{common.INTUnknownAttr: float64(4.00000), common.M3U8Uri: "fileSequence436248.m4s"},
 	}
 	tags := [...]common.TagId{common.M3U8ExtXVersion, common.M3U8TargetDuration, common.M3U8ExtInf}
 	remains := [...]int{1, 1, 1}
@@ -383,7 +385,7 @@ func Test_readOpens(t *testing.T) {
 		p.curTag = tags[i]
 		p.line = 0
 		p.col = 0
-		p.kv = nil
+		p.kv = parsers.NewAttrKVPairs()
 		remain, err := p.readingOpens([]byte(sample))
 		if err != nil {
 			t.Logf("\n%v: %v", i, err.Error())
@@ -406,7 +408,7 @@ func Test_readOpens(t *testing.T) {
 		for k, v := range kvs[i] {
 			var val interface{}
 			var ok bool
-			if val = p.kv.Get(k); val != nil {
+			if val = p.kv.Get(k); val == nil {
 				t.Errorf("%v : kv expected %v : not found", i, k)
 			}
 			switch ty := reflect.ValueOf(v); ty.Kind() {
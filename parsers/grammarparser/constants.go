@@ -39,55 +39,73 @@ var tagMeta = [...]TagMeta{
 	{tag: common.M3U8ExtXMedia, openTypes: nil, attrs: []common.AttrId{common.M3U8Type,
 		common.M3U8Uri, common.M3U8GroupId, common.M3U8Language, common.M3U8AssocLanguage, common.M3U8Name,
 		common.M3U8Default, common.M3U8AutoSelect, common.M3U8Forced, common.M3U8InStreamId,
-		common.M3U8Characteristics, common.M3U8Channels}},
+		common.M3U8Characteristics, common.M3U8Channels, common.M3U8PathwayId}},
 	{tag: common.M3U8ExtXStreamInf, openTypes: []OpenType{
 		{types: valueNextLineEnumeratedString, attr: common.INTUnknownAttr},
 	}, attrs: []common.AttrId{common.M3U8Bandwidth,
 		common.M3U8AverageBandwidth, common.M3U8Codecs, common.M3U8Resolution, common.M3U8FrameRate,
 		common.M3U8HdcpLevel, common.M3U8Audio, common.M3U8Video, common.M3U8Subtitles,
-		common.M3U8ClosedCaptions, common.M3U8ProgramId}},
+		common.M3U8ClosedCaptions, common.M3U8ProgramId, common.M3U8PathwayId, common.M3U8VideoRange,
+		common.M3U8Score}},
 	{tag: common.M3U8TargetDuration, openTypes: []OpenType{
 		{types: valueDecimalInt, attr: common.INTUnknownAttr},
 	}, attrs: nil},
 	{tag: common.M3U8ExtXServerControl, openTypes: nil, attrs: []common.AttrId{common.M3U8CanBlockReload,
-		common.M3U8CanSkipUntil, common.M3U8PartHoldBack}},
+		common.M3U8CanSkipUntil, common.M3U8PartHoldBack, common.M3U8HoldBack, common.M3U8CanSkipDateRanges}},
 	{tag: common.M3U8ExtXPartInf, openTypes: nil, attrs: []common.AttrId{common.M3U8PartTarget}},
 	{tag: common.M3U8ExtXMediaSequence, openTypes: []OpenType{
 		{types: valueDecimalInt, attr: common.INTUnknownAttr},
 	}, attrs: nil},
-	{tag: common.M3U8XSkip, openTypes: nil, attrs: []common.AttrId{common.M3U8SkippedSegments}},
+	{tag: common.M3U8XSkip, openTypes: nil, attrs: []common.AttrId{common.M3U8SkippedSegments,
+		common.M3U8RecentlyRemovedDateRanges}},
 	{tag: common.M3U8ExtInf, openTypes: []OpenType{
 		{types: valueDecimalInt | valueUnSignedDecimalFloat, attr: common.INTUnknownAttr},
-		{types: valueEnumeratedString | valueOptional, attr: common.M3U8Title},
+		{types: valueUTF8Text | valueOptional, attr: common.M3U8Title},
 		{types: valueNextLineEnumeratedString, attr: common.M3U8Uri},
 	}, attrs: nil},
 	{tag: common.M3U8ExtXIProgramDateTime, openTypes: []OpenType{
 		{types: valueDateTime, attr: common.INTUnknownAttr},
 	}, attrs: nil},
 	{tag: common.M3U8ExtXPart, openTypes: nil, attrs: []common.AttrId{common.M3U8Duration,
-		common.M3U8Independent, common.M3U8Uri}},
+		common.M3U8Independent, common.M3U8Uri, common.M3U8Gap, common.M3U8ByteRange}},
 	{tag: common.M3U8ExtXPreLoadHint, openTypes: nil, attrs: []common.AttrId{
-		common.M3U8Type, common.M3U8Uri}},
+		common.M3U8Type, common.M3U8Uri, common.M3U8ByteRangeStart, common.M3U8ByteRangeLength}},
 	{tag: common.M3U8ExtXRenditionReport, openTypes: nil, attrs: []common.AttrId{
 		common.M3U8Uri, common.M3U8LastMsn, common.M3U8LastPart}},
 	{tag: common.M3U8ExtXMap, openTypes: nil, attrs: []common.AttrId{common.M3U8Uri, common.M3U8ByteRange}},
-	{tag: common.M3U8ExtXIFrameStreamInf, openTypes: nil, attrs: []common.AttrId{common.M3U8Uri}},
+	{tag: common.M3U8ExtXIFrameStreamInf, openTypes: nil, attrs: []common.AttrId{common.M3U8Bandwidth,
+		common.M3U8AverageBandwidth, common.M3U8Codecs, common.M3U8Resolution, common.M3U8HdcpLevel,
+		common.M3U8Video, common.M3U8Uri, common.M3U8ProgramId, common.M3U8PathwayId, common.M3U8VideoRange}},
 	{tag: common.M3U8ExtXDiscontinuity, openTypes: nil, attrs: nil},
 	{tag: common.M3U8ExtXEndList, openTypes: nil, attrs: nil},
 	{tag: common.M3U8ExtXPlaylistType, openTypes: []OpenType{
 		{types: valueEnumeratedString, attr: common.INTUnknownAttr},
 	}, attrs: nil},
-	{tag: common.M3U8ExtXByteRange, openTypes: nil, attrs: nil},
+	{tag: common.M3U8ExtXByteRange, openTypes: []OpenType{
+		{types: valueEnumeratedString, attr: common.INTUnknownAttr},
+	}, attrs: nil},
 	{tag: common.M3U8ExtXKey, openTypes: nil, attrs: []common.AttrId{common.M3U8Method, common.M3U8IV,
 		common.M3U8KeyFormat, common.M3U8KeyFormatVersions}},
-	{tag: common.M3U8ExtXDataRange, openTypes: nil, attrs: []common.AttrId{common.M3U8Id}},
-	{tag: common.M3U8ExtXDiscontinuitySequence, openTypes: nil, attrs: nil},
+	{tag: common.M3U8ExtXDataRange, openTypes: nil, attrs: []common.AttrId{common.M3U8Id,
+		common.M3U8Class, common.M3U8StartDate, common.M3U8EndDate, common.M3U8Duration, common.M3U8PlannedDuration,
+		common.M3U8Scte35Cmd, common.M3U8Scte35Out, common.M3U8Scte35In, common.M3U8EndOnNext}},
+	{tag: common.M3U8ExtXDiscontinuitySequence, openTypes: []OpenType{
+		{types: valueDecimalInt, attr: common.INTUnknownAttr},
+	}, attrs: nil},
 	{tag: common.M3U8ExtXIFramesOnly, openTypes: nil, attrs: nil},
 	{tag: common.M3U8ExtXSesionKey, openTypes: nil, attrs: []common.AttrId{common.M3U8Method, common.M3U8IV,
 		common.M3U8KeyFormat, common.M3U8KeyFormatVersions}},
 	{tag: common.M3U8ExtXSessionData, openTypes: nil, attrs: []common.AttrId{common.M3U8DataId, common.M3U8Value,
 		common.M3U8Uri, common.M3U8Language}},
 	{tag: common.M3U8ExtXStart, openTypes: nil, attrs: []common.AttrId{common.M3U8TimeOffset, common.M3U8Precise}},
+	{tag: common.M3U8ExtXGap, openTypes: nil, attrs: nil},
+	{tag: common.M3U8ExtXBitrate, openTypes: []OpenType{
+		{types: valueDecimalInt, attr: common.INTUnknownAttr},
+	}, attrs: nil},
+	{tag: common.M3U8ExtXDefine, openTypes: nil, attrs: []common.AttrId{common.M3U8Name, common.M3U8Value,
+		common.M3U8Import, common.M3U8QueryParam}},
+	{tag: common.M3U8ExtXContentSteering, openTypes: nil, attrs: []common.AttrId{common.M3U8ServerUri,
+		common.M3U8PathwayId}},
 }
 
 type AttrMeta struct {
@@ -122,32 +140,48 @@ var attrMeta = [...]AttrMeta{
 	{attr: common.M3U8Independent, types: []ValueType{valueEnumeratedString}},
 	{attr: common.M3U8LastMsn, types: []ValueType{valueDecimalInt}},
 	{attr: common.M3U8LastPart, types: []ValueType{valueDecimalInt}},
-	{attr: common.M3U8Method, types: nil},
-	{attr: common.M3U8IV, types: nil},
-	{attr: common.M3U8KeyFormat, types: nil},
-	{attr: common.M3U8KeyFormatVersions, types: nil},
-	{attr: common.M3U8ByteRange, types: nil},
-	{attr: common.M3U8Id, types: nil},
-	{attr: common.M3U8Class, types: nil},
-	{attr: common.M3U8StartDate, types: nil},
-	{attr: common.M3U8EndDate, types: nil},
-	{attr: common.M3U8PlannedDuration, types: nil},
-	{attr: common.M3U8Scte35Cmd, types: nil},
-	{attr: common.M3U8Scte35Out, types: nil},
-	{attr: common.M3U8Scte35In, types: nil},
-	{attr: common.M3U8EndOnNext, types: nil},
-	{attr: common.M3U8AssocLanguage, types: nil},
-	{attr: common.M3U8Forced, types: nil},
-	{attr: common.M3U8InStreamId, types: nil},
-	{attr: common.M3U8Characteristics, types: nil},
+	{attr: common.M3U8Method, types: []ValueType{valueEnumeratedString}},
+	{attr: common.M3U8IV, types: []ValueType{valueHexaDecimalSeq}},
+	{attr: common.M3U8KeyFormat, types: []ValueType{valueQuotedString}},
+	{attr: common.M3U8KeyFormatVersions, types: []ValueType{valueQuotedString}},
+	{attr: common.M3U8ByteRange, types: []ValueType{valueQuotedString}},
+	{attr: common.M3U8Id, types: []ValueType{valueQuotedString}},
+	{attr: common.M3U8Class, types: []ValueType{valueQuotedString}},
+	{attr: common.M3U8StartDate, types: []ValueType{valueQuotedString}},
+	{attr: common.M3U8EndDate, types: []ValueType{valueQuotedString}},
+	{attr: common.M3U8PlannedDuration, types: []ValueType{valueSignedDecimalFloat}},
+	{attr: common.M3U8Scte35Cmd, types: []ValueType{valueHexaDecimalSeq}},
+	{attr: common.M3U8Scte35Out, types: []ValueType{valueHexaDecimalSeq}},
+	{attr: common.M3U8Scte35In, types: []ValueType{valueHexaDecimalSeq}},
+	{attr: common.M3U8EndOnNext, types: []ValueType{valueEnumeratedString}},
+	{attr: common.M3U8AssocLanguage, types: []ValueType{valueQuotedString}},
+	{attr: common.M3U8Forced, types: []ValueType{valueEnumeratedString}},
+	{attr: common.M3U8InStreamId, types: []ValueType{valueQuotedString}},
+	{attr: common.M3U8Characteristics, types: []ValueType{valueQuotedString}},
 	{attr: common.M3U8HdcpLevel, types: []ValueType{valueEnumeratedString}},
 	{attr: common.M3U8Video, types: []ValueType{valueQuotedString}},
 	{attr: common.M3U8Subtitles, types: []ValueType{valueQuotedString}},
 	{attr: common.M3U8ClosedCaptions, types: []ValueType{valueQuotedString | valueEnumeratedString}},
-	{attr: common.M3U8TimeOffset, types: nil},
-	{attr: common.M3U8Precise, types: nil},
-	{attr: common.M3U8DataId, types: nil},
-	{attr: common.M3U8Value, types: nil},
+	{attr: common.M3U8TimeOffset, types: []ValueType{valueSignedDecimalFloat}},
+	{attr: common.M3U8Precise, types: []ValueType{valueEnumeratedString}},
+	{attr: common.M3U8DataId, types: []ValueType{valueQuotedString}},
+	{attr: common.M3U8Value, types: []ValueType{valueQuotedString}},
 	{attr: common.M3U8Title, types: nil},
+	{attr: common.M3U8ByteRangeStart, types: []ValueType{valueDecimalInt}},
+	{attr: common.M3U8ByteRangeLength, types: []ValueType{valueDecimalInt}},
+	{attr: common.INTUnknownAttr, types: nil},
+	{attr: common.INTProgramDateTime, types: nil},
+	{attr: common.INTMediaSequenceNumber, types: nil},
+	{attr: common.INTPartNumber, types: nil},
 	{attr: common.M3U8ProgramId, types: []ValueType{valueDecimalInt}},
+	{attr: common.M3U8Gap, types: []ValueType{valueEnumeratedString}},
+	{attr: common.M3U8Import, types: []ValueType{valueQuotedString}},
+	{attr: common.M3U8QueryParam, types: []ValueType{valueQuotedString}},
+	{attr: common.M3U8ServerUri, types: []ValueType{valueQuotedString}},
+	{attr: common.M3U8PathwayId, types: []ValueType{valueQuotedString}},
+	{attr: common.M3U8HoldBack, types: []ValueType{valueSignedDecimalFloat}},
+	{attr: common.M3U8CanSkipDateRanges, types: []ValueType{valueEnumeratedString}},
+	{attr: common.M3U8RecentlyRemovedDateRanges, types: []ValueType{valueQuotedString}},
+	{attr: common.M3U8VideoRange, types: []ValueType{valueEnumeratedString}},
+	{attr: common.M3U8Score, types: []ValueType{valueSignedDecimalFloat}},
 }
@@ -10,7 +10,15 @@ type M3u8Handler interface {
 	PostRecord(tag common.TagId, kvpairs *AttrKVPairs) error
 }
 
+//Parser - reads the playlist and posts an entry for each of the tags to the handler
+//Errors returned are ParseError (errors.As) with the position of the failure
 type Parser interface {
 	ParseData(data []byte, handler M3u8Handler, parseBuffer []byte) (nBytes int, err error)
 	Parse(rdr io.Reader, handler M3u8Handler, parseBuffer []byte) (nBytes int, err error)
 }
+
+//HasURILine - the tag is followed by its URI line (EXTINF, EXT-X-STREAM-INF)
+//Blank lines and other tags can come in between, these tags are posted before it
+func HasURILine(tagId common.TagId) bool {
+	return tagId == common.M3U8ExtInf || tagId == common.M3U8ExtXStreamInf
+}
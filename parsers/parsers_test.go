@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/eswarantg/m3u8reader/common"
 	"github.com/eswarantg/m3u8reader/parsers"
@@ -372,3 +373,237 @@ func BenchmarkParse4(b *testing.B) {
 	}
 }
 */
+
+type RecordHandler struct {
+	tags   []common.TagId
+	values []map[common.AttrId]interface{}
+}
+
+func (h *RecordHandler) PostRecord(tag common.TagId, kvpairs *parsers.AttrKVPairs) error {
+	h.tags = append(h.tags, tag)
+	var values map[common.AttrId]interface{}
+	if kvpairs != nil {
+		values = kvpairs.Map()
+	}
+	h.values = append(h.values, values)
+	return nil
+}
+
+func Test_GapBitrate(t *testing.T) {
+	data, err := ReadFile("../test/ll_hls_gap.m3u8", t)
+	if err != nil {
+		return
+	}
+	parsers.AttrKVPairsSyncPool = false
+	for _, test := range conformanceParsers() {
+		hdlr := &RecordHandler{}
+		_, err = test.parser.ParseData(data, hdlr, make([]byte, 4096))
+		if err != nil {
+			t.Errorf("%v : %v", test.name, err)
+			continue
+		}
+		var gaps int
+		var bitrates []interface{}
+		var partGap interface{}
+		for i, tag := range hdlr.tags {
+			switch tag {
+			case common.M3U8ExtXGap:
+				gaps++
+			case common.M3U8ExtXBitrate:
+				bitrates = append(bitrates, hdlr.values[i][common.INTUnknownAttr])
+			case common.M3U8ExtXPart:
+				if v, ok := hdlr.values[i][common.M3U8Gap]; ok {
+					partGap = v
+				}
+			}
+		}
+		if gaps != 1 {
+			t.Errorf("%v : EXT-X-GAP expected %v : got %v", test.name, 1, gaps)
+		}
+		if len(bitrates) != 2 || bitrates[0] != int64(2500) || bitrates[1] != int64(1800) {
+			t.Errorf("%v : EXT-X-BITRATE expected [2500 1800] : got %v", test.name, bitrates)
+		}
+		if partGap != true {
+			t.Errorf("%v : EXT-X-PART GAP expected true : got %v", test.name, partGap)
+		}
+	}
+}
+
+func Test_Define(t *testing.T) {
+	data := []byte("#EXTM3U\n#EXT-X-DEFINE:NAME=\"host\",VALUE=\"cdn.example.com\"\n#EXT-X-DEFINE:IMPORT=\"token\"\n#EXT-X-DEFINE:QUERYPARAM=\"session\"\n")
+	parsers.AttrKVPairsSyncPool = false
+	expected := [][]common.AttrId{
+		{common.M3U8Name, common.M3U8Value},
+		{common.M3U8Import},
+		{common.M3U8QueryParam},
+	}
+	for _, test := range conformanceParsers() {
+		hdlr := &RecordHandler{}
+		_, err := test.parser.ParseData(data, hdlr, make([]byte, 4096))
+		if err != nil {
+			t.Errorf("%v : %v", test.name, err)
+			continue
+		}
+		var defines []map[common.AttrId]interface{}
+		for i, tag := range hdlr.tags {
+			if tag == common.M3U8ExtXDefine {
+				defines = append(defines, hdlr.values[i])
+			}
+		}
+		if len(defines) != len(expected) {
+			t.Errorf("%v : EXT-X-DEFINE expected %v : got %v", test.name, len(expected), len(defines))
+			continue
+		}
+		for i, attrs := range expected {
+			for _, attrId := range attrs {
+				if _, ok := defines[i][attrId]; !ok {
+					t.Errorf("%v : %v : %v missing", test.name, i, common.AttrNames[attrId])
+				}
+			}
+		}
+	}
+}
+
+func Test_TagsBeforeURI(t *testing.T) {
+	data := []byte("#EXTM3U\n#EXT-X-STREAM-INF:BANDWIDTH=1000\n\nvideo.m3u8\n#EXTINF:4.0,\n#EXT-X-CUE-OUT:4\n#EXT-X-KEY:METHOD=NONE\n\nseg.ts\n")
+	parsers.AttrKVPairsSyncPool = false
+	expected := []common.TagId{common.M3U8FormatIdentifier, common.M3U8ExtXStreamInf, common.M3U8ExtXKey, common.M3U8ExtInf}
+	for _, test := range conformanceParsers() {
+		hdlr := &RecordHandler{}
+		_, err := test.parser.ParseData(data, hdlr, make([]byte, 4096))
+		if err != nil {
+			t.Errorf("%v : %v", test.name, err)
+			continue
+		}
+		if fmt.Sprint(hdlr.tags) != fmt.Sprint(expected) {
+			t.Errorf("%v : tags expected %v : got %v", test.name, expected, hdlr.tags)
+			continue
+		}
+		if uri := hdlr.values[1][common.INTUnknownAttr]; uri != "video.m3u8" {
+			t.Errorf("%v : EXT-X-STREAM-INF URI expected %v : got %v", test.name, "video.m3u8", uri)
+		}
+		if uri := hdlr.values[3][common.M3U8Uri]; uri != "seg.ts" {
+			t.Errorf("%v : EXTINF URI expected %v : got %v", test.name, "seg.ts", uri)
+		}
+	}
+}
+
+func Test_AttrTypes(t *testing.T) {
+	data := []byte(`#EXTM3U
+#EXT-X-START:TIME-OFFSET=-12.5,PRECISE=YES
+#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID="aud",NAME="English",DEFAULT=YES,AUTOSELECT=NO,LANGUAGE="en"
+#EXT-X-SERVER-CONTROL:CAN-BLOCK-RELOAD=YES,CAN-SKIP-UNTIL=24.0,HOLD-BACK=12.0
+#EXT-X-KEY:METHOD=AES-128,URI="key.bin",IV=0x0123456789ABCDEF0123456789abcdef,KEYFORMATVERSIONS="1"
+#EXT-X-DATERANGE:ID="ad1",CLASS="ad",START-DATE="2022-01-20T12:16:44.000Z",DURATION=30.5,PLANNED-DURATION=30,END-ON-NEXT=YES
+#EXT-X-PART:DURATION=1.0,URI="part1.mp4",BYTERANGE="1000"
+#EXT-X-STREAM-INF:BANDWIDTH=1280000,RESOLUTION=1280x720
+hi.m3u8
+`)
+	parsers.AttrKVPairsSyncPool = false
+	startDate, _ := time.Parse(time.RFC3339Nano, "2022-01-20T12:16:44.000Z")
+	expected := map[common.TagId]map[common.AttrId]interface{}{
+		common.M3U8ExtXStart: {
+			common.M3U8TimeOffset: float64(-12.5),
+			common.M3U8Precise:    true,
+		},
+		common.M3U8ExtXMedia: {
+			common.M3U8Name:       "English",
+			common.M3U8Default:    true,
+			common.M3U8AutoSelect: false,
+		},
+		common.M3U8ExtXServerControl: {
+			common.M3U8CanBlockReload: true,
+			common.M3U8CanSkipUntil:   float64(24),
+			common.M3U8HoldBack:       float64(12),
+		},
+		common.M3U8ExtXKey: {
+			common.M3U8Method: "AES-128",
+			common.M3U8Uri:    "key.bin",
+			common.M3U8IV: [16]byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef,
+				0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef},
+			common.M3U8KeyFormatVersions: "1",
+		},
+		common.M3U8ExtXDataRange: {
+			common.M3U8Id:              "ad1",
+			common.M3U8Class:           "ad",
+			common.M3U8StartDate:       startDate,
+			common.M3U8Duration:        float64(30.5),
+			common.M3U8PlannedDuration: float64(30),
+			common.M3U8EndOnNext:       true,
+		},
+		common.M3U8ExtXPart: {
+			common.M3U8Duration:  float64(1),
+			common.M3U8ByteRange: [2]int64{1000, -1},
+		},
+		common.M3U8ExtXStreamInf: {
+			common.M3U8Bandwidth:  int64(1280000),
+			common.M3U8Resolution: common.Resolution{Width: 1280, Height: 720},
+		},
+	}
+	for _, test := range conformanceParsers() {
+		hdlr := &RecordHandler{}
+		_, err := test.parser.ParseData(data, hdlr, make([]byte, 4096))
+		if err != nil {
+			t.Errorf("%v : %v", test.name, err)
+			continue
+		}
+		found := 0
+		for i, tag := range hdlr.tags {
+			attrs, ok := expected[tag]
+			if !ok {
+				continue
+			}
+			found++
+			for attrId, want := range attrs {
+				got := hdlr.values[i][attrId]
+				if wantTime, ok := want.(time.Time); ok {
+					if gotTime, ok := got.(time.Time); ok && gotTime.Equal(wantTime) {
+						continue
+					}
+				} else if got == want {
+					continue
+				}
+				t.Errorf("%v : %v:%v expected %T(%v) : got %T(%v)", test.name, common.TagNames[tag],
+					common.AttrNames[attrId], want, want, got, got)
+			}
+		}
+		if found != len(expected) {
+			t.Errorf("%v : tags expected %v : got %v", test.name, len(expected), found)
+		}
+	}
+}
+
+func Test_ParseIV(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected [16]byte
+		err      bool
+	}{
+		{"0x000102030405060708090A0B0C0D0E0F", [16]byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}, false},
+		{"0X1", [16]byte{15: 1}, false},
+		{"0x10A", [16]byte{14: 1, 15: 0x0a}, false},
+		{"000102", [16]byte{}, true},
+		{"0x", [16]byte{}, true},
+		{"0xZZ", [16]byte{}, true},
+		{"0x000102030405060708090A0B0C0D0E0F10", [16]byte{}, true},
+	}
+	for _, test := range tests {
+		iv, err := parsers.ParseIV(test.in)
+		if (err != nil) != test.err {
+			t.Errorf("%v : error expected %v : got %v", test.in, test.err, err)
+			continue
+		}
+		if !test.err && iv != test.expected {
+			t.Errorf("%v : expected %x : got %x", test.in, test.expected, iv)
+		}
+	}
+}
+
+func Test_AttrKVPairsZeroValue(t *testing.T) {
+	kv := &parsers.AttrKVPairs{}
+	kv.Store(common.M3U8Bandwidth, int64(1000))
+	v, err := kv.GetInt64(common.M3U8ExtXStreamInf, common.M3U8Bandwidth)
+	if err != nil || v != 1000 {
+		t.Errorf("zero value : expected 1000 : got %v %v", v, err)
+	}
+}
@@ -1,11 +1,8 @@
 package scanparser
 
 import (
-	"bytes"
-	"errors"
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/eswarantg/m3u8reader/common"
@@ -42,7 +39,7 @@ func decorateM3U8ExtXMedia(kv parsers.AttrKVPairs) (err error) {
 	tagId := common.M3U8ExtXMedia
 	attrs := []common.AttrId{
 		common.M3U8Type,
-		common.M3U8Language, common.M3U8GroupId,
+		common.M3U8GroupId,
 	}
 	err = checkExists(kv, attrs, tagId)
 	return
@@ -100,20 +97,21 @@ func decorateM3U8ExtXPartInf(kv parsers.AttrKVPairs) (err error) {
 
 func decorateM3U8ExtXRenditionReport(kv parsers.AttrKVPairs) (err error) {
 	tagId := common.M3U8ExtXRenditionReport
-	attrs := []common.AttrId{common.M3U8LastMsn, common.M3U8LastPart}
+	attrs := []common.AttrId{common.M3U8LastMsn}
 	err = convertToInt64(kv, attrs, tagId, false)
 	if err != nil {
 		return
 	}
-	attrs = []common.AttrId{common.M3U8ByteRangeStart}
+	attrs = []common.AttrId{common.M3U8LastPart, common.M3U8ByteRangeStart}
 	err = convertToInt64(kv, attrs, tagId, true) //optional
 	return
 }
 
 func decorateM3U8ExtXServerControl(kv parsers.AttrKVPairs) (err error) {
 	tagId := common.M3U8ExtXServerControl
-	attrs := []common.AttrId{common.M3U8CanSkipUntil, common.M3U8PartHoldBack}
-	err = convertToFloat64(kv, attrs, tagId, false)
+	//all the attributes of EXT-X-SERVER-CONTROL are optional
+	attrs := []common.AttrId{common.M3U8CanSkipUntil, common.M3U8PartHoldBack, common.M3U8HoldBack}
+	err = convertToFloat64(kv, attrs, tagId, true)
 	return
 }
 
@@ -136,6 +134,13 @@ func decorateM3U8ExtXPreLoadHint(kv parsers.AttrKVPairs) (err error) {
 	return
 }
 
+func decorateM3U8ExtXBitrate(kv parsers.AttrKVPairs) (err error) {
+	tagId := common.M3U8ExtXBitrate
+	attrs := []common.AttrId{common.INTUnknownAttr}
+	err = convertToInt64(kv, attrs, tagId, false)
+	return
+}
+
 var decorators = map[common.TagId]func(kv parsers.AttrKVPairs) error{
 	common.M3U8ExtXVersion:          decorateM3U8ExtXVersion,
 	common.M3U8TargetDuration:       decorateM3U8TargetDuration,
@@ -150,11 +155,35 @@ var decorators = map[common.TagId]func(kv parsers.AttrKVPairs) error{
 	common.M3U8ExtXServerControl:    decorateM3U8ExtXServerControl,
 	common.M3U8XSkip:                decorateM3U8XSkip,
 	common.M3U8ExtXPreLoadHint:      decorateM3U8ExtXPreLoadHint,
+	common.M3U8ExtXBitrate:          decorateM3U8ExtXBitrate,
 }
 
 func decorateEntry(tag common.TagId, kv parsers.AttrKVPairs) (err error) {
 	if decorateFn, ok := decorators[tag]; ok {
 		err = decorateFn(kv)
+		if err != nil {
+			return
+		}
+	}
+	//attributes without a decorator are typed as per RFC 8216
+	err = parsers.TypeValues(tag, &kv)
+	return
+}
+
+//openAttrId - attribute to store a value which is not part of an attribute list
+//The value on the line of the tag is INTUnknownAttr (TITLE for the 2nd value of EXTINF)
+//The URI line following the tag is INTUnknownAttr (URI for EXTINF)
+func openAttrId(tagId common.TagId, kv parsers.AttrKVPairs, nextLine bool) (attrId common.AttrId, err error) {
+	attrId = common.INTUnknownAttr
+	if tagId == common.M3U8ExtInf {
+		if nextLine {
+			attrId = common.M3U8Uri
+		} else if kv.Exists(common.INTUnknownAttr) {
+			attrId = common.M3U8Title
+		}
+	}
+	if kv.Exists(attrId) {
+		err = parsers.NewParseError(parsers.ErrDuplicateAttribute, tagId, attrId, nil)
 	}
 	return
 }
@@ -162,7 +191,7 @@ func decorateEntry(tag common.TagId, kv parsers.AttrKVPairs) (err error) {
 func checkExists(kv parsers.AttrKVPairs, attrIds []common.AttrId, tagId common.TagId) error {
 	for _, attrId := range attrIds {
 		if val := kv.Get(attrId); val == nil {
-			return fmt.Errorf("missing \"%v\":\"%v\" value", common.TagNames[tagId], common.AttrNames[attrId])
+			return parsers.NewParseError(parsers.ErrMissingAttribute, tagId, attrId, nil)
 		}
 	}
 	return nil
@@ -178,16 +207,14 @@ func convertToFloat64(kv parsers.AttrKVPairs, attrIds []common.AttrId, tagId com
 			case string:
 				newVal, err = strconv.ParseFloat(v, 64)
 			default:
-				panic(fmt.Sprintf("\nconvertToFloat64 \"%v\":\"%v\" is %T(\"%v\") not string", common.TagNames[tagId],
-					common.AttrNames[attrId], v, v))
+				err = fmt.Errorf("%T not string", v)
 			}
 			if err != nil {
-				return fmt.Errorf("invalid Float value \"%v\":\"%v\"=\"%v\" - \"%v\"", common.TagNames[tagId],
-					common.AttrNames[attrId], val, err.Error())
+				return parsers.NewParseError(parsers.ErrBadValue, tagId, attrId, fmt.Errorf("\"%v\" : %w", val, err))
 			}
 			kv.Store(attrId, newVal)
 		} else if !optional {
-			return fmt.Errorf("missing \"%v\":\"%v\" Float value", common.TagNames[tagId], common.AttrNames[attrId])
+			return parsers.NewParseError(parsers.ErrMissingAttribute, tagId, attrId, nil)
 		}
 	}
 	return nil
@@ -203,16 +230,14 @@ func convertToInt64(kv parsers.AttrKVPairs, attrIds []common.AttrId, tagId commo
 			case string:
 				newVal, err = strconv.ParseInt(v, 10, 64)
 			default:
-				panic(fmt.Sprintf("\nconvertToInt64 \"%v\":\"%v\" is %T(\"%v\") not string", common.TagNames[tagId],
-					common.AttrNames[attrId], v, v))
+				err = fmt.Errorf("%T not string", v)
 			}
 			if err != nil {
-				return fmt.Errorf("invalid Int value \"%v\":\"%v\"=\"%v\" - \"%v\"", common.TagNames[tagId],
-					common.AttrNames[attrId], val, err.Error())
+				return parsers.NewParseError(parsers.ErrBadValue, tagId, attrId, fmt.Errorf("\"%v\" : %w", val, err))
 			}
 			kv.Store(attrId, newVal)
 		} else if !optional {
-			return fmt.Errorf("missing \"%v\":\"%v\" Int value", common.TagNames[tagId], common.AttrNames[attrId])
+			return parsers.NewParseError(parsers.ErrMissingAttribute, tagId, attrId, nil)
 		}
 	}
 	return nil
@@ -250,47 +275,18 @@ func convertToByteRange(kv parsers.AttrKVPairs, attrIds []common.AttrId, tagId c
 		if val := kv.Get(attrId); val != nil {
 			switch v := val.(type) {
 			case []byte:
-				parts := bytes.Split(v, []byte{'@'})
-				switch len(parts) {
-				case 2:
-					newVal[1], err = strconv.ParseInt(string(parts[1]), 10, 64)
-					if err != nil {
-						break
-					}
-					newVal[0], err = strconv.ParseInt(string(parts[0]), 10, 64)
-				case 1:
-					err = errors.New("byteRange with 1 part not supported, expect n@o")
-					//newVal[1] = 0
-					//newVal[0], err = strconv.ParseInt(string(parts[0]), 10, 64)
-				default:
-					err = errors.New("byteRange expected 1 part or 2 parts with @ seperator")
-				}
+				newVal, err = parsers.ParseByteRange(string(v))
 			case string:
-				parts := strings.Split(v, "@")
-				switch len(parts) {
-				case 2:
-					newVal[1], err = strconv.ParseInt(parts[1], 10, 64)
-					if err != nil {
-						break
-					}
-					newVal[0], err = strconv.ParseInt(parts[0], 10, 64)
-				case 1:
-					newVal[1] = -1
-					newVal[0], err = strconv.ParseInt(parts[0], 10, 64)
-				default:
-					err = errors.New("byteRange expected 1 part or 2 parts with @ seperator")
-				}
+				newVal, err = parsers.ParseByteRange(v)
 			default:
-				panic(fmt.Sprintf("\nconvertToByteRange \"%v\":\"%v\" is %T(\"%v\") not string", common.TagNames[tagId],
-					common.AttrNames[attrId], v, v))
+				err = fmt.Errorf("%T not string", v)
 			}
 			if err != nil {
-				return fmt.Errorf("invalid byteRange value \"%v\":\"%v\"=\"%v\" - \"%v\"", common.TagNames[tagId],
-					common.AttrNames[attrId], val, err.Error())
+				return parsers.NewParseError(parsers.ErrBadValue, tagId, attrId, fmt.Errorf("\"%v\" : %w", val, err))
 			}
 			kv.Store(attrId, newVal)
 		} else if !optional {
-			return fmt.Errorf("missing \"%v\":\"%v\" byteRange value", common.TagNames[tagId], common.AttrNames[attrId])
+			return parsers.NewParseError(parsers.ErrMissingAttribute, tagId, attrId, nil)
 		}
 	}
 	return nil
@@ -306,16 +302,14 @@ func convertToTime(kv parsers.AttrKVPairs, attrIds []common.AttrId, tagId common
 			case string:
 				newVal, err = time.Parse(time.RFC3339Nano, v)
 			default:
-				panic(fmt.Sprintf("\nconvertToTime %v:%v is %T(\"%v\") not string", common.TagNames[tagId],
-					common.AttrNames[attrId], v, v))
+				err = fmt.Errorf("%T not string", v)
 			}
 			if err != nil {
-				return fmt.Errorf("invalid Time value \"%v\":\"%v\"=\"%v\" - \"%v\"", common.TagNames[tagId],
-					common.AttrNames[attrId], val, err.Error())
+				return parsers.NewParseError(parsers.ErrBadValue, tagId, attrId, fmt.Errorf("\"%v\" : %w", val, err))
 			}
 			kv.Store(attrId, newVal)
 		} else if !optional {
-			return fmt.Errorf("missing \"%v\":\"%v\" Time value", common.TagNames[tagId], common.AttrNames[attrId])
+			return parsers.NewParseError(parsers.ErrMissingAttribute, tagId, attrId, nil)
 		}
 	}
 	return nil
@@ -12,6 +12,7 @@ import (
 
 type ScanParser1 struct {
 	extHandler parsers.M3u8Handler
+	positions  scanPositions
 }
 
 func (s *ScanParser1) PostRecord(tag common.TagId, kvpairs *parsers.AttrKVPairs) error {
@@ -19,21 +20,21 @@ func (s *ScanParser1) PostRecord(tag common.TagId, kvpairs *parsers.AttrKVPairs)
 	if kvpairs != nil {
 		err = decorateEntry(tag, *kvpairs)
 		if err != nil {
-			return err
+			return parsers.Locate(err, parsers.ErrBadValue, tag, s.positions.tag)
 		}
 	}
 	if s.extHandler == nil {
-		panic("\nInvalid extHandler for post")
+		return fmt.Errorf("invalid extHandler for post")
 	}
 	err = s.extHandler.PostRecord(tag, kvpairs)
-	return err
+	return parsers.Locate(err, parsers.ErrHandler, tag, s.positions.tag)
 }
 
 func (s *ScanParser1) Parse(rdr io.Reader, handler parsers.M3u8Handler, buffer []byte) (nBytes int, err error) {
 	s.extHandler = handler
 	scan := bufio.NewScanner(rdr)
 	scan.Buffer(buffer, len(buffer))
-	return parseM3U8_1(scan, s)
+	return parseM3U8_1(scan, s, &s.positions)
 }
 
 func (s *ScanParser1) ParseData(data []byte, handler parsers.M3u8Handler, buffer []byte) (nBytes int, err error) {
@@ -41,13 +42,16 @@ func (s *ScanParser1) ParseData(data []byte, handler parsers.M3u8Handler, buffer
 	rdr := bytes.NewReader(data)
 	scan := bufio.NewScanner(rdr)
 	scan.Buffer(buffer, len(buffer))
-	return parseM3U8_1(scan, s)
+	return parseM3U8_1(scan, s, &s.positions)
 }
 
-func parseM3U8_1(s *bufio.Scanner, handler parsers.M3u8Handler) (nBytes int, err error) {
+func parseM3U8_1(s *bufio.Scanner, handler parsers.M3u8Handler, positions *scanPositions) (nBytes int, err error) {
 	//Custom Split Function - Begin
 	tokenCount := -1
 	inTokenRead := false
+	//EXTINF title is read as text till the end of the line
+	titleExpected := false
+	inTextRead := false
 
 	nBytes = 0
 	lastTokenNewline := true
@@ -56,25 +60,52 @@ func parseM3U8_1(s *bufio.Scanner, handler parsers.M3u8Handler) (nBytes int, err
 		inQuotes := false
 		tokenCount++
 		carriageReturnRead := false
+		if len(data) > 0 {
+			//line end is a token by itself
+			eol := 0
+			if data[0] == '\r' && len(data) > 1 && data[1] == '\n' {
+				eol = 1
+			}
+			if data[eol] == '\n' {
+				inTokenRead = false
+				lastTokenNewline = true
+				titleExpected = false
+				inTextRead = false
+				nBytes += eol + 1
+				return eol + 1, data[eol : eol+1], nil
+			}
+			//line not starting with # (URI) and text are read entirely
+			if !inTokenRead && ((lastTokenNewline && data[0] != '#' && data[0] != '\r') || inTextRead) {
+				pos := bytes.IndexAny(data, "\r\n")
+				if pos < 0 {
+					if !atEOF {
+						return 0, nil, nil //need more characters
+					}
+					pos = len(data)
+				}
+				lastTokenNewline = false
+				inTextRead = false
+				nBytes += pos
+				return pos, data[0:pos], nil
+			}
+		}
 		for i, ch := range data {
 			if inTokenRead {
-				if ch != ':' && ch != '\n' {
+				if ch != ':' && ch != '\n' && ch != '\r' {
 					continue
 				}
 				if i == 0 {
-					inTokenRead = false
-					if ch == '\n' {
-						lastTokenNewline = true
-						//Skip new line
-						nBytes += 1
-						return 1, data[0:1], nil
-					} else {
-						//Token ':'
-						nBytes += 1
-						return 1, data[0:1], nil
+					if ch == '\r' && !atEOF {
+						//line end is read as next token
+						return 0, nil, nil //need more characters
 					}
+					inTokenRead = false
+					//Token ':' or \r at the end
+					nBytes += 1
+					return 1, data[0:1], nil
 				} else {
 					//String before
+					titleExpected = bytes.Equal(data[0:i], []byte("EXTINF"))
 					nBytes += i
 					return i, data[0:i], nil
 				}
@@ -92,35 +123,14 @@ func parseM3U8_1(s *bufio.Scanner, handler parsers.M3u8Handler) (nBytes int, err
 				return i + 1, data[1:i], nil
 			}
 			if ch == '\n' {
-				lastTokenNewline = true
-				if i > 0 {
-					if data[0] == '\n' || data[0] == '\r' {
-						//String before
-						nBytes += i
-						if carriageReturnRead {
-							return i, data[2:i], nil
-						} else {
-							return i, data[1:i], nil
-						}
-					} else {
-						//String before
-						nBytes += i
-						return i, data[0:i], nil
-					}
-				}
+				//String before, line end is read as next token
 				if carriageReturnRead {
-					nBytes += 2
-					return 2, data[1:2], nil
-				} else {
-					nBytes += 1
-					return 1, data[0:1], nil
-				}
-			} else {
-				if i > 0 && data[i-1] != '\n' {
-					lastTokenNewline = false
+					i--
 				}
-				carriageReturnRead = false
+				nBytes += i
+				return i, data[0:i], nil
 			}
+			carriageReturnRead = false
 			switch ch {
 			case '\r':
 				carriageReturnRead = true
@@ -129,14 +139,19 @@ func parseM3U8_1(s *bufio.Scanner, handler parsers.M3u8Handler) (nBytes int, err
 				inQuotes = true
 				continue
 			case '#':
-				if (i == 1 || i == 0) && lastTokenNewline {
+				if i == 0 && lastTokenNewline {
 					inTokenRead = true
+					lastTokenNewline = false
 					//Token
 					nBytes += i + 1
 					return i + 1, data[i : i+1], nil
 				}
 			case ',', '=':
 				if i == 0 {
+					if ch == ',' && titleExpected {
+						titleExpected = false
+						inTextRead = true
+					}
 					//Token
 					nBytes += 1
 					return 1, data[0 : i+1], nil
@@ -153,40 +168,91 @@ func parseM3U8_1(s *bufio.Scanner, handler parsers.M3u8Handler) (nBytes int, err
 		return 0, nil, nil
 	}
 
-	s.Split(custSplitFn)
+	positions.init()
+	s.Split(positions.wrap(custSplitFn))
 	//Custom Split Function - End
 
 	//Post Record Entry - Start
 	var kvpairs *parsers.AttrKVPairs
 	kvpairs = parsers.NewAttrKVPairs() //initalize
-	var lastToken []byte
+	var lastTokenCh byte
 	var key []byte
 	var tag []byte
 	tagId := common.M3U8UNKNOWNTAG
-	postRecordFn := func() (err error) {
-		if tag != nil {
-			if key != nil {
-				newkey := common.INTUnknownAttr
-				if kvpairs.Exists(common.INTUnknownAttr) {
-					//Already present
-					switch tagId {
-					case common.M3U8ExtInf:
-						newkey = common.M3U8Uri
-					default:
-						//panic(fmt.Sprintf("Duplicate INTUnknownAttr for %v required.", tag))
-					}
-				}
-				kvpairs.Store(newkey, string(key))
+	//value is from the line following the tag
+	nextLine := false
+	//a value is read from the line following the tag
+	lineRead := false
+	//comment or unknown tag
+	ignoreLine := false
+	//tag waiting for its URI line, the tags in between are posted before it
+	var pending *parsers.AttrKVPairs
+	pendingTag := common.M3U8UNKNOWNTAG
+	var pendingPos parsers.Position
+	//URI line of the tag pending
+	var uri []byte
+	storeOpenFn := func() (err error) {
+		if key != nil {
+			if nextLine && pending != nil && uri == nil {
+				uri, key = key, nil
+				return
+			}
+			if tag == nil {
+				//line following a comment or an unknown tag
 				key = nil
+				return
+			}
+			var attrId common.AttrId
+			attrId, err = openAttrId(tagId, *kvpairs, nextLine)
+			if err == nil {
+				kvpairs.Store(attrId, string(key))
 			}
-			//fmt.Printf("\npostRecordFn %v %v", tag, kvpairs)
-			tagId, ok := common.TagToTagId[string(tag)]
-			if ok {
+			lineRead = lineRead || nextLine
+			key = nil
+		}
+		return
+	}
+	postPendingFn := func() (err error) {
+		if pending == nil {
+			return
+		}
+		if uri != nil {
+			var attrId common.AttrId
+			attrId, err = openAttrId(pendingTag, *pending, true)
+			if err != nil {
+				return parsers.Locate(err, parsers.ErrSyntax, pendingTag, pendingPos)
+			}
+			pending.Store(attrId, string(uri))
+		}
+		//errors are located at the tag pending
+		tagPos := positions.tag
+		positions.tag = pendingPos
+		err = handler.PostRecord(pendingTag, pending)
+		positions.tag = tagPos
+		pending, uri = nil, nil
+		return
+	}
+	postRecordFn := func() (err error) {
+		err = storeOpenFn()
+		if err != nil {
+			return
+		}
+		if tag != nil {
+			if !lineRead && parsers.HasURILine(tagId) {
+				pending, pendingTag, pendingPos = kvpairs, tagId, positions.tag
+			} else {
+				//fmt.Printf("\npostRecordFn %v %v", tag, kvpairs)
 				err = handler.PostRecord(tagId, kvpairs)
-				kvpairs = parsers.NewAttrKVPairs() //use new one next time
+				if err != nil {
+					return
+				}
 			}
+			kvpairs = parsers.NewAttrKVPairs() //use new one next time
 			tag = nil
 		}
+		if uri != nil {
+			err = postPendingFn()
+		}
 		return
 	}
 	//Post Record Entry - End
@@ -197,31 +263,39 @@ func parseM3U8_1(s *bufio.Scanner, handler parsers.M3u8Handler) (nBytes int, err
 		if len(curToken) == 0 {
 			continue
 		}
-		if bytes.Equal(curToken, []byte{'#'}) {
+		switch {
+		case bytes.Equal(curToken, []byte{'#'}):
 			err = postRecordFn()
-			if err != nil {
-				break
-			}
-		} else {
-			switch lastToken[0] {
+			ignoreLine = false
+			positions.tag = positions.token
+		case ignoreLine && curToken[0] != '\n':
+			//skip the tokens till the end of the line
+		case lastTokenCh == 0:
+			err = fmt.Errorf("expected # not found")
+		case curToken[0] == '\n':
+			ignoreLine = false
+			//value pending is from the line of the tag
+			err = storeOpenFn()
+			nextLine = true
+		default:
+			switch lastTokenCh {
 			case '#':
-				if curToken[0] == '\n' {
-					continue //skip new line with only #
-				}
 				var ok bool
-				tag = curToken
+				tag = bytes.Clone(curToken)
 				tagId, ok = common.TagToTagId[string(tag)]
 				if !ok {
-					panic(fmt.Sprintf("\nUnknown Tag : \"%v\"", string(tag)))
+					//comments and unknown tags are ignored
+					tag = nil
+					ignoreLine = true
+				} else if parsers.HasURILine(tagId) {
+					//tag pending without its URI line
+					err = postPendingFn()
 				}
+				nextLine = false
+				lineRead = false
 			case ',', ':':
-				if key != nil {
-					kvpairs.Store(common.INTUnknownAttr, string(key))
-					key = nil
-				}
-				if curToken[0] != '\n' {
-					key = curToken
-				}
+				err = storeOpenFn()
+				key = bytes.Clone(curToken)
 			case '=':
 				attrId, ok := common.AttrToAttrId[string(key)]
 				if ok {
@@ -229,23 +303,31 @@ func parseM3U8_1(s *bufio.Scanner, handler parsers.M3u8Handler) (nBytes int, err
 				}
 				key = nil
 			case '\n':
-				if curToken[0] != '\n' {
-					if key != nil {
-						attrId, ok := common.AttrToAttrId[string(key)]
-						if ok {
-							kvpairs.Store(attrId, string(curToken))
-						}
-						key = nil
-					} else {
-						key = curToken
-					}
-				}
+				key = bytes.Clone(curToken)
 			}
 		}
-		lastToken = s.Bytes()
+		if err != nil {
+			break
+		}
+		lastTokenCh = curToken[0]
+		if len(curToken) > 1 {
+			//values are not delimitters even if starting with one
+			lastTokenCh = 'v'
+		}
+	}
+	if err == nil {
+		err = s.Err()
 	}
 	if err == nil {
 		err = postRecordFn()
 	}
+	if err == nil {
+		//tag pending without its URI line
+		err = postPendingFn()
+	}
+	if err != nil && tag == nil {
+		tagId = common.M3U8UNKNOWNTAG
+	}
+	err = parsers.Locate(err, parsers.ErrSyntax, tagId, positions.token)
 	return
 }
@@ -5,7 +5,6 @@ import (
 	"bytes"
 	"fmt"
 	"io"
-	"regexp"
 	"strings"
 
 	"github.com/eswarantg/m3u8reader/common"
@@ -25,7 +24,7 @@ func (s *ScanParser2) PostRecord(tag common.TagId, kvpairs *parsers.AttrKVPairs)
 		}
 	}
 	if s.extHander == nil {
-		panic("\nInvalid extHandler for post")
+		return fmt.Errorf("invalid extHandler for post")
 	}
 	err = s.extHander.PostRecord(tag, kvpairs)
 	return err
@@ -65,60 +64,185 @@ func stringSplitFunc(data []byte, atEOF bool) (advance int, token []byte, err er
 	return
 }
 
+//splitAttributes - splits the attribute list at the commas not within quotes
+func splitAttributes(str string) (items []string) {
+	inQuotes := false
+	start := 0
+	for i := 0; i < len(str); i++ {
+		switch str[i] {
+		case '"':
+			inQuotes = !inQuotes
+		case ',':
+			if !inQuotes {
+				items = append(items, str[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(items, str[start:])
+}
+
+//unquote - value within the quotes of a quoted-string
+func unquote(str string) string {
+	if len(str) >= 2 && str[0] == '"' && str[len(str)-1] == '"' {
+		return str[1 : len(str)-1]
+	}
+	return str
+}
+
 func parseM3U8_fast(src io.Reader, handler parsers.M3u8Handler) (nBytes int, err error) {
 	s := bufio.NewScanner(src)
 	s.Split(stringSplitFunc)
-	i := 0
+	entries := 0
+	//position of the entry being read
+	pos := parsers.StartPosition()
+	tagId := common.M3U8UNKNOWNTAG
+	defer func() {
+		err = parsers.Locate(err, parsers.ErrSyntax, tagId, pos)
+	}()
 	var kvpairs *parsers.AttrKVPairs
-	kvpairs = parsers.NewAttrKVPairs() //initalize
-	re := regexp.MustCompile(`[,\n]`)
+	storeOpen := func(tagId common.TagId, value string, nextLine bool) (err error) {
+		var attrId common.AttrId
+		attrId, err = openAttrId(tagId, *kvpairs, nextLine)
+		if err == nil {
+			kvpairs.Store(attrId, value)
+		}
+		return
+	}
+	//tag waiting for its URI line, the tags in between are posted before it
+	var pending *parsers.AttrKVPairs
+	pendingTag := common.M3U8UNKNOWNTAG
+	pendingPos := pos
+	postPending := func(uri string) (err error) {
+		if pending == nil {
+			return
+		}
+		if len(uri) > 0 {
+			var attrId common.AttrId
+			attrId, err = openAttrId(pendingTag, *pending, true)
+			if err != nil {
+				return parsers.Locate(err, parsers.ErrSyntax, pendingTag, pendingPos)
+			}
+			pending.Store(attrId, uri)
+		}
+		err = handler.PostRecord(pendingTag, pending)
+		pending = nil
+		return parsers.Locate(err, parsers.ErrHandler, pendingTag, pendingPos)
+	}
 	for s.Scan() {
 		//fmt.Printf("\n%v", s.Text())
 		entryStr := s.Text()
-		index := strings.Index(entryStr, ":")
-		tag := entryStr
-		tagId := common.M3U8UNKNOWNTAG
-		if index > 0 {
-			tag = entryStr[:index]
-			var ok bool
-			tagId, ok = common.TagToTagId[tag]
-			if !ok {
-				panic(fmt.Sprintf("\nUnknown Tag : \"%v\"", tag))
+		if entries > 0 {
+			nBytes++ //new line before the entry
+			pos = pos.Advance([]byte{'\n'})
+		}
+		entries++
+		tagId = common.M3U8UNKNOWNTAG
+		if !strings.HasPrefix(entryStr, "#") {
+			err = fmt.Errorf("expected # not found")
+			return
+		}
+		//tag line followed by the URI line if any
+		lines := strings.Split(entryStr[1:], "\n")
+		tag := strings.TrimSuffix(lines[0], "\r")
+		lines = lines[1:]
+		value := ""
+		if index := strings.Index(tag, ":"); index >= 0 {
+			tag, value = tag[:index], tag[index+1:]
+		}
+		var ok bool
+		tagId, ok = common.TagToTagId[tag]
+		if ok && parsers.HasURILine(tagId) {
+			//tag pending without its URI line
+			err = postPending("")
+			if err != nil {
+				return
 			}
-			split := re.Split(entryStr[index+1:], -1)
-			for _, token := range split {
-				if len(token) <= 0 {
-					continue
+		}
+		//URI line of the tag pending
+		uri := ""
+		if pending != nil {
+			for i, line := range lines {
+				if line = strings.TrimSuffix(line, "\r"); len(line) > 0 {
+					uri, lines = line, lines[i+1:]
+					break
+				}
+			}
+		}
+		if !ok {
+			//comments and unknown tags are ignored
+			if len(uri) > 0 {
+				err = postPending(uri)
+				if err != nil {
+					return
 				}
-				parts := strings.Split(token, "=")
-				if len(parts) == 2 {
-					attrId, ok := common.AttrToAttrId[parts[0]]
-					if ok {
-						kvpairs.Store(attrId, parts[1])
-					}
-				} else {
-					newkey := common.INTUnknownAttr
-					if kvpairs != nil {
-						if kvpairs.Exists(common.INTUnknownAttr) {
-							//Already present
-							switch tagId {
-							case common.M3U8ExtInf:
-								newkey = common.M3U8Uri
-							default:
-								//panic(fmt.Sprintf("Duplicate INTUnknownAttr for %v required.", tag))
-							}
-						}
-					}
-					kvpairs.Store(newkey, parts[0])
+			}
+			nBytes += len(entryStr)
+			pos = pos.Advance(s.Bytes())
+			continue
+		}
+		kvpairs = parsers.NewAttrKVPairs() //new value
+		var items []string
+		switch {
+		case len(value) == 0:
+		case tagId == common.M3U8ExtInf:
+			//title is any text after the duration
+			items = strings.SplitN(value, ",", 2)
+		default:
+			items = splitAttributes(value)
+		}
+		for _, item := range items {
+			if len(item) == 0 {
+				continue
+			}
+			parts := strings.SplitN(item, "=", 2)
+			if len(parts) == 2 && tagId != common.M3U8ExtInf {
+				attrId, ok := common.AttrToAttrId[parts[0]]
+				if ok {
+					kvpairs.Store(attrId, unquote(parts[1]))
 				}
-				i++
+				continue
+			}
+			err = storeOpen(tagId, item, false)
+			if err != nil {
+				return
 			}
 		}
-		tagId, ok := common.TagToTagId[tag]
-		if ok {
-			handler.PostRecord(tagId, kvpairs)
-			kvpairs = parsers.NewAttrKVPairs() //new value
+		nextLines := 0
+		for _, line := range lines {
+			line = strings.TrimSuffix(line, "\r")
+			if len(line) == 0 {
+				continue
+			}
+			err = storeOpen(tagId, line, true)
+			if err != nil {
+				return
+			}
+			nextLines++
+		}
+		if nextLines == 0 && parsers.HasURILine(tagId) {
+			pending, pendingTag, pendingPos = kvpairs, tagId, pos
+		} else {
+			err = handler.PostRecord(tagId, kvpairs)
+			if err != nil {
+				err = parsers.Locate(err, parsers.ErrHandler, tagId, pos)
+				return
+			}
 		}
+		if len(uri) > 0 {
+			err = postPending(uri)
+			if err != nil {
+				return
+			}
+		}
+		nBytes += len(entryStr)
+		pos = pos.Advance(s.Bytes())
 	}
-	return 0, nil
+	err = s.Err()
+	if err == nil {
+		//tag pending without its URI line
+		err = postPending("")
+	}
+	tagId = common.M3U8UNKNOWNTAG
+	return
 }
@@ -20,14 +20,28 @@ type ScanParser3 struct {
 	state         s3_ParsingState
 	savedState    [10]s3_ParsingState
 	savedStateTop int
-	tokenCount    int
-	nBytes        int
+	//error in the saved state stack, returned by parse
+	stateErr   error
+	tokenCount int
+	nBytes     int
 
 	kvpairs *parsers.AttrKVPairs
 	tag     []byte
 	tagId   common.TagId
 	key     []byte
 	eof     bool
+	//data is from the line following the tag
+	nextLine bool
+	//a value is read from the line following the tag
+	lineRead  bool
+	positions scanPositions
+
+	//tag waiting for its URI line, the tags in between are posted before it
+	pending    *parsers.AttrKVPairs
+	pendingTag common.TagId
+	pendingPos parsers.Position
+	//URI line of the tag pending
+	uri []byte
 }
 
 const (
@@ -41,11 +55,13 @@ const (
 	s3_WaitingEntryStart
 	s3_WaitingEntryName
 	s3_WaitingEntryData
+	s3_ReadingText
 )
 
 func (s *ScanParser3) Init() {
 	s.tokenCount = -1
 	s.savedStateTop = -1
+	s.stateErr = nil
 	s.nBytes = 0
 	s.state = s3_UndefinedState       //bad state if we pop this
 	s.pushState(s3_WaitingEntryStart) //push the starting state
@@ -54,11 +70,20 @@ func (s *ScanParser3) Init() {
 	s.tagId = common.M3U8UNKNOWNTAG
 	s.key = nil
 	s.eof = false
+	s.nextLine = false
+	s.lineRead = false
+	s.positions.init()
+	s.pending = nil
+	s.pendingTag = common.M3U8UNKNOWNTAG
+	s.uri = nil
 }
 
 func (s *ScanParser3) pushState(newState s3_ParsingState) {
-	if s.savedStateTop >= len(s.savedState) {
-		panic("saved state len is not enough")
+	if s.savedStateTop+1 >= len(s.savedState) {
+		if s.stateErr == nil {
+			s.stateErr = errors.New("saved state len is not enough")
+		}
+		return
 	}
 	s.savedStateTop++
 	s.savedState[s.savedStateTop] = s.state
@@ -70,7 +95,11 @@ func (s *ScanParser3) replaceState(newState s3_ParsingState) {
 
 func (s *ScanParser3) popState() {
 	if s.savedStateTop < 0 {
-		panic("empty saved state stack")
+		if s.stateErr == nil {
+			s.stateErr = errors.New("empty saved state stack")
+		}
+		s.state = s3_UndefinedState
+		return
 	}
 	s.state = s.savedState[s.savedStateTop]
 	s.savedStateTop--
@@ -81,21 +110,69 @@ func (s *ScanParser3) PostRecord(tag common.TagId, kvpairs *parsers.AttrKVPairs)
 	if kvpairs != nil {
 		err = decorateEntry(tag, *kvpairs)
 		if err != nil {
-			return err
+			return parsers.Locate(err, parsers.ErrBadValue, tag, s.positions.tag)
 		}
 	}
 	if s.extHandler == nil {
-		panic("Invalid extHandler for post")
+		return errors.New("invalid extHandler for post")
 	}
 	err = s.extHandler.PostRecord(tag, kvpairs)
-	return err
+	return parsers.Locate(err, parsers.ErrHandler, tag, s.positions.tag)
+}
+
+//postTag - posts the tag read, a tag without its URI line is kept pending
+func (s *ScanParser3) postTag() (err error) {
+	if !s.lineRead && parsers.HasURILine(s.tagId) {
+		s.pending, s.pendingTag, s.pendingPos = s.kvpairs, s.tagId, s.positions.tag
+	} else {
+		err = s.PostRecord(s.tagId, s.kvpairs)
+		if err != nil {
+			return
+		}
+	}
+	if s.uri != nil {
+		err = s.postPending()
+	}
+	return
+}
+
+//postPending - posts the tag pending with its URI line if read
+func (s *ScanParser3) postPending() (err error) {
+	if s.pending == nil {
+		return
+	}
+	if s.uri != nil {
+		var attrId common.AttrId
+		attrId, err = openAttrId(s.pendingTag, *s.pending, true)
+		if err != nil {
+			return parsers.Locate(err, parsers.ErrSyntax, s.pendingTag, s.pendingPos)
+		}
+		s.pending.Store(attrId, string(s.uri))
+	}
+	//errors are located at the tag pending
+	tagPos := s.positions.tag
+	s.positions.tag = s.pendingPos
+	err = s.PostRecord(s.pendingTag, s.pending)
+	s.positions.tag = tagPos
+	s.pending, s.uri = nil, nil
+	return
+}
+
+//curTagId - tag being read, M3U8UNKNOWNTAG if none
+func (s *ScanParser3) curTagId() common.TagId {
+	if s.tag == nil {
+		return common.M3U8UNKNOWNTAG
+	}
+	return s.tagId
 }
 
 func (s *ScanParser3) Parse(rdr io.Reader, handler parsers.M3u8Handler, buffer []byte) (nBytes int, err error) {
 	s.extHandler = handler
 	scan := bufio.NewScanner(rdr)
 	scan.Buffer(buffer, len(buffer))
-	return s.parse(scan, s)
+	nBytes, err = s.parse(scan, s)
+	err = parsers.Locate(err, parsers.ErrSyntax, s.curTagId(), s.positions.token)
+	return
 }
 
 func (s *ScanParser3) ParseData(data []byte, handler parsers.M3u8Handler, buffer []byte) (nBytes int, err error) {
@@ -103,20 +180,24 @@ func (s *ScanParser3) ParseData(data []byte, handler parsers.M3u8Handler, buffer
 	rdr := bytes.NewReader(data)
 	scan := bufio.NewScanner(rdr)
 	scan.Buffer(buffer, len(buffer))
-	return s.parse(scan, s)
+	nBytes, err = s.parse(scan, s)
+	err = parsers.Locate(err, parsers.ErrSyntax, s.curTagId(), s.positions.token)
+	return
 }
 
 func (s *ScanParser3) postData(key common.AttrId, token []byte) error {
 	if key == common.INTUnknownAttr {
 		//fmt.Fprintf(os.Stdout, "\n%v:%v", string(s.tag), string(token))
-		if s.kvpairs.Exists(common.INTUnknownAttr) {
-			switch s.tagId {
-			case common.M3U8ExtInf:
-				key = common.M3U8Uri
-			default:
-				return fmt.Errorf("duplicate INTUnknownAttr for %v required", string(s.tag))
-			}
+		if s.nextLine && s.pending != nil && s.uri == nil {
+			s.uri = bytes.Clone(token)
+			return nil
+		}
+		var err error
+		key, err = openAttrId(s.tagId, *s.kvpairs, s.nextLine)
+		if err != nil {
+			return err
 		}
+		s.lineRead = s.lineRead || s.nextLine
 	}
 	s.kvpairs.Store(key, string(token))
 	return nil
@@ -125,7 +206,7 @@ func (s *ScanParser3) postData(key common.AttrId, token []byte) error {
 func (s *ScanParser3) parse(scan *bufio.Scanner, handler parsers.M3u8Handler) (nBytes int, err error) {
 	var lastToken []byte
 	s.Init()
-	scan.Split(s.splitFunctionMain)
+	scan.Split(s.positions.wrap(s.splitFunctionMain))
 
 	for scan.Scan() {
 		s.tokenCount++
@@ -141,6 +222,7 @@ func (s *ScanParser3) parse(scan *bufio.Scanner, handler parsers.M3u8Handler) (n
 		case s3_WaitingEntryStart:
 			switch curToken[0] {
 			case '#':
+				s.positions.tag = s.positions.token
 				s.popState()
 				s.pushState(s3_WaitingEntryName)
 				s.pushState(s3_ReadingEntryName)
@@ -149,6 +231,12 @@ func (s *ScanParser3) parse(scan *bufio.Scanner, handler parsers.M3u8Handler) (n
 			//case ':', '=', ',':
 			//fallthrough
 			default:
+				if s.pending != nil && s.uri == nil {
+					//URI line of the tag pending
+					s.uri = bytes.Clone(curToken)
+					err = s.postPending()
+					break
+				}
 				err = fmt.Errorf("unexpected token %v received when waiting for EntryStart", string(curToken))
 			}
 		case s3_WaitingEntryName:
@@ -159,10 +247,11 @@ func (s *ScanParser3) parse(scan *bufio.Scanner, handler parsers.M3u8Handler) (n
 					s.pushState(s3_WaitingEntryData)
 					s.pushState(s3_ReadingEnumeratedString)
 					lastToken = nil
+					s.nextLine = false
 				}
 			case '\n':
 				if s.tag != nil {
-					err = s.PostRecord(s.tagId, s.kvpairs)
+					err = s.postTag()
 					if err != nil {
 						return s.nBytes, err
 					}
@@ -183,21 +272,27 @@ func (s *ScanParser3) parse(scan *bufio.Scanner, handler parsers.M3u8Handler) (n
 					s.tag = nil
 					s.pushState(s3_ReadingIgnoredLine)
 					//ignore any line that is commented without valid key
+				} else if parsers.HasURILine(s.tagId) {
+					//tag pending without its URI line
+					err = s.postPending()
 				}
+				s.lineRead = false
 			}
 		case s3_WaitingEntryData:
 			switch curToken[0] {
 			case '#':
-				if s.tag != nil && lastToken[0] == '\n' {
-					err = s.PostRecord(s.tagId, s.kvpairs)
+				if s.tag != nil && len(lastToken) > 0 && lastToken[0] == '\n' {
+					err = s.postTag()
 					if err != nil {
 						return s.nBytes, err
 					}
 					s.tag = nil
 					s.kvpairs = parsers.NewAttrKVPairs()
+					s.positions.tag = s.positions.token
 					s.popState()
 					s.pushState(s3_WaitingEntryName)
 					s.pushState(s3_ReadingEntryName)
+					lastToken = nil
 				} else {
 					err = fmt.Errorf("%v : invalid token %v received when waiting for EntryData", string(s.tag), string(curToken))
 					return s.nBytes, err
@@ -212,8 +307,8 @@ func (s *ScanParser3) parse(scan *bufio.Scanner, handler parsers.M3u8Handler) (n
 				s.pushState(s3_ReadingAnyString)
 			case '\n':
 				//fmt.Fprintf(os.Stdout, "\nNEWLINE:%v", string(lastToken))
-				if lastToken[0] == '\n' && s.tag != nil {
-					err = s.PostRecord(s.tagId, s.kvpairs)
+				if len(lastToken) > 0 && lastToken[0] == '\n' && s.tag != nil {
+					err = s.postTag()
 					if err != nil {
 						return s.nBytes, err
 					}
@@ -221,6 +316,7 @@ func (s *ScanParser3) parse(scan *bufio.Scanner, handler parsers.M3u8Handler) (n
 					s.kvpairs = parsers.NewAttrKVPairs()
 					s.popState()
 					s.pushState(s3_WaitingEntryStart)
+					lastToken = nil
 					continue
 				}
 				fallthrough
@@ -230,17 +326,17 @@ func (s *ScanParser3) parse(scan *bufio.Scanner, handler parsers.M3u8Handler) (n
 					var attr common.AttrId
 					var ok bool
 					attr, ok = common.AttrToAttrId[string(s.key)]
-					if !ok {
-						err = fmt.Errorf("invalid attribute token %v received when waiting for EntryData", string(s.key))
-						return s.nBytes, err
+					//unknown attributes are ignored
+					if ok {
+						err = s.postData(attr, lastToken)
 					}
-					err = s.postData(attr, lastToken)
 					if err != nil {
 						return s.nBytes, err
 					}
 					s.key = nil
 					lastToken = nil
-				} else {
+				} else if len(lastToken) > 0 && lastToken[0] != ',' && lastToken[0] != '\n' {
+					//value which is not part of an attribute list
 					err = s.postData(common.INTUnknownAttr, lastToken)
 					if err != nil {
 						return s.nBytes, err
@@ -248,15 +344,23 @@ func (s *ScanParser3) parse(scan *bufio.Scanner, handler parsers.M3u8Handler) (n
 					lastToken = nil
 				}
 				lastToken = bytes.Clone(curToken)
-				if curToken[0] == ',' {
-					s.pushState(s3_ReadingEnumeratedString)
-				} else {
+				switch {
+				case curToken[0] == '\n':
+					s.nextLine = true
 					s.pushState(s3_ReadingEnumeratedStringLine)
+				case s.tagId == common.M3U8ExtInf:
+					//title is any text till the end of the line
+					s.pushState(s3_ReadingText)
+				default:
+					s.pushState(s3_ReadingEnumeratedString)
 				}
 			default:
 				lastToken = bytes.Clone(curToken)
 			}
 		}
+		if err == nil {
+			err = s.stateErr
+		}
 		if err != nil {
 			return s.nBytes, err
 		}
@@ -267,9 +371,16 @@ func (s *ScanParser3) parse(scan *bufio.Scanner, handler parsers.M3u8Handler) (n
 	switch s.state {
 	case s3_ReadingQuote:
 		fallthrough
-	case s3_ReadingEnumeratedString, s3_ReadingEnumeratedStringLine:
+	case s3_ReadingEnumeratedString, s3_ReadingEnumeratedStringLine, s3_ReadingIgnoredLine, s3_ReadingText,
+		s3_ReadingEntryName, s3_ReadingAnyString:
 		s.popState()
 	}
+	if err == nil {
+		err = s.stateErr
+	}
+	if err != nil {
+		return s.nBytes, err
+	}
 	switch s.state {
 	case s3_WaitingEntryStart:
 		//fmt.Printf("%v %v %v", "s3_WaitingEntryStart", s.tag, lastToken)
@@ -285,7 +396,7 @@ func (s *ScanParser3) parse(scan *bufio.Scanner, handler parsers.M3u8Handler) (n
 			}
 		}
 		if len(s.tag) > 0 {
-			err = s.PostRecord(s.tagId, s.kvpairs)
+			err = s.postTag()
 			if err != nil {
 				return s.nBytes, err
 			}
@@ -297,11 +408,10 @@ func (s *ScanParser3) parse(scan *bufio.Scanner, handler parsers.M3u8Handler) (n
 				var attr common.AttrId
 				var ok bool
 				attr, ok = common.AttrToAttrId[string(s.key)]
-				if !ok {
-					err = fmt.Errorf("invalid attribute token %v received when waiting for EntryData", string(s.key))
-					return s.nBytes, err
+				//unknown attributes are ignored
+				if ok {
+					err = s.postData(attr, lastToken)
 				}
-				err = s.postData(attr, lastToken)
 				if err != nil {
 					return s.nBytes, err
 				}
@@ -316,24 +426,23 @@ func (s *ScanParser3) parse(scan *bufio.Scanner, handler parsers.M3u8Handler) (n
 			}
 		}
 		if len(s.tag) > 0 {
-			err = s.PostRecord(s.tagId, s.kvpairs)
+			err = s.postTag()
 			if err != nil {
 				return s.nBytes, err
 			}
 		}
 	default:
-		panic(fmt.Sprintf("Unexpected state : %v", s.state))
+		err = fmt.Errorf("unexpected state %v at the end of data", s.state)
 	}
 	if err == nil {
 		if s.key != nil {
 			var attr common.AttrId
 			var ok bool
 			attr, ok = common.AttrToAttrId[string(s.key)]
-			if !ok {
-				err = fmt.Errorf("invalid attribute token %v received when waiting for EntryData", string(s.key))
-				return s.nBytes, err
+			//unknown attributes are ignored
+			if ok {
+				err = s.postData(attr, lastToken)
 			}
-			err = s.postData(attr, lastToken)
 			if err != nil {
 				return s.nBytes, err
 			}
@@ -349,10 +458,17 @@ func (s *ScanParser3) parse(scan *bufio.Scanner, handler parsers.M3u8Handler) (n
 			}
 		}
 	}
+	if err == nil {
+		//tag pending, without its URI line if not read
+		err = s.postPending()
+	}
 	return s.nBytes, err
 }
 
 func (s *ScanParser3) splitFunctionMain(data []byte, atEOF bool) (int, []byte, error) {
+	if s.stateErr != nil {
+		return 0, nil, s.stateErr
+	}
 	switch s.state {
 	case s3_ReadingQuote:
 		return s.readQuotedString(data, atEOF)
@@ -364,8 +480,14 @@ func (s *ScanParser3) splitFunctionMain(data []byte, atEOF bool) (int, []byte, e
 		return s.readEntryName(data, atEOF)
 	case s3_ReadingAnyString:
 		return s.readAnyString(data, atEOF)
-	case s3_ReadingIgnoredLine:
-		return s.readIgnoredLine(data, atEOF)
+	case s3_ReadingIgnoredLine, s3_ReadingText:
+		return s.readLine(data, atEOF)
+	case s3_WaitingEntryStart:
+		if s.pending != nil && len(data) > 0 && data[0] != '#' && data[0] != '\r' && data[0] != '\n' {
+			//URI line of the tag pending
+			s.pushState(s3_ReadingText)
+			return s.readLine(data, atEOF)
+		}
 	}
 	for i, ch := range data {
 		if ch == '#' || ch == ':' || ch == '=' || ch == ',' || ch == '\n' {
@@ -390,10 +512,11 @@ func (s *ScanParser3) readQuotedString(data []byte, atEOF bool) (int, []byte, er
 	for i, ch := range data {
 		if ch == '"' {
 			if i > 0 {
-				s.nBytes += i
+				s.nBytes += i + 1
 				s.popState()
 				//data = 1:i  (skip last quote)
-				return i, data[1:i], nil
+				//consume the last quote too, else it is left as a token at the end of data
+				return i + 1, data[1:i], nil
 			}
 		} else if ch == '\r' || ch == '\n' {
 			s.nBytes += i
@@ -430,7 +553,8 @@ func (s *ScanParser3) readEntryName(data []byte, atEOF bool) (int, []byte, error
 	return 0, nil, nil //need more characters
 }
 
-func (s *ScanParser3) readIgnoredLine(data []byte, atEOF bool) (int, []byte, error) {
+//readLine - any text till the end of the line
+func (s *ScanParser3) readLine(data []byte, atEOF bool) (int, []byte, error) {
 	for i, ch := range data {
 		if ch == '\r' || ch == '\n' {
 			s.nBytes += i
@@ -510,7 +634,7 @@ func (s *ScanParser3) readEnumeratedStringLine(data []byte, atEOF bool) (int, []
 }
 
 func (s *ScanParser3) readAnyString(data []byte, atEOF bool) (int, []byte, error) {
-	if data[0] == '"' {
+	if len(data) > 0 && data[0] == '"' {
 		s.replaceState(s3_ReadingQuote)
 		return s.readQuotedString(data, atEOF)
 	}
@@ -0,0 +1,36 @@
+package scanparser
+
+import (
+	"bufio"
+
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+//scanPositions - positions in the data of the tokens returned by the bufio.Scanner
+type scanPositions struct {
+	consumed parsers.Position //start of the data not yet consumed
+	token    parsers.Position //start of the last token, or of the data not consumed on error
+	tag      parsers.Position //start of the tag being read, set by the parser
+}
+
+func (p *scanPositions) init() {
+	p.consumed = parsers.StartPosition()
+	p.token = p.consumed
+	p.tag = p.consumed
+}
+
+//wrap - split function tracking the positions of the tokens of split
+func (p *scanPositions) wrap(split bufio.SplitFunc) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		advance, token, err = split(data, atEOF)
+		p.token = p.consumed
+		//tokens are slices of data, start is found from the capacity left
+		if start := cap(data) - cap(token); token != nil && err == nil && start > 0 && start <= len(data) {
+			p.token = p.consumed.Advance(data[:start])
+		}
+		if advance > 0 && advance <= len(data) {
+			p.consumed = p.consumed.Advance(data[:advance])
+		}
+		return
+	}
+}
@@ -1,7 +1,8 @@
 package yaccparser
 
 import (
-	"fmt"
+	"bytes"
+	"strings"
 
 	"github.com/eswarantg/m3u8reader/common"
 	"github.com/eswarantg/m3u8reader/parsers"
@@ -10,6 +11,8 @@ import (
 type keyValuePair struct {
 	k common.AttrId
 	v interface{}
+	//attribute name not known
+	unknown bool
 }
 
 type keyValuePairs struct {
@@ -22,12 +25,17 @@ func (e *keyValuePairs) storeKVDebug(label string, k common.AttrId, v interface{
 		t := parsers.NewAttrKVPairsDebug(label + "_keyValuePairs.storeKVDebug")
 		e.kvs = t
 	}
-	if e.kvs == nil {
-		panic(fmt.Sprintf("keyValuePairs.storeKVDebug allocated but still nil %v", label))
-	}
 	e.kvs.StoreDebug(label, k, v)
 	//fmt.Printf("%v keyValuePairs.storeKVDebug done for %v\n", e, label)
 }
+
+//storeAttr - unknown attributes are ignored
+func (e *keyValuePairs) storeAttr(label string, kv keyValuePair) {
+	if kv.unknown {
+		return
+	}
+	e.storeKVDebug(label, kv.k, kv.v)
+}
 func (e *keyValuePairs) clear(label string) {
 	//fmt.Printf("%v keyValuePairs.clear called for %v\n", e, label)
 	e.kvs = nil
@@ -51,14 +59,89 @@ func (e *accEntry) storeKVDebug(label string, k common.AttrId, v interface{}) {
 		t := parsers.NewAttrKVPairsDebug(label + "_accEntry.storeKVDebug")
 		e.kvs = t
 	}
-	if e.kvs == nil {
-		panic(fmt.Sprintf("accEntry.storeKVDebug allocated but still nil %v", label))
-	}
 	e.kvs.StoreDebug(label, k, v)
 	//fmt.Printf("%v accEntry.storeKVDebug done for %v\n", e, label)
 }
+
+//storeExtInf - EXTINF:<duration>,[<title>]
+func (e *accEntry) storeExtInf(label string, text string) {
+	duration, title := text, ""
+	if pos := strings.IndexByte(text, ','); pos >= 0 {
+		duration, title = text[:pos], text[pos+1:]
+	}
+	e.storeKVDebug(label, common.INTUnknownAttr, duration)
+	if len(title) > 0 {
+		e.storeKVDebug(label, common.M3U8Title, title)
+	}
+}
 func (e *accEntry) clear(label string) {
 	//fmt.Printf("%v accEntry.clear called for %v\n", e, label)
 	e.kvs = nil
 	//fmt.Printf("%v accEntry.clear done for %v\n", e, label)
 }
+
+//keptLine - line of the data given to the lexer by knownTagLines
+type keptLine struct {
+	pos parsers.Position //start of the line in the data
+	tag common.TagId     //M3U8UNKNOWNTAG if not a tag line
+}
+
+//knownTagLines - comment lines and lines of unknown tags are removed
+//as the lexer can't skip them without matching the known tags too
+//Tag lines between a tag and its URI line are moved before the tag, to be posted before it
+//Lines kept are returned with their position in data
+func knownTagLines(data []byte) ([]byte, []keptLine) {
+	var out bytes.Buffer
+	out.Grow(len(data) + 1)
+	var lines []keptLine
+	keep := func(line []byte, kept keptLine) {
+		//tags are matched by the lexer with the line end before them
+		out.WriteByte('\n')
+		out.Write(line)
+		lines = append(lines, kept)
+	}
+	//tag line waiting for its URI line
+	var held []byte
+	var heldLine keptLine
+	pos := parsers.StartPosition()
+	for len(data) > 0 {
+		line, next := data, len(data)
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			line, next = data[:i], i+1
+		}
+		linePos := pos
+		pos = parsers.Position{Offset: pos.Offset + next, Line: pos.Line + 1, Column: 1}
+		data = data[next:]
+		line = bytes.TrimSuffix(line, []byte{'\r'})
+		tagId := common.M3U8UNKNOWNTAG
+		if len(line) > 0 && line[0] == '#' {
+			tag := line[1:]
+			if end := bytes.IndexByte(tag, ':'); end >= 0 {
+				tag = tag[:end]
+			}
+			var ok bool
+			tagId, ok = common.TagToTagId[string(tag)]
+			if !ok {
+				continue
+			}
+		}
+		if parsers.HasURILine(tagId) {
+			if held != nil {
+				//no URI line for the tag held
+				keep(held, heldLine)
+			}
+			held, heldLine = line, keptLine{pos: linePos, tag: tagId}
+			continue
+		}
+		if held != nil && tagId == common.M3U8UNKNOWNTAG && len(line) > 0 {
+			//URI line of the tag held
+			keep(held, heldLine)
+			held = nil
+		}
+		keep(line, keptLine{pos: linePos, tag: tagId})
+	}
+	if held != nil {
+		keep(held, heldLine)
+	}
+	return out.Bytes(), lines
+}
@@ -6322,7 +6322,7 @@ var dfas = []dfa{
 		},
 	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
 
-	// \n#EXTINF:
+	// \n#EXTINF:[^\n]*
 	{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
@@ -6536,23 +6536,23 @@ var dfas = []dfa{
 			case 10:
 				return -1
 			case 35:
-				return -1
+				return 9
 			case 58:
-				return -1
+				return 9
 			case 69:
-				return -1
+				return 9
 			case 70:
-				return -1
+				return 9
 			case 73:
-				return -1
+				return 9
 			case 78:
-				return -1
+				return 9
 			case 84:
-				return -1
+				return 9
 			case 88:
-				return -1
+				return 9
 			}
-			return -1
+			return 9
 		},
 	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
 
@@ -9816,27 +9816,27 @@ var dfas = []dfa{
 		},
 	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
 
-	// \n[A-Za-z][^\"\n, #=]+
-	{[]bool{false, false, false, true}, []func(rune) int{ // Transitions
+	// \n#EXT-X-GAP
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 10:
 				return 1
-			case 32:
+			case 35:
 				return -1
-			case 34:
+			case 45:
 				return -1
-			case 35:
+			case 65:
 				return -1
-			case 44:
+			case 69:
 				return -1
-			case 61:
+			case 71:
 				return -1
-			}
-			switch {
-			case 65 <= r && r <= 90:
+			case 80:
 				return -1
-			case 97 <= r && r <= 122:
+			case 84:
+				return -1
+			case 88:
 				return -1
 			}
 			return -1
@@ -9845,22 +9845,22 @@ var dfas = []dfa{
 			switch r {
 			case 10:
 				return -1
-			case 32:
+			case 35:
+				return 2
+			case 45:
 				return -1
-			case 34:
+			case 65:
 				return -1
-			case 35:
+			case 69:
 				return -1
-			case 44:
+			case 71:
 				return -1
-			case 61:
+			case 80:
+				return -1
+			case 84:
+				return -1
+			case 88:
 				return -1
-			}
-			switch {
-			case 65 <= r && r <= 90:
-				return 2
-			case 97 <= r && r <= 122:
-				return 2
 			}
 			return -1
 		},
@@ -9868,103 +9868,66 @@ var dfas = []dfa{
 			switch r {
 			case 10:
 				return -1
-			case 32:
-				return -1
-			case 34:
-				return -1
 			case 35:
 				return -1
-			case 44:
+			case 45:
 				return -1
-			case 61:
+			case 65:
 				return -1
-			}
-			switch {
-			case 65 <= r && r <= 90:
-				return 3
-			case 97 <= r && r <= 122:
+			case 69:
 				return 3
+			case 71:
+				return -1
+			case 80:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
 			}
-			return 3
+			return -1
 		},
 		func(r rune) int {
 			switch r {
 			case 10:
 				return -1
-			case 32:
-				return -1
-			case 34:
-				return -1
 			case 35:
 				return -1
-			case 44:
+			case 45:
 				return -1
-			case 61:
+			case 65:
 				return -1
-			}
-			switch {
-			case 65 <= r && r <= 90:
-				return 3
-			case 97 <= r && r <= 122:
-				return 3
-			}
-			return 3
-		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
-
-	// \n[ \t]*
-	{[]bool{false, true, true}, []func(rune) int{ // Transitions
-		func(r rune) int {
-			switch r {
-			case 9:
+			case 69:
 				return -1
-			case 10:
-				return 1
-			case 32:
+			case 71:
 				return -1
-			}
-			return -1
-		},
-		func(r rune) int {
-			switch r {
-			case 9:
-				return 2
-			case 10:
+			case 80:
 				return -1
-			case 32:
-				return 2
-			}
-			return -1
-		},
-		func(r rune) int {
-			switch r {
-			case 9:
-				return 2
-			case 10:
+			case 84:
 				return -1
-			case 32:
-				return 2
+			case 88:
+				return 4
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1}, nil},
-
-	// \n#[^(EXT)].*
-	{[]bool{false, false, false, true, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 10:
-				return 1
+				return -1
 			case 35:
 				return -1
-			case 40:
+			case 45:
 				return -1
-			case 41:
+			case 65:
 				return -1
 			case 69:
 				return -1
-			case 84:
+			case 71:
+				return -1
+			case 80:
 				return -1
+			case 84:
+				return 5
 			case 88:
 				return -1
 			}
@@ -9975,13 +9938,17 @@ var dfas = []dfa{
 			case 10:
 				return -1
 			case 35:
-				return 2
-			case 40:
 				return -1
-			case 41:
+			case 45:
+				return 6
+			case 65:
 				return -1
 			case 69:
 				return -1
+			case 71:
+				return -1
+			case 80:
+				return -1
 			case 84:
 				return -1
 			case 88:
@@ -9992,1062 +9959,1095 @@ var dfas = []dfa{
 		func(r rune) int {
 			switch r {
 			case 10:
-				return 3
+				return -1
 			case 35:
-				return 3
-			case 40:
 				return -1
-			case 41:
+			case 45:
+				return -1
+			case 65:
 				return -1
 			case 69:
 				return -1
+			case 71:
+				return -1
+			case 80:
+				return -1
 			case 84:
 				return -1
 			case 88:
-				return -1
+				return 7
 			}
-			return 3
+			return -1
 		},
 		func(r rune) int {
 			switch r {
 			case 10:
-				return 4
+				return -1
 			case 35:
-				return 4
-			case 40:
-				return 4
-			case 41:
-				return 4
+				return -1
+			case 45:
+				return 8
+			case 65:
+				return -1
 			case 69:
-				return 4
+				return -1
+			case 71:
+				return -1
+			case 80:
+				return -1
 			case 84:
-				return 4
+				return -1
 			case 88:
-				return 4
+				return -1
 			}
-			return 4
+			return -1
 		},
 		func(r rune) int {
 			switch r {
 			case 10:
-				return 4
+				return -1
 			case 35:
-				return 4
-			case 40:
-				return 4
-			case 41:
-				return 4
+				return -1
+			case 45:
+				return -1
+			case 65:
+				return -1
 			case 69:
-				return 4
+				return -1
+			case 71:
+				return 9
+			case 80:
+				return -1
 			case 84:
-				return 4
+				return -1
 			case 88:
-				return 4
+				return -1
 			}
-			return 4
+			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
-
-	// BANDWIDTH=
-	{[]bool{false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
-			case 61:
+			case 10:
 				return -1
-			case 65:
+			case 35:
 				return -1
-			case 66:
-				return 1
-			case 68:
+			case 45:
 				return -1
-			case 72:
+			case 65:
+				return 10
+			case 69:
 				return -1
-			case 73:
+			case 71:
 				return -1
-			case 78:
+			case 80:
 				return -1
 			case 84:
 				return -1
-			case 87:
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 61:
+			case 10:
 				return -1
-			case 65:
-				return 2
-			case 66:
+			case 35:
 				return -1
-			case 68:
+			case 45:
 				return -1
-			case 72:
+			case 65:
 				return -1
-			case 73:
+			case 69:
 				return -1
-			case 78:
+			case 71:
 				return -1
+			case 80:
+				return 11
 			case 84:
 				return -1
-			case 87:
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 61:
+			case 10:
 				return -1
-			case 65:
+			case 35:
 				return -1
-			case 66:
+			case 45:
 				return -1
-			case 68:
+			case 65:
 				return -1
-			case 72:
+			case 69:
 				return -1
-			case 73:
+			case 71:
+				return -1
+			case 80:
 				return -1
-			case 78:
-				return 3
 			case 84:
 				return -1
-			case 87:
+			case 88:
 				return -1
 			}
 			return -1
 		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// \n#EXT-X-BITRATE:
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
-			case 61:
+			case 10:
+				return 1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
 				return -1
 			case 65:
 				return -1
 			case 66:
 				return -1
-			case 68:
-				return 4
-			case 72:
+			case 69:
 				return -1
 			case 73:
 				return -1
-			case 78:
+			case 82:
 				return -1
 			case 84:
 				return -1
-			case 87:
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 61:
+			case 10:
+				return -1
+			case 35:
+				return 2
+			case 45:
+				return -1
+			case 58:
 				return -1
 			case 65:
 				return -1
 			case 66:
 				return -1
-			case 68:
-				return -1
-			case 72:
+			case 69:
 				return -1
 			case 73:
 				return -1
-			case 78:
+			case 82:
 				return -1
 			case 84:
 				return -1
-			case 87:
-				return 5
+			case 88:
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 61:
+			case 10:
 				return -1
-			case 65:
+			case 35:
 				return -1
-			case 66:
+			case 45:
 				return -1
-			case 68:
+			case 58:
 				return -1
-			case 72:
+			case 65:
+				return -1
+			case 66:
 				return -1
+			case 69:
+				return 3
 			case 73:
-				return 6
-			case 78:
+				return -1
+			case 82:
 				return -1
 			case 84:
 				return -1
-			case 87:
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 61:
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
 				return -1
 			case 65:
 				return -1
 			case 66:
 				return -1
-			case 68:
-				return 7
-			case 72:
+			case 69:
 				return -1
 			case 73:
 				return -1
-			case 78:
+			case 82:
 				return -1
 			case 84:
 				return -1
-			case 87:
-				return -1
+			case 88:
+				return 4
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 61:
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
 				return -1
 			case 65:
 				return -1
 			case 66:
 				return -1
-			case 68:
-				return -1
-			case 72:
+			case 69:
 				return -1
 			case 73:
 				return -1
-			case 78:
+			case 82:
 				return -1
 			case 84:
-				return 8
-			case 87:
+				return 5
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 61:
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 6
+			case 58:
 				return -1
 			case 65:
 				return -1
 			case 66:
 				return -1
-			case 68:
+			case 69:
 				return -1
-			case 72:
-				return 9
 			case 73:
 				return -1
-			case 78:
+			case 82:
 				return -1
 			case 84:
 				return -1
-			case 87:
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 61:
-				return 10
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
 			case 65:
 				return -1
 			case 66:
 				return -1
-			case 68:
-				return -1
-			case 72:
+			case 69:
 				return -1
 			case 73:
 				return -1
-			case 78:
+			case 82:
 				return -1
 			case 84:
 				return -1
-			case 87:
-				return -1
+			case 88:
+				return 7
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 61:
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 8
+			case 58:
 				return -1
 			case 65:
 				return -1
 			case 66:
 				return -1
-			case 68:
-				return -1
-			case 72:
+			case 69:
 				return -1
 			case 73:
 				return -1
-			case 78:
+			case 82:
 				return -1
 			case 84:
 				return -1
-			case 87:
+			case 88:
 				return -1
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-	// AVERAGE-BANDWIDTH=
-	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
 			case 45:
 				return -1
-			case 61:
+			case 58:
 				return -1
 			case 65:
-				return 1
-			case 66:
-				return -1
-			case 68:
 				return -1
+			case 66:
+				return 9
 			case 69:
 				return -1
-			case 71:
-				return -1
-			case 72:
-				return -1
 			case 73:
 				return -1
-			case 78:
-				return -1
 			case 82:
 				return -1
 			case 84:
 				return -1
-			case 86:
-				return -1
-			case 87:
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
 			case 45:
 				return -1
-			case 61:
+			case 58:
 				return -1
 			case 65:
 				return -1
 			case 66:
 				return -1
-			case 68:
-				return -1
 			case 69:
 				return -1
-			case 71:
-				return -1
-			case 72:
-				return -1
 			case 73:
-				return -1
-			case 78:
-				return -1
+				return 10
 			case 82:
 				return -1
 			case 84:
 				return -1
-			case 86:
-				return 2
-			case 87:
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
 			case 45:
 				return -1
-			case 61:
+			case 58:
 				return -1
 			case 65:
 				return -1
 			case 66:
 				return -1
-			case 68:
-				return -1
 			case 69:
-				return 3
-			case 71:
-				return -1
-			case 72:
 				return -1
 			case 73:
 				return -1
-			case 78:
-				return -1
 			case 82:
 				return -1
 			case 84:
-				return -1
-			case 86:
-				return -1
-			case 87:
+				return 11
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
 			case 45:
 				return -1
-			case 61:
+			case 58:
 				return -1
 			case 65:
 				return -1
 			case 66:
 				return -1
-			case 68:
-				return -1
 			case 69:
 				return -1
-			case 71:
-				return -1
-			case 72:
-				return -1
 			case 73:
 				return -1
-			case 78:
-				return -1
 			case 82:
-				return 4
+				return 12
 			case 84:
 				return -1
-			case 86:
-				return -1
-			case 87:
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
 			case 45:
 				return -1
-			case 61:
+			case 58:
 				return -1
 			case 65:
-				return 5
+				return 13
 			case 66:
 				return -1
-			case 68:
-				return -1
 			case 69:
 				return -1
-			case 71:
-				return -1
-			case 72:
-				return -1
 			case 73:
 				return -1
-			case 78:
-				return -1
 			case 82:
 				return -1
 			case 84:
 				return -1
-			case 86:
-				return -1
-			case 87:
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
 			case 45:
 				return -1
-			case 61:
+			case 58:
 				return -1
 			case 65:
 				return -1
 			case 66:
 				return -1
-			case 68:
-				return -1
 			case 69:
 				return -1
-			case 71:
-				return 6
-			case 72:
-				return -1
 			case 73:
 				return -1
-			case 78:
-				return -1
 			case 82:
 				return -1
 			case 84:
-				return -1
-			case 86:
-				return -1
-			case 87:
+				return 14
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
 			case 45:
 				return -1
-			case 61:
+			case 58:
 				return -1
 			case 65:
 				return -1
 			case 66:
 				return -1
-			case 68:
-				return -1
 			case 69:
-				return 7
-			case 71:
-				return -1
-			case 72:
-				return -1
+				return 15
 			case 73:
 				return -1
-			case 78:
-				return -1
 			case 82:
 				return -1
 			case 84:
 				return -1
-			case 86:
-				return -1
-			case 87:
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
 			case 45:
-				return 8
-			case 61:
 				return -1
+			case 58:
+				return 16
 			case 65:
 				return -1
 			case 66:
 				return -1
-			case 68:
-				return -1
 			case 69:
 				return -1
-			case 71:
-				return -1
-			case 72:
-				return -1
 			case 73:
 				return -1
-			case 78:
-				return -1
 			case 82:
 				return -1
 			case 84:
 				return -1
-			case 86:
-				return -1
-			case 87:
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
 			case 45:
 				return -1
-			case 61:
+			case 58:
 				return -1
 			case 65:
 				return -1
 			case 66:
-				return 9
-			case 68:
 				return -1
 			case 69:
 				return -1
-			case 71:
-				return -1
-			case 72:
-				return -1
 			case 73:
 				return -1
-			case 78:
-				return -1
 			case 82:
 				return -1
 			case 84:
 				return -1
-			case 86:
-				return -1
-			case 87:
+			case 88:
 				return -1
 			}
 			return -1
 		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// \n#EXT-X-DEFINE:
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
-			case 45:
+			case 10:
+				return 1
+			case 35:
 				return -1
-			case 61:
+			case 45:
 				return -1
-			case 65:
-				return 10
-			case 66:
+			case 58:
 				return -1
 			case 68:
 				return -1
 			case 69:
 				return -1
-			case 71:
-				return -1
-			case 72:
+			case 70:
 				return -1
 			case 73:
 				return -1
 			case 78:
 				return -1
-			case 82:
-				return -1
 			case 84:
 				return -1
-			case 86:
-				return -1
-			case 87:
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
-			case 61:
+			case 10:
 				return -1
-			case 65:
+			case 35:
+				return 2
+			case 45:
 				return -1
-			case 66:
+			case 58:
 				return -1
 			case 68:
 				return -1
 			case 69:
 				return -1
-			case 71:
-				return -1
-			case 72:
+			case 70:
 				return -1
 			case 73:
 				return -1
 			case 78:
-				return 11
-			case 82:
 				return -1
 			case 84:
 				return -1
-			case 86:
-				return -1
-			case 87:
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
+			case 10:
 				return -1
-			case 61:
+			case 35:
 				return -1
-			case 65:
+			case 45:
 				return -1
-			case 66:
+			case 58:
 				return -1
 			case 68:
-				return 12
-			case 69:
-				return -1
-			case 71:
 				return -1
-			case 72:
+			case 69:
+				return 3
+			case 70:
 				return -1
 			case 73:
 				return -1
 			case 78:
 				return -1
-			case 82:
-				return -1
 			case 84:
 				return -1
-			case 86:
-				return -1
-			case 87:
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
+			case 10:
 				return -1
-			case 61:
+			case 35:
 				return -1
-			case 65:
+			case 45:
 				return -1
-			case 66:
+			case 58:
 				return -1
 			case 68:
 				return -1
 			case 69:
 				return -1
-			case 71:
-				return -1
-			case 72:
+			case 70:
 				return -1
 			case 73:
 				return -1
 			case 78:
 				return -1
-			case 82:
-				return -1
 			case 84:
 				return -1
-			case 86:
-				return -1
-			case 87:
-				return 13
+			case 88:
+				return 4
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
+			case 10:
 				return -1
-			case 61:
+			case 35:
 				return -1
-			case 65:
+			case 45:
 				return -1
-			case 66:
+			case 58:
 				return -1
 			case 68:
 				return -1
 			case 69:
 				return -1
-			case 71:
-				return -1
-			case 72:
+			case 70:
 				return -1
 			case 73:
-				return 14
-			case 78:
 				return -1
-			case 82:
+			case 78:
 				return -1
 			case 84:
-				return -1
-			case 86:
-				return -1
-			case 87:
+				return 5
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
-			case 61:
+			case 10:
 				return -1
-			case 65:
+			case 35:
 				return -1
-			case 66:
+			case 45:
+				return 6
+			case 58:
 				return -1
 			case 68:
-				return 15
-			case 69:
 				return -1
-			case 71:
+			case 69:
 				return -1
-			case 72:
+			case 70:
 				return -1
 			case 73:
 				return -1
 			case 78:
 				return -1
-			case 82:
-				return -1
 			case 84:
 				return -1
-			case 86:
-				return -1
-			case 87:
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
+			case 10:
 				return -1
-			case 61:
+			case 35:
 				return -1
-			case 65:
+			case 45:
 				return -1
-			case 66:
+			case 58:
 				return -1
 			case 68:
 				return -1
 			case 69:
 				return -1
-			case 71:
-				return -1
-			case 72:
+			case 70:
 				return -1
 			case 73:
 				return -1
 			case 78:
 				return -1
-			case 82:
-				return -1
 			case 84:
-				return 16
-			case 86:
-				return -1
-			case 87:
 				return -1
+			case 88:
+				return 7
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
-			case 61:
+			case 10:
 				return -1
-			case 65:
+			case 35:
 				return -1
-			case 66:
+			case 45:
+				return 8
+			case 58:
 				return -1
 			case 68:
 				return -1
 			case 69:
 				return -1
-			case 71:
+			case 70:
 				return -1
-			case 72:
-				return 17
 			case 73:
 				return -1
 			case 78:
 				return -1
-			case 82:
-				return -1
 			case 84:
 				return -1
-			case 86:
-				return -1
-			case 87:
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
+			case 10:
 				return -1
-			case 61:
-				return 18
-			case 65:
+			case 35:
 				return -1
-			case 66:
+			case 45:
 				return -1
-			case 68:
+			case 58:
 				return -1
+			case 68:
+				return 9
 			case 69:
 				return -1
-			case 71:
-				return -1
-			case 72:
+			case 70:
 				return -1
 			case 73:
 				return -1
 			case 78:
 				return -1
-			case 82:
-				return -1
 			case 84:
 				return -1
-			case 86:
-				return -1
-			case 87:
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
+			case 10:
 				return -1
-			case 61:
+			case 35:
 				return -1
-			case 65:
+			case 45:
 				return -1
-			case 66:
+			case 58:
 				return -1
 			case 68:
 				return -1
 			case 69:
-				return -1
-			case 71:
-				return -1
-			case 72:
+				return 10
+			case 70:
 				return -1
 			case 73:
 				return -1
 			case 78:
 				return -1
-			case 82:
-				return -1
 			case 84:
 				return -1
-			case 86:
-				return -1
-			case 87:
+			case 88:
 				return -1
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-	// RESOLUTION=
-	{[]bool{false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
-			case 61:
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 68:
 				return -1
 			case 69:
 				return -1
+			case 70:
+				return 11
 			case 73:
 				return -1
-			case 76:
-				return -1
 			case 78:
 				return -1
-			case 79:
+			case 84:
 				return -1
-			case 82:
-				return 1
-			case 83:
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return 12
+			case 78:
 				return -1
 			case 84:
 				return -1
-			case 85:
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 61:
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 68:
 				return -1
 			case 69:
-				return 2
-			case 73:
 				return -1
-			case 76:
+			case 70:
+				return -1
+			case 73:
 				return -1
 			case 78:
+				return 13
+			case 84:
 				return -1
-			case 79:
+			case 88:
 				return -1
-			case 82:
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
 				return -1
-			case 83:
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return 14
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 78:
 				return -1
 			case 84:
 				return -1
-			case 85:
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 61:
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return 15
+			case 68:
 				return -1
 			case 69:
 				return -1
-			case 73:
+			case 70:
 				return -1
-			case 76:
+			case 73:
 				return -1
 			case 78:
 				return -1
-			case 79:
+			case 84:
 				return -1
-			case 82:
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 78:
 				return -1
-			case 83:
-				return 3
 			case 84:
 				return -1
-			case 85:
+			case 88:
 				return -1
 			}
 			return -1
 		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// \n#EXT-X-CONTENT-STEERING:
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
-			case 61:
+			case 10:
+				return 1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
 				return -1
 			case 69:
 				return -1
-			case 73:
+			case 71:
 				return -1
-			case 76:
+			case 73:
 				return -1
 			case 78:
 				return -1
 			case 79:
-				return 4
+				return -1
 			case 82:
 				return -1
 			case 83:
 				return -1
 			case 84:
 				return -1
-			case 85:
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 61:
+			case 10:
+				return -1
+			case 35:
+				return 2
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
 				return -1
 			case 69:
 				return -1
+			case 71:
+				return -1
 			case 73:
 				return -1
-			case 76:
-				return 5
 			case 78:
 				return -1
 			case 79:
@@ -11058,21 +11058,29 @@ var dfas = []dfa{
 				return -1
 			case 84:
 				return -1
-			case 85:
+			case 88:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 61:
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
 				return -1
 			case 69:
+				return 3
+			case 71:
 				return -1
 			case 73:
 				return -1
-			case 76:
-				return -1
 			case 78:
 				return -1
 			case 79:
@@ -11083,31 +11091,10575 @@ var dfas = []dfa{
 				return -1
 			case 84:
 				return -1
-			case 85:
-				return 6
+			case 88:
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 61:
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
 				return -1
 			case 69:
 				return -1
-			case 73:
+			case 71:
 				return -1
-			case 76:
+			case 73:
 				return -1
 			case 78:
 				return -1
 			case 79:
 				return -1
-			case 82:
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 4
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 5
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 6
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 7
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 8
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return 9
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return 10
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return 11
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 12
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 69:
+				return 13
+			case 71:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return 14
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 15
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 16
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return 17
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 18
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 69:
+				return 19
+			case 71:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 69:
+				return 20
+			case 71:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return 21
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 73:
+				return 22
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return 23
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return 24
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return 25
+			case 67:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// \n#EXT-X-KEY:
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 10:
+				return 1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 75:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return 2
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 75:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return 3
+			case 75:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 75:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 4
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 75:
+				return -1
+			case 84:
+				return 5
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 6
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 75:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 75:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 7
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 8
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 75:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 75:
+				return 9
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return 10
+			case 75:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 75:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return 11
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return 12
+			case 69:
+				return -1
+			case 75:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 75:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// \n#EXT-X-DATERANGE:
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 10:
+				return 1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return 2
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return 3
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 4
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return 5
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 6
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 7
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 8
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return 9
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return 10
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return 11
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return 12
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return 13
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return 14
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return 15
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return 16
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return 17
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return 18
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// \n#EXT-X-SESSION-KEY:
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 10:
+				return 1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 75:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return 2
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 75:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return 3
+			case 73:
+				return -1
+			case 75:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 75:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 4
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 75:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 5
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 6
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 75:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 75:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 7
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 8
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 75:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 75:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return 9
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return 10
+			case 73:
+				return -1
+			case 75:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 75:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return 11
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 75:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return 12
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return 13
+			case 75:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 75:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return 14
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 75:
+				return -1
+			case 78:
+				return 15
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 16
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 75:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 75:
+				return 17
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return 18
+			case 73:
+				return -1
+			case 75:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 75:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return 19
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return 20
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 75:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 75:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// \n#EXT-X-SESSION-DATA:
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 10:
+				return 1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return 2
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return 3
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 4
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 5
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 6
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 7
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 8
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return 9
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return 10
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return 11
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return 12
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return 13
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return 14
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return 15
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 16
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return 17
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return 18
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 19
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return 20
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return 21
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// \n#EXT-X-START:
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 10:
+				return 1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return 2
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return 3
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 4
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 5
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 6
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 7
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 8
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return 9
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 10
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return 11
+			case 69:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 82:
+				return 12
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 13
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return 14
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// \n#EXT-X-I-FRAME-STREAM-INF:
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 10:
+				return 1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return 2
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return 3
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 4
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 5
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 6
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 7
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 8
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return 9
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 10
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return 11
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return 12
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return 13
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return 14
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return 15
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 16
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return 17
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 18
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return 19
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return 20
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return 21
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return 22
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 23
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return 24
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return 25
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return 26
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return 27
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// \n#EXT-X-DISCONTINUITY
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 10:
+				return 1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return 2
+			case 45:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return 3
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return 4
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 5
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 6
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return 7
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 8
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return 9
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return 10
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return 11
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 67:
+				return 12
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return 13
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return 14
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 15
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return 16
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return 17
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return 18
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return 19
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 20
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return 21
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// \n#EXT-X-ENDLIST
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 10:
+				return 1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return 2
+			case 45:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return 3
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 4
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 5
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 6
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 7
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 8
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return 9
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return 10
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 68:
+				return 11
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return 12
+			case 78:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return 13
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 83:
+				return 14
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 15
+			case 88:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			}
+			return -1
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// \n#EXT-X-PLAYLIST-TYPE:
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 10:
+				return 1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return 2
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return 3
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 4
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 5
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 6
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 7
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 8
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 80:
+				return 9
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return 10
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return 11
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return 12
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return 13
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return 14
+			case 76:
+				return -1
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 80:
+				return -1
+			case 83:
+				return 15
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 16
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 17
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 18
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return 19
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 80:
+				return 20
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return 21
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return 22
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// \n#EXT-X-BYTERANGE:
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 10:
+				return 1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return 2
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 69:
+				return 3
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 4
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return 5
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 6
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 7
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 8
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return 9
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return 10
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return 11
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 69:
+				return 12
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return 13
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return 14
+			case 66:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return 15
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return 16
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 69:
+				return 17
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return 18
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// \n#EXT-X-DISCONTINUITY-SEQUENCE:
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 10:
+				return 1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return 2
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return 3
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return 4
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 5
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 6
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return 7
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 8
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return 9
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return 10
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return 11
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return 12
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return 13
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return 14
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 15
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return 16
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return 17
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return 18
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return 19
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 20
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return 21
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 22
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return 23
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return 24
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return 25
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return 26
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return 27
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return 28
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return 29
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return 30
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return 31
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 58:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 81:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// \n#EXT-X-I-FRAMES-ONLY
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 10:
+				return 1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return 2
+			case 45:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return 3
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 4
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 5
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 6
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return 7
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 8
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return 9
+			case 76:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 10
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return 11
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return 12
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 65:
+				return 13
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 77:
+				return 14
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return 15
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return 16
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return 17
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return 18
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return 19
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return 20
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return 21
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return -1
+			case 45:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 77:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 88:
+				return -1
+			case 89:
+				return -1
+			}
+			return -1
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// \n[^#\n \t][^\n]*
+	{[]bool{false, false, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 9:
+				return -1
+			case 10:
+				return 1
+			case 32:
+				return -1
+			case 35:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 9:
+				return -1
+			case 10:
+				return -1
+			case 32:
+				return -1
+			case 35:
+				return -1
+			}
+			return 2
+		},
+		func(r rune) int {
+			switch r {
+			case 9:
+				return 2
+			case 10:
+				return -1
+			case 32:
+				return 2
+			case 35:
+				return 2
+			}
+			return 2
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1}, nil},
+
+	// \n[ \t]*
+	{[]bool{false, true, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 9:
+				return -1
+			case 10:
+				return 1
+			case 32:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 9:
+				return 2
+			case 10:
+				return -1
+			case 32:
+				return 2
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 9:
+				return 2
+			case 10:
+				return -1
+			case 32:
+				return 2
+			}
+			return -1
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1}, nil},
+
+	// \n#[^E\n][^\n]*
+	{[]bool{false, false, false, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 10:
+				return 1
+			case 35:
+				return -1
+			case 69:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return 2
+			case 69:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return 3
+			case 69:
+				return -1
+			}
+			return 3
+		},
+		func(r rune) int {
+			switch r {
+			case 10:
+				return -1
+			case 35:
+				return 3
+			case 69:
+				return 3
+			}
+			return 3
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
+
+	// BANDWIDTH=
+	{[]bool{false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return 1
+			case 68:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 84:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 65:
+				return 2
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 84:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return 3
+			case 84:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return 4
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 84:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 84:
+				return -1
+			case 87:
+				return 5
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return 6
+			case 78:
+				return -1
+			case 84:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return 7
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 84:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 84:
+				return 8
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 72:
+				return 9
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 84:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return 10
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 84:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 84:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// AVERAGE-BANDWIDTH=
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return 1
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 86:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 86:
+				return 2
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return 3
+			case 71:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 86:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return 4
+			case 84:
+				return -1
+			case 86:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return 5
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 86:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return 6
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 86:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return 7
+			case 71:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 86:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return 8
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 86:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return 9
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 86:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return 10
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 86:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return 11
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 86:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return 12
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 86:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 86:
+				return -1
+			case 87:
+				return 13
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return 14
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 86:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return 15
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 86:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return 16
+			case 86:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 72:
+				return 17
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 86:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return 18
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 86:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 66:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
+				return -1
+			case 72:
+				return -1
+			case 73:
+				return -1
+			case 78:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			case 86:
+				return -1
+			case 87:
+				return -1
+			}
+			return -1
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// RESOLUTION=
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return 1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 69:
+				return 2
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return 3
+			case 84:
+				return -1
+			case 85:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return 4
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return 5
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return 6
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return 7
+			case 85:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return 8
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return 9
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return 10
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return 11
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 69:
+				return -1
+			case 73:
+				return -1
+			case 76:
+				return -1
+			case 78:
+				return -1
+			case 79:
+				return -1
+			case 82:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
+			}
+			return -1
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// FRAME-RATE=
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return 1
+			case 77:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 77:
+				return -1
+			case 82:
+				return 2
+			case 84:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return 3
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 77:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 77:
+				return 4
+			case 82:
+				return -1
+			case 84:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return 5
+			case 70:
+				return -1
+			case 77:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return 6
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 77:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 77:
+				return -1
+			case 82:
+				return 7
+			case 84:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return 8
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 77:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 77:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return 9
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return 10
+			case 70:
+				return -1
+			case 77:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return 11
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 77:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
+				return -1
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 77:
+				return -1
+			case 82:
+				return -1
+			case 84:
+				return -1
+			}
+			return -1
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// CODECS=
+	{[]bool{false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 67:
+				return 1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 79:
+				return 2
+			case 83:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return 3
+			case 69:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return 4
+			case 79:
+				return -1
+			case 83:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 67:
+				return 5
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return 6
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return 7
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 79:
+				return -1
+			case 83:
+				return -1
+			}
+			return -1
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// AUDIO=
+	{[]bool{false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 65:
+				return 1
+			case 68:
+				return -1
+			case 73:
+				return -1
+			case 79:
+				return -1
+			case 85:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 73:
+				return -1
+			case 79:
+				return -1
+			case 85:
+				return 2
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return 3
+			case 73:
+				return -1
+			case 79:
+				return -1
+			case 85:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 73:
+				return 4
+			case 79:
+				return -1
+			case 85:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 73:
+				return -1
+			case 79:
+				return 5
+			case 85:
+				return -1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return 6
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 73:
 				return -1
-			case 83:
+			case 79:
 				return -1
-			case 84:
-				return 7
 			case 85:
 				return -1
 			}
@@ -11117,49 +21669,50 @@ var dfas = []dfa{
 			switch r {
 			case 61:
 				return -1
-			case 69:
+			case 65:
 				return -1
-			case 73:
-				return 8
-			case 76:
+			case 68:
 				return -1
-			case 78:
+			case 73:
 				return -1
 			case 79:
 				return -1
-			case 82:
-				return -1
-			case 83:
-				return -1
-			case 84:
-				return -1
 			case 85:
 				return -1
 			}
 			return -1
 		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// TYPE=
+	{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 61:
 				return -1
 			case 69:
 				return -1
-			case 73:
+			case 80:
 				return -1
-			case 76:
+			case 84:
+				return 1
+			case 89:
 				return -1
-			case 78:
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
 				return -1
-			case 79:
-				return 9
-			case 82:
+			case 69:
 				return -1
-			case 83:
+			case 80:
 				return -1
 			case 84:
 				return -1
-			case 85:
-				return -1
+			case 89:
+				return 2
 			}
 			return -1
 		},
@@ -11169,21 +21722,26 @@ var dfas = []dfa{
 				return -1
 			case 69:
 				return -1
-			case 73:
-				return -1
-			case 76:
+			case 80:
+				return 3
+			case 84:
 				return -1
-			case 78:
-				return 10
-			case 79:
+			case 89:
 				return -1
-			case 82:
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
 				return -1
-			case 83:
+			case 69:
+				return 4
+			case 80:
 				return -1
 			case 84:
 				return -1
-			case 85:
+			case 89:
 				return -1
 			}
 			return -1
@@ -11191,24 +21749,14 @@ var dfas = []dfa{
 		func(r rune) int {
 			switch r {
 			case 61:
-				return 11
+				return 5
 			case 69:
 				return -1
-			case 73:
-				return -1
-			case 76:
-				return -1
-			case 78:
-				return -1
-			case 79:
-				return -1
-			case 82:
-				return -1
-			case 83:
+			case 80:
 				return -1
 			case 84:
 				return -1
-			case 85:
+			case 89:
 				return -1
 			}
 			return -1
@@ -11219,46 +21767,38 @@ var dfas = []dfa{
 				return -1
 			case 69:
 				return -1
-			case 73:
-				return -1
-			case 76:
-				return -1
-			case 78:
-				return -1
-			case 79:
-				return -1
-			case 82:
-				return -1
-			case 83:
+			case 80:
 				return -1
 			case 84:
 				return -1
-			case 85:
+			case 89:
 				return -1
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
 
-	// FRAME-RATE=
-	{[]bool{false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+	// GROUP-ID=
+	{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 45:
 				return -1
 			case 61:
 				return -1
-			case 65:
-				return -1
-			case 69:
+			case 68:
 				return -1
-			case 70:
+			case 71:
 				return 1
-			case 77:
+			case 73:
+				return -1
+			case 79:
+				return -1
+			case 80:
 				return -1
 			case 82:
 				return -1
-			case 84:
+			case 85:
 				return -1
 			}
 			return -1
@@ -11269,17 +21809,19 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 65:
+			case 68:
 				return -1
-			case 69:
+			case 71:
 				return -1
-			case 70:
+			case 73:
 				return -1
-			case 77:
+			case 79:
+				return -1
+			case 80:
 				return -1
 			case 82:
 				return 2
-			case 84:
+			case 85:
 				return -1
 			}
 			return -1
@@ -11290,17 +21832,19 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 65:
-				return 3
-			case 69:
+			case 68:
 				return -1
-			case 70:
+			case 71:
 				return -1
-			case 77:
+			case 73:
+				return -1
+			case 79:
+				return 3
+			case 80:
 				return -1
 			case 82:
 				return -1
-			case 84:
+			case 85:
 				return -1
 			}
 			return -1
@@ -11311,18 +21855,20 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 65:
+			case 68:
 				return -1
-			case 69:
+			case 71:
 				return -1
-			case 70:
+			case 73:
 				return -1
-			case 77:
-				return 4
-			case 82:
+			case 79:
 				return -1
-			case 84:
+			case 80:
+				return -1
+			case 82:
 				return -1
+			case 85:
+				return 4
 			}
 			return -1
 		},
@@ -11332,17 +21878,19 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 65:
+			case 68:
 				return -1
-			case 69:
-				return 5
-			case 70:
+			case 71:
 				return -1
-			case 77:
+			case 73:
+				return -1
+			case 79:
 				return -1
+			case 80:
+				return 5
 			case 82:
 				return -1
-			case 84:
+			case 85:
 				return -1
 			}
 			return -1
@@ -11353,17 +21901,19 @@ var dfas = []dfa{
 				return 6
 			case 61:
 				return -1
-			case 65:
+			case 68:
 				return -1
-			case 69:
+			case 71:
 				return -1
-			case 70:
+			case 73:
 				return -1
-			case 77:
+			case 79:
+				return -1
+			case 80:
 				return -1
 			case 82:
 				return -1
-			case 84:
+			case 85:
 				return -1
 			}
 			return -1
@@ -11374,17 +21924,19 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 65:
+			case 68:
 				return -1
-			case 69:
+			case 71:
 				return -1
-			case 70:
+			case 73:
+				return 7
+			case 79:
 				return -1
-			case 77:
+			case 80:
 				return -1
 			case 82:
-				return 7
-			case 84:
+				return -1
+			case 85:
 				return -1
 			}
 			return -1
@@ -11395,17 +21947,19 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 65:
+			case 68:
 				return 8
-			case 69:
+			case 71:
 				return -1
-			case 70:
+			case 73:
 				return -1
-			case 77:
+			case 79:
+				return -1
+			case 80:
 				return -1
 			case 82:
 				return -1
-			case 84:
+			case 85:
 				return -1
 			}
 			return -1
@@ -11415,19 +21969,21 @@ var dfas = []dfa{
 			case 45:
 				return -1
 			case 61:
+				return 9
+			case 68:
 				return -1
-			case 65:
+			case 71:
 				return -1
-			case 69:
+			case 73:
 				return -1
-			case 70:
+			case 79:
 				return -1
-			case 77:
+			case 80:
 				return -1
 			case 82:
 				return -1
-			case 84:
-				return 9
+			case 85:
+				return -1
 			}
 			return -1
 		},
@@ -11437,80 +21993,83 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 65:
+			case 68:
 				return -1
-			case 69:
-				return 10
-			case 70:
+			case 71:
 				return -1
-			case 77:
+			case 73:
+				return -1
+			case 79:
+				return -1
+			case 80:
+				return -1
+			case 82:
+				return -1
+			case 85:
+				return -1
+			}
+			return -1
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// NAME=
+	{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 65:
 				return -1
-			case 82:
+			case 69:
 				return -1
-			case 84:
+			case 77:
 				return -1
+			case 78:
+				return 1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
-				return 11
-			case 65:
 				return -1
+			case 65:
+				return 2
 			case 69:
 				return -1
-			case 70:
-				return -1
 			case 77:
 				return -1
-			case 82:
-				return -1
-			case 84:
+			case 78:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
 			case 69:
 				return -1
-			case 70:
-				return -1
 			case 77:
-				return -1
-			case 82:
-				return -1
-			case 84:
+				return 3
+			case 78:
 				return -1
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-	// CODECS=
-	{[]bool{false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 61:
 				return -1
-			case 67:
-				return 1
-			case 68:
+			case 65:
 				return -1
 			case 69:
+				return 4
+			case 77:
 				return -1
-			case 79:
-				return -1
-			case 83:
+			case 78:
 				return -1
 			}
 			return -1
@@ -11518,16 +22077,14 @@ var dfas = []dfa{
 		func(r rune) int {
 			switch r {
 			case 61:
-				return -1
-			case 67:
-				return -1
-			case 68:
+				return 5
+			case 65:
 				return -1
 			case 69:
 				return -1
-			case 79:
-				return 2
-			case 83:
+			case 77:
+				return -1
+			case 78:
 				return -1
 			}
 			return -1
@@ -11536,32 +22093,38 @@ var dfas = []dfa{
 			switch r {
 			case 61:
 				return -1
-			case 67:
+			case 65:
 				return -1
-			case 68:
-				return 3
 			case 69:
 				return -1
-			case 79:
+			case 77:
 				return -1
-			case 83:
+			case 78:
 				return -1
 			}
 			return -1
 		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
+
+	// DEFAULT=
+	{[]bool{false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 61:
 				return -1
-			case 67:
+			case 65:
 				return -1
 			case 68:
-				return -1
+				return 1
 			case 69:
-				return 4
-			case 79:
 				return -1
-			case 83:
+			case 70:
+				return -1
+			case 76:
+				return -1
+			case 84:
+				return -1
+			case 85:
 				return -1
 			}
 			return -1
@@ -11570,15 +22133,19 @@ var dfas = []dfa{
 			switch r {
 			case 61:
 				return -1
-			case 67:
-				return 5
+			case 65:
+				return -1
 			case 68:
 				return -1
 			case 69:
+				return 2
+			case 70:
 				return -1
-			case 79:
+			case 76:
 				return -1
-			case 83:
+			case 84:
+				return -1
+			case 85:
 				return -1
 			}
 			return -1
@@ -11587,32 +22154,40 @@ var dfas = []dfa{
 			switch r {
 			case 61:
 				return -1
-			case 67:
+			case 65:
 				return -1
 			case 68:
 				return -1
 			case 69:
 				return -1
-			case 79:
+			case 70:
+				return 3
+			case 76:
+				return -1
+			case 84:
+				return -1
+			case 85:
 				return -1
-			case 83:
-				return 6
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
 			case 61:
-				return 7
-			case 67:
 				return -1
+			case 65:
+				return 4
 			case 68:
 				return -1
 			case 69:
 				return -1
-			case 79:
+			case 70:
 				return -1
-			case 83:
+			case 76:
+				return -1
+			case 84:
+				return -1
+			case 85:
 				return -1
 			}
 			return -1
@@ -11621,34 +22196,38 @@ var dfas = []dfa{
 			switch r {
 			case 61:
 				return -1
-			case 67:
+			case 65:
 				return -1
 			case 68:
 				return -1
 			case 69:
 				return -1
-			case 79:
+			case 70:
 				return -1
-			case 83:
+			case 76:
+				return -1
+			case 84:
 				return -1
+			case 85:
+				return 5
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-	// AUDIO=
-	{[]bool{false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 61:
 				return -1
 			case 65:
-				return 1
+				return -1
 			case 68:
 				return -1
-			case 73:
+			case 69:
 				return -1
-			case 79:
+			case 70:
+				return -1
+			case 76:
+				return 6
+			case 84:
 				return -1
 			case 85:
 				return -1
@@ -11663,26 +22242,34 @@ var dfas = []dfa{
 				return -1
 			case 68:
 				return -1
-			case 73:
+			case 69:
 				return -1
-			case 79:
+			case 70:
+				return -1
+			case 76:
 				return -1
+			case 84:
+				return 7
 			case 85:
-				return 2
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
 			case 61:
-				return -1
+				return 8
 			case 65:
 				return -1
 			case 68:
-				return 3
-			case 73:
 				return -1
-			case 79:
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 76:
+				return -1
+			case 84:
 				return -1
 			case 85:
 				return -1
@@ -11697,27 +22284,41 @@ var dfas = []dfa{
 				return -1
 			case 68:
 				return -1
-			case 73:
-				return 4
-			case 79:
+			case 69:
+				return -1
+			case 70:
+				return -1
+			case 76:
+				return -1
+			case 84:
 				return -1
 			case 85:
 				return -1
 			}
 			return -1
 		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// AUTOSELECT=
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 61:
 				return -1
 			case 65:
+				return 1
+			case 67:
 				return -1
-			case 68:
+			case 69:
 				return -1
-			case 73:
+			case 76:
 				return -1
 			case 79:
-				return 5
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
 			case 85:
 				return -1
 			}
@@ -11726,17 +22327,23 @@ var dfas = []dfa{
 		func(r rune) int {
 			switch r {
 			case 61:
-				return 6
+				return -1
 			case 65:
 				return -1
-			case 68:
+			case 67:
 				return -1
-			case 73:
+			case 69:
+				return -1
+			case 76:
 				return -1
 			case 79:
 				return -1
-			case 85:
+			case 83:
+				return -1
+			case 84:
 				return -1
+			case 85:
+				return 2
 			}
 			return -1
 		},
@@ -11746,32 +22353,42 @@ var dfas = []dfa{
 				return -1
 			case 65:
 				return -1
-			case 68:
+			case 67:
 				return -1
-			case 73:
+			case 69:
+				return -1
+			case 76:
 				return -1
 			case 79:
 				return -1
+			case 83:
+				return -1
+			case 84:
+				return 3
 			case 85:
 				return -1
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, nil},
-
-	// TYPE=
-	{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 61:
 				return -1
+			case 65:
+				return -1
+			case 67:
+				return -1
 			case 69:
 				return -1
-			case 80:
+			case 76:
+				return -1
+			case 79:
+				return 4
+			case 83:
 				return -1
 			case 84:
-				return 1
-			case 89:
+				return -1
+			case 85:
 				return -1
 			}
 			return -1
@@ -11780,14 +22397,22 @@ var dfas = []dfa{
 			switch r {
 			case 61:
 				return -1
+			case 65:
+				return -1
+			case 67:
+				return -1
 			case 69:
 				return -1
-			case 80:
+			case 76:
+				return -1
+			case 79:
 				return -1
+			case 83:
+				return 5
 			case 84:
 				return -1
-			case 89:
-				return 2
+			case 85:
+				return -1
 			}
 			return -1
 		},
@@ -11795,13 +22420,21 @@ var dfas = []dfa{
 			switch r {
 			case 61:
 				return -1
+			case 65:
+				return -1
+			case 67:
+				return -1
 			case 69:
+				return 6
+			case 76:
+				return -1
+			case 79:
+				return -1
+			case 83:
 				return -1
-			case 80:
-				return 3
 			case 84:
 				return -1
-			case 89:
+			case 85:
 				return -1
 			}
 			return -1
@@ -11810,13 +22443,21 @@ var dfas = []dfa{
 			switch r {
 			case 61:
 				return -1
+			case 65:
+				return -1
+			case 67:
+				return -1
 			case 69:
-				return 4
-			case 80:
+				return -1
+			case 76:
+				return 7
+			case 79:
+				return -1
+			case 83:
 				return -1
 			case 84:
 				return -1
-			case 89:
+			case 85:
 				return -1
 			}
 			return -1
@@ -11824,14 +22465,22 @@ var dfas = []dfa{
 		func(r rune) int {
 			switch r {
 			case 61:
-				return 5
+				return -1
+			case 65:
+				return -1
+			case 67:
+				return -1
 			case 69:
+				return 8
+			case 76:
 				return -1
-			case 80:
+			case 79:
+				return -1
+			case 83:
 				return -1
 			case 84:
 				return -1
-			case 89:
+			case 85:
 				return -1
 			}
 			return -1
@@ -11840,39 +22489,43 @@ var dfas = []dfa{
 			switch r {
 			case 61:
 				return -1
+			case 65:
+				return -1
+			case 67:
+				return 9
 			case 69:
 				return -1
-			case 80:
+			case 76:
+				return -1
+			case 79:
+				return -1
+			case 83:
 				return -1
 			case 84:
 				return -1
-			case 89:
+			case 85:
 				return -1
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
-
-	// GROUP-ID=
-	{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
-			case 68:
+			case 65:
 				return -1
-			case 71:
-				return 1
-			case 73:
+			case 67:
 				return -1
-			case 79:
+			case 69:
 				return -1
-			case 80:
+			case 76:
 				return -1
-			case 82:
+			case 79:
+				return -1
+			case 83:
 				return -1
+			case 84:
+				return 10
 			case 85:
 				return -1
 			}
@@ -11880,22 +22533,22 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
+				return 11
+			case 65:
 				return -1
-			case 68:
+			case 67:
 				return -1
-			case 71:
+			case 69:
 				return -1
-			case 73:
+			case 76:
 				return -1
 			case 79:
 				return -1
-			case 80:
+			case 83:
+				return -1
+			case 84:
 				return -1
-			case 82:
-				return 2
 			case 85:
 				return -1
 			}
@@ -11903,67 +22556,63 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
-			case 68:
+			case 65:
 				return -1
-			case 71:
+			case 67:
 				return -1
-			case 73:
+			case 69:
+				return -1
+			case 76:
 				return -1
 			case 79:
-				return 3
-			case 80:
 				return -1
-			case 82:
+			case 83:
+				return -1
+			case 84:
 				return -1
 			case 85:
 				return -1
 			}
 			return -1
 		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// LANGUAGE=
+	{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
-			case 68:
-				return -1
-			case 71:
-				return -1
-			case 73:
+			case 65:
 				return -1
-			case 79:
+			case 69:
 				return -1
-			case 80:
+			case 71:
 				return -1
-			case 82:
+			case 76:
+				return 1
+			case 78:
 				return -1
 			case 85:
-				return 4
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
-			case 68:
+			case 65:
+				return 2
+			case 69:
 				return -1
 			case 71:
 				return -1
-			case 73:
-				return -1
-			case 79:
+			case 76:
 				return -1
-			case 80:
-				return 5
-			case 82:
+			case 78:
 				return -1
 			case 85:
 				return -1
@@ -11972,22 +22621,18 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return 6
 			case 61:
 				return -1
-			case 68:
-				return -1
-			case 71:
-				return -1
-			case 73:
+			case 65:
 				return -1
-			case 79:
+			case 69:
 				return -1
-			case 80:
+			case 71:
 				return -1
-			case 82:
+			case 76:
 				return -1
+			case 78:
+				return 3
 			case 85:
 				return -1
 			}
@@ -11995,21 +22640,17 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
-			case 68:
-				return -1
-			case 71:
+			case 65:
 				return -1
-			case 73:
-				return 7
-			case 79:
+			case 69:
 				return -1
-			case 80:
+			case 71:
+				return 4
+			case 76:
 				return -1
-			case 82:
+			case 78:
 				return -1
 			case 85:
 				return -1
@@ -12018,44 +22659,36 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
-			case 68:
-				return 8
-			case 71:
+			case 65:
 				return -1
-			case 73:
+			case 69:
 				return -1
-			case 79:
+			case 71:
 				return -1
-			case 80:
+			case 76:
 				return -1
-			case 82:
+			case 78:
 				return -1
 			case 85:
-				return -1
+				return 5
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
-				return 9
-			case 68:
-				return -1
-			case 71:
 				return -1
-			case 73:
+			case 65:
+				return 6
+			case 69:
 				return -1
-			case 79:
+			case 71:
 				return -1
-			case 80:
+			case 76:
 				return -1
-			case 82:
+			case 78:
 				return -1
 			case 85:
 				return -1
@@ -12064,31 +22697,23 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
-			case 68:
-				return -1
-			case 71:
-				return -1
-			case 73:
+			case 65:
 				return -1
-			case 79:
+			case 69:
 				return -1
-			case 80:
+			case 71:
+				return 7
+			case 76:
 				return -1
-			case 82:
+			case 78:
 				return -1
 			case 85:
 				return -1
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-	// NAME=
-	{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 61:
@@ -12096,26 +22721,34 @@ var dfas = []dfa{
 			case 65:
 				return -1
 			case 69:
+				return 8
+			case 71:
 				return -1
-			case 77:
+			case 76:
 				return -1
 			case 78:
-				return 1
+				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
 			case 61:
-				return -1
+				return 9
 			case 65:
-				return 2
+				return -1
 			case 69:
 				return -1
-			case 77:
+			case 71:
+				return -1
+			case 76:
 				return -1
 			case 78:
 				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
@@ -12127,40 +22760,60 @@ var dfas = []dfa{
 				return -1
 			case 69:
 				return -1
-			case 77:
-				return 3
+			case 71:
+				return -1
+			case 76:
+				return -1
 			case 78:
 				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// CHANNELS=
+	{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 61:
 				return -1
 			case 65:
 				return -1
+			case 67:
+				return 1
 			case 69:
-				return 4
-			case 77:
+				return -1
+			case 72:
+				return -1
+			case 76:
 				return -1
 			case 78:
 				return -1
+			case 83:
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
 			case 61:
-				return 5
+				return -1
 			case 65:
 				return -1
+			case 67:
+				return -1
 			case 69:
 				return -1
-			case 77:
+			case 72:
+				return 2
+			case 76:
 				return -1
 			case 78:
 				return -1
+			case 83:
+				return -1
 			}
 			return -1
 		},
@@ -12169,37 +22822,39 @@ var dfas = []dfa{
 			case 61:
 				return -1
 			case 65:
+				return 3
+			case 67:
 				return -1
 			case 69:
 				return -1
-			case 77:
+			case 72:
+				return -1
+			case 76:
 				return -1
 			case 78:
 				return -1
+			case 83:
+				return -1
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
-
-	// DEFAULT=
-	{[]bool{false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 61:
 				return -1
 			case 65:
 				return -1
-			case 68:
-				return 1
+			case 67:
+				return -1
 			case 69:
 				return -1
-			case 70:
+			case 72:
 				return -1
 			case 76:
 				return -1
-			case 84:
-				return -1
-			case 85:
+			case 78:
+				return 4
+			case 83:
 				return -1
 			}
 			return -1
@@ -12210,17 +22865,17 @@ var dfas = []dfa{
 				return -1
 			case 65:
 				return -1
-			case 68:
+			case 67:
 				return -1
 			case 69:
-				return 2
-			case 70:
 				return -1
-			case 76:
+			case 72:
 				return -1
-			case 84:
+			case 76:
 				return -1
-			case 85:
+			case 78:
+				return 5
+			case 83:
 				return -1
 			}
 			return -1
@@ -12231,17 +22886,17 @@ var dfas = []dfa{
 				return -1
 			case 65:
 				return -1
-			case 68:
+			case 67:
 				return -1
 			case 69:
+				return 6
+			case 72:
 				return -1
-			case 70:
-				return 3
 			case 76:
 				return -1
-			case 84:
+			case 78:
 				return -1
-			case 85:
+			case 83:
 				return -1
 			}
 			return -1
@@ -12251,18 +22906,18 @@ var dfas = []dfa{
 			case 61:
 				return -1
 			case 65:
-				return 4
-			case 68:
+				return -1
+			case 67:
 				return -1
 			case 69:
 				return -1
-			case 70:
+			case 72:
 				return -1
 			case 76:
+				return 7
+			case 78:
 				return -1
-			case 84:
-				return -1
-			case 85:
+			case 83:
 				return -1
 			}
 			return -1
@@ -12273,38 +22928,38 @@ var dfas = []dfa{
 				return -1
 			case 65:
 				return -1
-			case 68:
+			case 67:
 				return -1
 			case 69:
 				return -1
-			case 70:
+			case 72:
 				return -1
 			case 76:
 				return -1
-			case 84:
+			case 78:
 				return -1
-			case 85:
-				return 5
+			case 83:
+				return 8
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
 			case 61:
-				return -1
+				return 9
 			case 65:
 				return -1
-			case 68:
+			case 67:
 				return -1
 			case 69:
 				return -1
-			case 70:
+			case 72:
 				return -1
 			case 76:
-				return 6
-			case 84:
 				return -1
-			case 85:
+			case 78:
+				return -1
+			case 83:
 				return -1
 			}
 			return -1
@@ -12315,37 +22970,46 @@ var dfas = []dfa{
 				return -1
 			case 65:
 				return -1
-			case 68:
+			case 67:
 				return -1
 			case 69:
 				return -1
-			case 70:
+			case 72:
 				return -1
 			case 76:
 				return -1
-			case 84:
-				return 7
-			case 85:
+			case 78:
+				return -1
+			case 83:
 				return -1
 			}
 			return -1
 		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// URI=
+	{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 61:
-				return 8
-			case 65:
-				return -1
-			case 68:
 				return -1
-			case 69:
+			case 73:
 				return -1
-			case 70:
+			case 82:
 				return -1
-			case 76:
+			case 85:
+				return 1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
 				return -1
-			case 84:
+			case 73:
 				return -1
+			case 82:
+				return 2
 			case 85:
 				return -1
 			}
@@ -12355,435 +23019,595 @@ var dfas = []dfa{
 			switch r {
 			case 61:
 				return -1
-			case 65:
+			case 73:
+				return 3
+			case 82:
 				return -1
-			case 68:
+			case 85:
 				return -1
-			case 69:
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return 4
+			case 73:
 				return -1
-			case 70:
+			case 82:
 				return -1
-			case 76:
+			case 85:
 				return -1
-			case 84:
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 61:
+				return -1
+			case 73:
+				return -1
+			case 82:
 				return -1
 			case 85:
 				return -1
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
 
-	// AUTOSELECT=
-	{[]bool{false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+	// CAN-BLOCK-RELOAD=
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
-				return 1
+				return -1
+			case 66:
+				return -1
 			case 67:
+				return 1
+			case 68:
 				return -1
 			case 69:
 				return -1
-			case 76:
+			case 75:
 				return -1
-			case 79:
+			case 76:
 				return -1
-			case 83:
+			case 78:
 				return -1
-			case 84:
+			case 79:
 				return -1
-			case 85:
+			case 82:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
+				return 2
+			case 66:
 				return -1
 			case 67:
 				return -1
+			case 68:
+				return -1
 			case 69:
 				return -1
+			case 75:
+				return -1
 			case 76:
 				return -1
-			case 79:
+			case 78:
 				return -1
-			case 83:
+			case 79:
 				return -1
-			case 84:
+			case 82:
 				return -1
-			case 85:
-				return 2
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
+			case 66:
+				return -1
 			case 67:
 				return -1
+			case 68:
+				return -1
 			case 69:
 				return -1
+			case 75:
+				return -1
 			case 76:
 				return -1
+			case 78:
+				return 3
 			case 79:
 				return -1
-			case 83:
-				return -1
-			case 84:
-				return 3
-			case 85:
+			case 82:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return 4
 			case 61:
 				return -1
 			case 65:
 				return -1
+			case 66:
+				return -1
 			case 67:
 				return -1
+			case 68:
+				return -1
 			case 69:
 				return -1
+			case 75:
+				return -1
 			case 76:
 				return -1
-			case 79:
-				return 4
-			case 83:
+			case 78:
 				return -1
-			case 84:
+			case 79:
 				return -1
-			case 85:
+			case 82:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
+			case 66:
+				return 5
 			case 67:
 				return -1
+			case 68:
+				return -1
 			case 69:
 				return -1
+			case 75:
+				return -1
 			case 76:
 				return -1
-			case 79:
+			case 78:
 				return -1
-			case 83:
-				return 5
-			case 84:
+			case 79:
 				return -1
-			case 85:
+			case 82:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
+			case 66:
+				return -1
 			case 67:
 				return -1
+			case 68:
+				return -1
 			case 69:
-				return 6
+				return -1
+			case 75:
+				return -1
 			case 76:
+				return 6
+			case 78:
 				return -1
 			case 79:
 				return -1
-			case 83:
-				return -1
-			case 84:
-				return -1
-			case 85:
+			case 82:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
+			case 66:
+				return -1
 			case 67:
 				return -1
+			case 68:
+				return -1
 			case 69:
 				return -1
-			case 76:
-				return 7
-			case 79:
+			case 75:
 				return -1
-			case 83:
+			case 76:
 				return -1
-			case 84:
+			case 78:
 				return -1
-			case 85:
+			case 79:
+				return 7
+			case 82:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
+			case 66:
+				return -1
 			case 67:
+				return 8
+			case 68:
 				return -1
 			case 69:
-				return 8
-			case 76:
 				return -1
-			case 79:
+			case 75:
 				return -1
-			case 83:
+			case 76:
 				return -1
-			case 84:
+			case 78:
 				return -1
-			case 85:
+			case 79:
+				return -1
+			case 82:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
+			case 66:
+				return -1
 			case 67:
-				return 9
+				return -1
+			case 68:
+				return -1
 			case 69:
 				return -1
+			case 75:
+				return 9
 			case 76:
 				return -1
-			case 79:
-				return -1
-			case 83:
+			case 78:
 				return -1
-			case 84:
+			case 79:
 				return -1
-			case 85:
+			case 82:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return 10
 			case 61:
 				return -1
 			case 65:
 				return -1
+			case 66:
+				return -1
 			case 67:
 				return -1
+			case 68:
+				return -1
 			case 69:
 				return -1
+			case 75:
+				return -1
 			case 76:
 				return -1
-			case 79:
+			case 78:
 				return -1
-			case 83:
+			case 79:
 				return -1
-			case 84:
-				return 10
-			case 85:
+			case 82:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
-				return 11
+				return -1
 			case 65:
 				return -1
+			case 66:
+				return -1
 			case 67:
 				return -1
-			case 69:
+			case 68:
 				return -1
-			case 76:
+			case 69:
 				return -1
-			case 79:
+			case 75:
 				return -1
-			case 83:
+			case 76:
 				return -1
-			case 84:
+			case 78:
 				return -1
-			case 85:
+			case 79:
 				return -1
+			case 82:
+				return 11
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
+			case 66:
+				return -1
 			case 67:
 				return -1
+			case 68:
+				return -1
 			case 69:
+				return 12
+			case 75:
 				return -1
 			case 76:
 				return -1
-			case 79:
-				return -1
-			case 83:
+			case 78:
 				return -1
-			case 84:
+			case 79:
 				return -1
-			case 85:
+			case 82:
 				return -1
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-	// LANGUAGE=
-	{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
+			case 66:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
 			case 69:
 				return -1
-			case 71:
+			case 75:
 				return -1
 			case 76:
-				return 1
+				return 13
 			case 78:
 				return -1
-			case 85:
+			case 79:
+				return -1
+			case 82:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
-				return 2
+				return -1
+			case 66:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
 			case 69:
 				return -1
-			case 71:
+			case 75:
 				return -1
 			case 76:
 				return -1
 			case 78:
 				return -1
-			case 85:
+			case 79:
+				return 14
+			case 82:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
+				return 15
+			case 66:
+				return -1
+			case 67:
+				return -1
+			case 68:
 				return -1
 			case 69:
 				return -1
-			case 71:
+			case 75:
 				return -1
 			case 76:
 				return -1
 			case 78:
-				return 3
-			case 85:
+				return -1
+			case 79:
+				return -1
+			case 82:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
+			case 66:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return 16
 			case 69:
 				return -1
-			case 71:
-				return 4
+			case 75:
+				return -1
 			case 76:
 				return -1
 			case 78:
 				return -1
-			case 85:
+			case 79:
+				return -1
+			case 82:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 61:
+			case 45:
 				return -1
+			case 61:
+				return 17
 			case 65:
 				return -1
+			case 66:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
 			case 69:
 				return -1
-			case 71:
+			case 75:
 				return -1
 			case 76:
 				return -1
 			case 78:
 				return -1
-			case 85:
-				return 5
+			case 79:
+				return -1
+			case 82:
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
-				return 6
+				return -1
+			case 66:
+				return -1
+			case 67:
+				return -1
+			case 68:
+				return -1
 			case 69:
 				return -1
-			case 71:
+			case 75:
 				return -1
 			case 76:
 				return -1
 			case 78:
 				return -1
-			case 85:
+			case 79:
+				return -1
+			case 82:
 				return -1
 			}
 			return -1
 		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// CAN-SKIP-UNTIL=
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
-			case 69:
+			case 67:
+				return 1
+			case 73:
+				return -1
+			case 75:
 				return -1
-			case 71:
-				return 7
 			case 76:
 				return -1
 			case 78:
 				return -1
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
 			case 85:
 				return -1
 			}
@@ -12791,18 +23615,28 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
+				return 2
+			case 67:
 				return -1
-			case 69:
-				return 8
-			case 71:
+			case 73:
+				return -1
+			case 75:
 				return -1
 			case 76:
 				return -1
 			case 78:
 				return -1
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
 			case 85:
 				return -1
 			}
@@ -12810,17 +23644,27 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
-				return 9
+				return -1
 			case 65:
 				return -1
-			case 69:
+			case 67:
+				return -1
+			case 73:
 				return -1
-			case 71:
+			case 75:
 				return -1
 			case 76:
 				return -1
 			case 78:
+				return 3
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
 				return -1
 			case 85:
 				return -1
@@ -12829,274 +23673,346 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return 4
 			case 61:
 				return -1
 			case 65:
 				return -1
-			case 69:
+			case 67:
 				return -1
-			case 71:
+			case 73:
+				return -1
+			case 75:
 				return -1
 			case 76:
 				return -1
 			case 78:
 				return -1
+			case 80:
+				return -1
+			case 83:
+				return -1
+			case 84:
+				return -1
 			case 85:
 				return -1
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-	// CHANNELS=
-	{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
 			case 67:
-				return 1
-			case 69:
 				return -1
-			case 72:
+			case 73:
+				return -1
+			case 75:
 				return -1
 			case 76:
 				return -1
 			case 78:
 				return -1
+			case 80:
+				return -1
 			case 83:
+				return 5
+			case 84:
+				return -1
+			case 85:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
 			case 67:
 				return -1
-			case 69:
+			case 73:
 				return -1
-			case 72:
-				return 2
+			case 75:
+				return 6
 			case 76:
 				return -1
 			case 78:
 				return -1
+			case 80:
+				return -1
 			case 83:
 				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
-				return 3
-			case 67:
 				return -1
-			case 69:
+			case 67:
 				return -1
-			case 72:
+			case 73:
+				return 7
+			case 75:
 				return -1
 			case 76:
 				return -1
 			case 78:
 				return -1
+			case 80:
+				return -1
 			case 83:
 				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
 			case 67:
 				return -1
-			case 69:
+			case 73:
 				return -1
-			case 72:
+			case 75:
 				return -1
 			case 76:
 				return -1
 			case 78:
-				return 4
+				return -1
+			case 80:
+				return 8
 			case 83:
 				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return 9
 			case 61:
 				return -1
 			case 65:
 				return -1
 			case 67:
 				return -1
-			case 69:
+			case 73:
 				return -1
-			case 72:
+			case 75:
 				return -1
 			case 76:
 				return -1
 			case 78:
-				return 5
+				return -1
+			case 80:
+				return -1
 			case 83:
 				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
 			case 67:
 				return -1
-			case 69:
-				return 6
-			case 72:
+			case 73:
+				return -1
+			case 75:
 				return -1
 			case 76:
 				return -1
 			case 78:
 				return -1
+			case 80:
+				return -1
 			case 83:
 				return -1
+			case 84:
+				return -1
+			case 85:
+				return 10
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
 			case 67:
 				return -1
-			case 69:
+			case 73:
 				return -1
-			case 72:
+			case 75:
 				return -1
 			case 76:
-				return 7
+				return -1
 			case 78:
+				return 11
+			case 80:
 				return -1
 			case 83:
 				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
 			case 67:
 				return -1
-			case 69:
+			case 73:
 				return -1
-			case 72:
+			case 75:
 				return -1
 			case 76:
 				return -1
 			case 78:
 				return -1
+			case 80:
+				return -1
 			case 83:
-				return 8
+				return -1
+			case 84:
+				return 12
+			case 85:
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
-				return 9
+				return -1
 			case 65:
 				return -1
 			case 67:
 				return -1
-			case 69:
-				return -1
-			case 72:
+			case 73:
+				return 13
+			case 75:
 				return -1
 			case 76:
 				return -1
 			case 78:
 				return -1
+			case 80:
+				return -1
 			case 83:
 				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
 			case 67:
 				return -1
-			case 69:
+			case 73:
 				return -1
-			case 72:
+			case 75:
 				return -1
 			case 76:
-				return -1
+				return 14
 			case 78:
 				return -1
+			case 80:
+				return -1
 			case 83:
 				return -1
+			case 84:
+				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-	// URI=
-	{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
+				return 15
+			case 65:
+				return -1
+			case 67:
 				return -1
 			case 73:
 				return -1
-			case 82:
+			case 75:
 				return -1
-			case 85:
-				return 1
-			}
-			return -1
-		},
-		func(r rune) int {
-			switch r {
-			case 61:
+			case 76:
 				return -1
-			case 73:
+			case 78:
 				return -1
-			case 82:
-				return 2
-			case 85:
+			case 80:
 				return -1
-			}
-			return -1
-		},
-		func(r rune) int {
-			switch r {
-			case 61:
+			case 83:
 				return -1
-			case 73:
-				return 3
-			case 82:
+			case 84:
 				return -1
 			case 85:
 				return -1
@@ -13105,34 +24021,37 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
-				return 4
+				return -1
+			case 65:
+				return -1
+			case 67:
+				return -1
 			case 73:
 				return -1
-			case 82:
+			case 75:
 				return -1
-			case 85:
+			case 76:
 				return -1
-			}
-			return -1
-		},
-		func(r rune) int {
-			switch r {
-			case 61:
+			case 78:
 				return -1
-			case 73:
+			case 80:
 				return -1
-			case 82:
+			case 83:
+				return -1
+			case 84:
 				return -1
 			case 85:
 				return -1
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
 
-	// CAN-BLOCK-RELOAD=
-	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+	// PART-HOLD-BACK=
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 45:
@@ -13144,21 +24063,23 @@ var dfas = []dfa{
 			case 66:
 				return -1
 			case 67:
-				return 1
+				return -1
 			case 68:
 				return -1
-			case 69:
+			case 72:
 				return -1
 			case 75:
 				return -1
 			case 76:
 				return -1
-			case 78:
-				return -1
 			case 79:
 				return -1
+			case 80:
+				return 1
 			case 82:
 				return -1
+			case 84:
+				return -1
 			}
 			return -1
 		},
@@ -13176,18 +24097,20 @@ var dfas = []dfa{
 				return -1
 			case 68:
 				return -1
-			case 69:
+			case 72:
 				return -1
 			case 75:
 				return -1
-			case 76:
-				return -1
-			case 78:
+			case 76:
 				return -1
 			case 79:
 				return -1
+			case 80:
+				return -1
 			case 82:
 				return -1
+			case 84:
+				return -1
 			}
 			return -1
 		},
@@ -13205,17 +24128,19 @@ var dfas = []dfa{
 				return -1
 			case 68:
 				return -1
-			case 69:
+			case 72:
 				return -1
 			case 75:
 				return -1
 			case 76:
 				return -1
-			case 78:
-				return 3
 			case 79:
 				return -1
+			case 80:
+				return -1
 			case 82:
+				return 3
+			case 84:
 				return -1
 			}
 			return -1
@@ -13223,7 +24148,7 @@ var dfas = []dfa{
 		func(r rune) int {
 			switch r {
 			case 45:
-				return 4
+				return -1
 			case 61:
 				return -1
 			case 65:
@@ -13234,47 +24159,51 @@ var dfas = []dfa{
 				return -1
 			case 68:
 				return -1
-			case 69:
+			case 72:
 				return -1
 			case 75:
 				return -1
 			case 76:
 				return -1
-			case 78:
-				return -1
 			case 79:
 				return -1
+			case 80:
+				return -1
 			case 82:
 				return -1
+			case 84:
+				return 4
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
 			case 45:
-				return -1
+				return 5
 			case 61:
 				return -1
 			case 65:
 				return -1
 			case 66:
-				return 5
+				return -1
 			case 67:
 				return -1
 			case 68:
 				return -1
-			case 69:
+			case 72:
 				return -1
 			case 75:
 				return -1
 			case 76:
 				return -1
-			case 78:
-				return -1
 			case 79:
 				return -1
+			case 80:
+				return -1
 			case 82:
 				return -1
+			case 84:
+				return -1
 			}
 			return -1
 		},
@@ -13292,18 +24221,20 @@ var dfas = []dfa{
 				return -1
 			case 68:
 				return -1
-			case 69:
-				return -1
+			case 72:
+				return 6
 			case 75:
 				return -1
 			case 76:
-				return 6
-			case 78:
 				return -1
 			case 79:
 				return -1
+			case 80:
+				return -1
 			case 82:
 				return -1
+			case 84:
+				return -1
 			}
 			return -1
 		},
@@ -13321,18 +24252,20 @@ var dfas = []dfa{
 				return -1
 			case 68:
 				return -1
-			case 69:
+			case 72:
 				return -1
 			case 75:
 				return -1
 			case 76:
 				return -1
-			case 78:
-				return -1
 			case 79:
 				return 7
+			case 80:
+				return -1
 			case 82:
 				return -1
+			case 84:
+				return -1
 			}
 			return -1
 		},
@@ -13347,21 +24280,23 @@ var dfas = []dfa{
 			case 66:
 				return -1
 			case 67:
-				return 8
+				return -1
 			case 68:
 				return -1
-			case 69:
+			case 72:
 				return -1
 			case 75:
 				return -1
 			case 76:
-				return -1
-			case 78:
-				return -1
+				return 8
 			case 79:
 				return -1
+			case 80:
+				return -1
 			case 82:
 				return -1
+			case 84:
+				return -1
 			}
 			return -1
 		},
@@ -13378,19 +24313,21 @@ var dfas = []dfa{
 			case 67:
 				return -1
 			case 68:
-				return -1
-			case 69:
+				return 9
+			case 72:
 				return -1
 			case 75:
-				return 9
-			case 76:
 				return -1
-			case 78:
+			case 76:
 				return -1
 			case 79:
 				return -1
+			case 80:
+				return -1
 			case 82:
 				return -1
+			case 84:
+				return -1
 			}
 			return -1
 		},
@@ -13408,18 +24345,20 @@ var dfas = []dfa{
 				return -1
 			case 68:
 				return -1
-			case 69:
+			case 72:
 				return -1
 			case 75:
 				return -1
 			case 76:
 				return -1
-			case 78:
-				return -1
 			case 79:
 				return -1
+			case 80:
+				return -1
 			case 82:
 				return -1
+			case 84:
+				return -1
 			}
 			return -1
 		},
@@ -13432,23 +24371,25 @@ var dfas = []dfa{
 			case 65:
 				return -1
 			case 66:
-				return -1
+				return 11
 			case 67:
 				return -1
 			case 68:
 				return -1
-			case 69:
+			case 72:
 				return -1
 			case 75:
 				return -1
 			case 76:
 				return -1
-			case 78:
-				return -1
 			case 79:
 				return -1
+			case 80:
+				return -1
 			case 82:
-				return 11
+				return -1
+			case 84:
+				return -1
 			}
 			return -1
 		},
@@ -13459,25 +24400,27 @@ var dfas = []dfa{
 			case 61:
 				return -1
 			case 65:
-				return -1
+				return 12
 			case 66:
 				return -1
 			case 67:
 				return -1
 			case 68:
 				return -1
-			case 69:
-				return 12
+			case 72:
+				return -1
 			case 75:
 				return -1
 			case 76:
 				return -1
-			case 78:
-				return -1
 			case 79:
 				return -1
+			case 80:
+				return -1
 			case 82:
 				return -1
+			case 84:
+				return -1
 			}
 			return -1
 		},
@@ -13492,21 +24435,23 @@ var dfas = []dfa{
 			case 66:
 				return -1
 			case 67:
-				return -1
+				return 13
 			case 68:
 				return -1
-			case 69:
+			case 72:
 				return -1
 			case 75:
 				return -1
 			case 76:
-				return 13
-			case 78:
 				return -1
 			case 79:
 				return -1
+			case 80:
+				return -1
 			case 82:
 				return -1
+			case 84:
+				return -1
 			}
 			return -1
 		},
@@ -13524,18 +24469,20 @@ var dfas = []dfa{
 				return -1
 			case 68:
 				return -1
-			case 69:
+			case 72:
 				return -1
 			case 75:
-				return -1
+				return 14
 			case 76:
 				return -1
-			case 78:
-				return -1
 			case 79:
-				return 14
+				return -1
+			case 80:
+				return -1
 			case 82:
 				return -1
+			case 84:
+				return -1
 			}
 			return -1
 		},
@@ -13544,27 +24491,29 @@ var dfas = []dfa{
 			case 45:
 				return -1
 			case 61:
-				return -1
-			case 65:
 				return 15
+			case 65:
+				return -1
 			case 66:
 				return -1
 			case 67:
 				return -1
 			case 68:
 				return -1
-			case 69:
+			case 72:
 				return -1
 			case 75:
 				return -1
 			case 76:
 				return -1
-			case 78:
-				return -1
 			case 79:
 				return -1
+			case 80:
+				return -1
 			case 82:
 				return -1
+			case 84:
+				return -1
 			}
 			return -1
 		},
@@ -13581,48 +24530,67 @@ var dfas = []dfa{
 			case 67:
 				return -1
 			case 68:
-				return 16
-			case 69:
+				return -1
+			case 72:
 				return -1
 			case 75:
 				return -1
 			case 76:
 				return -1
-			case 78:
-				return -1
 			case 79:
 				return -1
+			case 80:
+				return -1
 			case 82:
 				return -1
+			case 84:
+				return -1
 			}
 			return -1
 		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// PART-TARGET=
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 45:
 				return -1
 			case 61:
-				return 17
+				return -1
 			case 65:
 				return -1
-			case 66:
+			case 69:
 				return -1
-			case 67:
+			case 71:
 				return -1
-			case 68:
+			case 80:
+				return 1
+			case 82:
 				return -1
-			case 69:
+			case 84:
 				return -1
-			case 75:
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 45:
 				return -1
-			case 76:
+			case 61:
 				return -1
-			case 78:
+			case 65:
+				return 2
+			case 69:
 				return -1
-			case 79:
+			case 71:
+				return -1
+			case 80:
 				return -1
 			case 82:
 				return -1
+			case 84:
+				return -1
 			}
 			return -1
 		},
@@ -13634,31 +24602,19 @@ var dfas = []dfa{
 				return -1
 			case 65:
 				return -1
-			case 66:
-				return -1
-			case 67:
-				return -1
-			case 68:
-				return -1
 			case 69:
 				return -1
-			case 75:
-				return -1
-			case 76:
-				return -1
-			case 78:
+			case 71:
 				return -1
-			case 79:
+			case 80:
 				return -1
 			case 82:
+				return 3
+			case 84:
 				return -1
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-	// CAN-SKIP-UNTIL=
-	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 45:
@@ -13667,53 +24623,37 @@ var dfas = []dfa{
 				return -1
 			case 65:
 				return -1
-			case 67:
-				return 1
-			case 73:
-				return -1
-			case 75:
-				return -1
-			case 76:
+			case 69:
 				return -1
-			case 78:
+			case 71:
 				return -1
 			case 80:
 				return -1
-			case 83:
+			case 82:
 				return -1
 			case 84:
-				return -1
-			case 85:
-				return -1
+				return 4
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
 			case 45:
-				return -1
+				return 5
 			case 61:
 				return -1
 			case 65:
-				return 2
-			case 67:
-				return -1
-			case 73:
-				return -1
-			case 75:
 				return -1
-			case 76:
+			case 69:
 				return -1
-			case 78:
+			case 71:
 				return -1
 			case 80:
 				return -1
-			case 83:
+			case 82:
 				return -1
 			case 84:
 				return -1
-			case 85:
-				return -1
 			}
 			return -1
 		},
@@ -13725,53 +24665,37 @@ var dfas = []dfa{
 				return -1
 			case 65:
 				return -1
-			case 67:
-				return -1
-			case 73:
-				return -1
-			case 75:
+			case 69:
 				return -1
-			case 76:
+			case 71:
 				return -1
-			case 78:
-				return 3
 			case 80:
 				return -1
-			case 83:
-				return -1
-			case 84:
-				return -1
-			case 85:
+			case 82:
 				return -1
+			case 84:
+				return 6
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
 			case 45:
-				return 4
+				return -1
 			case 61:
 				return -1
 			case 65:
+				return 7
+			case 69:
 				return -1
-			case 67:
-				return -1
-			case 73:
-				return -1
-			case 75:
-				return -1
-			case 76:
-				return -1
-			case 78:
+			case 71:
 				return -1
 			case 80:
 				return -1
-			case 83:
+			case 82:
 				return -1
 			case 84:
 				return -1
-			case 85:
-				return -1
 			}
 			return -1
 		},
@@ -13783,24 +24707,16 @@ var dfas = []dfa{
 				return -1
 			case 65:
 				return -1
-			case 67:
-				return -1
-			case 73:
-				return -1
-			case 75:
-				return -1
-			case 76:
+			case 69:
 				return -1
-			case 78:
+			case 71:
 				return -1
 			case 80:
 				return -1
-			case 83:
-				return 5
+			case 82:
+				return 8
 			case 84:
 				return -1
-			case 85:
-				return -1
 			}
 			return -1
 		},
@@ -13812,24 +24728,16 @@ var dfas = []dfa{
 				return -1
 			case 65:
 				return -1
-			case 67:
-				return -1
-			case 73:
-				return -1
-			case 75:
-				return 6
-			case 76:
-				return -1
-			case 78:
+			case 69:
 				return -1
+			case 71:
+				return 9
 			case 80:
 				return -1
-			case 83:
+			case 82:
 				return -1
 			case 84:
 				return -1
-			case 85:
-				return -1
 			}
 			return -1
 		},
@@ -13841,24 +24749,16 @@ var dfas = []dfa{
 				return -1
 			case 65:
 				return -1
-			case 67:
-				return -1
-			case 73:
-				return 7
-			case 75:
-				return -1
-			case 76:
-				return -1
-			case 78:
+			case 69:
+				return 10
+			case 71:
 				return -1
 			case 80:
 				return -1
-			case 83:
+			case 82:
 				return -1
 			case 84:
 				return -1
-			case 85:
-				return -1
 			}
 			return -1
 		},
@@ -13870,53 +24770,37 @@ var dfas = []dfa{
 				return -1
 			case 65:
 				return -1
-			case 67:
-				return -1
-			case 73:
-				return -1
-			case 75:
-				return -1
-			case 76:
+			case 69:
 				return -1
-			case 78:
+			case 71:
 				return -1
 			case 80:
-				return 8
-			case 83:
 				return -1
-			case 84:
-				return -1
-			case 85:
+			case 82:
 				return -1
+			case 84:
+				return 11
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
 			case 45:
-				return 9
-			case 61:
 				return -1
+			case 61:
+				return 12
 			case 65:
 				return -1
-			case 67:
-				return -1
-			case 73:
-				return -1
-			case 75:
-				return -1
-			case 76:
+			case 69:
 				return -1
-			case 78:
+			case 71:
 				return -1
 			case 80:
 				return -1
-			case 83:
+			case 82:
 				return -1
 			case 84:
 				return -1
-			case 85:
-				return -1
 			}
 			return -1
 		},
@@ -13928,53 +24812,49 @@ var dfas = []dfa{
 				return -1
 			case 65:
 				return -1
-			case 67:
-				return -1
-			case 73:
-				return -1
-			case 75:
-				return -1
-			case 76:
+			case 69:
 				return -1
-			case 78:
+			case 71:
 				return -1
 			case 80:
 				return -1
-			case 83:
+			case 82:
 				return -1
 			case 84:
 				return -1
-			case 85:
-				return 10
 			}
 			return -1
 		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// SKIPPED-SEGMENTS=
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 45:
 				return -1
 			case 61:
 				return -1
-			case 65:
+			case 68:
 				return -1
-			case 67:
+			case 69:
+				return -1
+			case 71:
 				return -1
 			case 73:
 				return -1
 			case 75:
 				return -1
-			case 76:
+			case 77:
 				return -1
 			case 78:
-				return 11
+				return -1
 			case 80:
 				return -1
 			case 83:
-				return -1
+				return 1
 			case 84:
 				return -1
-			case 85:
-				return -1
 			}
 			return -1
 		},
@@ -13984,15 +24864,17 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 65:
+			case 68:
 				return -1
-			case 67:
+			case 69:
+				return -1
+			case 71:
 				return -1
 			case 73:
 				return -1
 			case 75:
-				return -1
-			case 76:
+				return 2
+			case 77:
 				return -1
 			case 78:
 				return -1
@@ -14001,8 +24883,6 @@ var dfas = []dfa{
 			case 83:
 				return -1
 			case 84:
-				return 12
-			case 85:
 				return -1
 			}
 			return -1
@@ -14013,15 +24893,17 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 65:
+			case 68:
 				return -1
-			case 67:
+			case 69:
+				return -1
+			case 71:
 				return -1
 			case 73:
-				return 13
+				return 3
 			case 75:
 				return -1
-			case 76:
+			case 77:
 				return -1
 			case 78:
 				return -1
@@ -14031,8 +24913,6 @@ var dfas = []dfa{
 				return -1
 			case 84:
 				return -1
-			case 85:
-				return -1
 			}
 			return -1
 		},
@@ -14042,26 +24922,26 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 65:
+			case 68:
 				return -1
-			case 67:
+			case 69:
+				return -1
+			case 71:
 				return -1
 			case 73:
 				return -1
 			case 75:
 				return -1
-			case 76:
-				return 14
+			case 77:
+				return -1
 			case 78:
 				return -1
 			case 80:
-				return -1
+				return 4
 			case 83:
 				return -1
 			case 84:
 				return -1
-			case 85:
-				return -1
 			}
 			return -1
 		},
@@ -14070,27 +24950,27 @@ var dfas = []dfa{
 			case 45:
 				return -1
 			case 61:
-				return 15
-			case 65:
 				return -1
-			case 67:
+			case 68:
+				return -1
+			case 69:
+				return -1
+			case 71:
 				return -1
 			case 73:
 				return -1
 			case 75:
 				return -1
-			case 76:
+			case 77:
 				return -1
 			case 78:
 				return -1
 			case 80:
-				return -1
+				return 5
 			case 83:
 				return -1
 			case 84:
 				return -1
-			case 85:
-				return -1
 			}
 			return -1
 		},
@@ -14100,15 +24980,17 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 65:
+			case 68:
 				return -1
-			case 67:
+			case 69:
+				return 6
+			case 71:
 				return -1
 			case 73:
 				return -1
 			case 75:
 				return -1
-			case 76:
+			case 77:
 				return -1
 			case 78:
 				return -1
@@ -14118,40 +25000,32 @@ var dfas = []dfa{
 				return -1
 			case 84:
 				return -1
-			case 85:
-				return -1
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-	// PART-HOLD-BACK=
-	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 45:
 				return -1
 			case 61:
 				return -1
-			case 65:
-				return -1
-			case 66:
-				return -1
-			case 67:
-				return -1
 			case 68:
+				return 7
+			case 69:
 				return -1
-			case 72:
+			case 71:
+				return -1
+			case 73:
 				return -1
 			case 75:
 				return -1
-			case 76:
+			case 77:
 				return -1
-			case 79:
+			case 78:
 				return -1
 			case 80:
-				return 1
-			case 82:
+				return -1
+			case 83:
 				return -1
 			case 84:
 				return -1
@@ -14161,28 +25035,26 @@ var dfas = []dfa{
 		func(r rune) int {
 			switch r {
 			case 45:
-				return -1
+				return 8
 			case 61:
 				return -1
-			case 65:
-				return 2
-			case 66:
+			case 68:
 				return -1
-			case 67:
+			case 69:
 				return -1
-			case 68:
+			case 71:
 				return -1
-			case 72:
+			case 73:
 				return -1
 			case 75:
 				return -1
-			case 76:
+			case 77:
 				return -1
-			case 79:
+			case 78:
 				return -1
 			case 80:
 				return -1
-			case 82:
+			case 83:
 				return -1
 			case 84:
 				return -1
@@ -14195,26 +25067,24 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 65:
-				return -1
-			case 66:
+			case 68:
 				return -1
-			case 67:
+			case 69:
 				return -1
-			case 68:
+			case 71:
 				return -1
-			case 72:
+			case 73:
 				return -1
 			case 75:
 				return -1
-			case 76:
+			case 77:
 				return -1
-			case 79:
+			case 78:
 				return -1
 			case 80:
 				return -1
-			case 82:
-				return 3
+			case 83:
+				return 9
 			case 84:
 				return -1
 			}
@@ -14226,56 +25096,52 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 65:
-				return -1
-			case 66:
-				return -1
-			case 67:
-				return -1
 			case 68:
 				return -1
-			case 72:
+			case 69:
+				return 10
+			case 71:
+				return -1
+			case 73:
 				return -1
 			case 75:
 				return -1
-			case 76:
+			case 77:
 				return -1
-			case 79:
+			case 78:
 				return -1
 			case 80:
 				return -1
-			case 82:
+			case 83:
 				return -1
 			case 84:
-				return 4
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
 			case 45:
-				return 5
-			case 61:
 				return -1
-			case 65:
-				return -1
-			case 66:
-				return -1
-			case 67:
+			case 61:
 				return -1
 			case 68:
 				return -1
-			case 72:
+			case 69:
+				return -1
+			case 71:
+				return 11
+			case 73:
 				return -1
 			case 75:
 				return -1
-			case 76:
+			case 77:
 				return -1
-			case 79:
+			case 78:
 				return -1
 			case 80:
 				return -1
-			case 82:
+			case 83:
 				return -1
 			case 84:
 				return -1
@@ -14288,25 +25154,23 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 65:
+			case 68:
 				return -1
-			case 66:
+			case 69:
 				return -1
-			case 67:
+			case 71:
 				return -1
-			case 68:
+			case 73:
 				return -1
-			case 72:
-				return 6
 			case 75:
 				return -1
-			case 76:
-				return -1
-			case 79:
+			case 77:
+				return 12
+			case 78:
 				return -1
 			case 80:
 				return -1
-			case 82:
+			case 83:
 				return -1
 			case 84:
 				return -1
@@ -14319,25 +25183,23 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 65:
-				return -1
-			case 66:
-				return -1
-			case 67:
-				return -1
 			case 68:
 				return -1
-			case 72:
+			case 69:
+				return 13
+			case 71:
+				return -1
+			case 73:
 				return -1
 			case 75:
 				return -1
-			case 76:
+			case 77:
+				return -1
+			case 78:
 				return -1
-			case 79:
-				return 7
 			case 80:
 				return -1
-			case 82:
+			case 83:
 				return -1
 			case 84:
 				return -1
@@ -14350,25 +25212,23 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 65:
-				return -1
-			case 66:
+			case 68:
 				return -1
-			case 67:
+			case 69:
 				return -1
-			case 68:
+			case 71:
 				return -1
-			case 72:
+			case 73:
 				return -1
 			case 75:
 				return -1
-			case 76:
-				return 8
-			case 79:
+			case 77:
 				return -1
+			case 78:
+				return 14
 			case 80:
 				return -1
-			case 82:
+			case 83:
 				return -1
 			case 84:
 				return -1
@@ -14381,57 +25241,53 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 65:
+			case 68:
 				return -1
-			case 66:
+			case 69:
 				return -1
-			case 67:
+			case 71:
 				return -1
-			case 68:
-				return 9
-			case 72:
+			case 73:
 				return -1
 			case 75:
 				return -1
-			case 76:
+			case 77:
 				return -1
-			case 79:
+			case 78:
 				return -1
 			case 80:
 				return -1
-			case 82:
+			case 83:
 				return -1
 			case 84:
-				return -1
+				return 15
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
 			case 45:
-				return 10
-			case 61:
 				return -1
-			case 65:
+			case 61:
 				return -1
-			case 66:
+			case 68:
 				return -1
-			case 67:
+			case 69:
 				return -1
-			case 68:
+			case 71:
 				return -1
-			case 72:
+			case 73:
 				return -1
 			case 75:
 				return -1
-			case 76:
+			case 77:
 				return -1
-			case 79:
+			case 78:
 				return -1
 			case 80:
 				return -1
-			case 82:
-				return -1
+			case 83:
+				return 16
 			case 84:
 				return -1
 			}
@@ -14442,26 +25298,24 @@ var dfas = []dfa{
 			case 45:
 				return -1
 			case 61:
+				return 17
+			case 68:
 				return -1
-			case 65:
-				return -1
-			case 66:
-				return 11
-			case 67:
+			case 69:
 				return -1
-			case 68:
+			case 71:
 				return -1
-			case 72:
+			case 73:
 				return -1
 			case 75:
 				return -1
-			case 76:
+			case 77:
 				return -1
-			case 79:
+			case 78:
 				return -1
 			case 80:
 				return -1
-			case 82:
+			case 83:
 				return -1
 			case 84:
 				return -1
@@ -14474,301 +25328,281 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 65:
-				return 12
-			case 66:
+			case 68:
 				return -1
-			case 67:
+			case 69:
 				return -1
-			case 68:
+			case 71:
 				return -1
-			case 72:
+			case 73:
 				return -1
 			case 75:
 				return -1
-			case 76:
+			case 77:
 				return -1
-			case 79:
+			case 78:
 				return -1
 			case 80:
 				return -1
-			case 82:
+			case 83:
 				return -1
 			case 84:
 				return -1
 			}
 			return -1
 		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// DURATION=
+	{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
-			case 66:
-				return -1
-			case 67:
-				return 13
 			case 68:
+				return 1
+			case 73:
 				return -1
-			case 72:
-				return -1
-			case 75:
-				return -1
-			case 76:
+			case 78:
 				return -1
 			case 79:
 				return -1
-			case 80:
-				return -1
 			case 82:
 				return -1
 			case 84:
 				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
-			case 66:
-				return -1
-			case 67:
-				return -1
 			case 68:
 				return -1
-			case 72:
+			case 73:
 				return -1
-			case 75:
-				return 14
-			case 76:
+			case 78:
 				return -1
 			case 79:
 				return -1
-			case 80:
-				return -1
 			case 82:
 				return -1
 			case 84:
 				return -1
+			case 85:
+				return 2
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
-				return 15
-			case 65:
 				return -1
-			case 66:
-				return -1
-			case 67:
+			case 65:
 				return -1
 			case 68:
 				return -1
-			case 72:
-				return -1
-			case 75:
+			case 73:
 				return -1
-			case 76:
+			case 78:
 				return -1
 			case 79:
 				return -1
-			case 80:
-				return -1
 			case 82:
-				return -1
+				return 3
 			case 84:
 				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
 			case 65:
-				return -1
-			case 66:
-				return -1
-			case 67:
-				return -1
+				return 4
 			case 68:
 				return -1
-			case 72:
-				return -1
-			case 75:
+			case 73:
 				return -1
-			case 76:
+			case 78:
 				return -1
 			case 79:
 				return -1
-			case 80:
-				return -1
 			case 82:
 				return -1
 			case 84:
 				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-	// PART-TARGET=
-	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
-			case 65:
+			case 65:
+				return -1
+			case 68:
+				return -1
+			case 73:
 				return -1
-			case 69:
+			case 78:
 				return -1
-			case 71:
+			case 79:
 				return -1
-			case 80:
-				return 1
 			case 82:
 				return -1
 			case 84:
+				return 5
+			case 85:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
 			case 65:
-				return 2
-			case 69:
 				return -1
-			case 71:
+			case 68:
 				return -1
-			case 80:
+			case 73:
+				return 6
+			case 78:
+				return -1
+			case 79:
 				return -1
 			case 82:
 				return -1
 			case 84:
 				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
-			case 69:
+			case 68:
 				return -1
-			case 71:
+			case 73:
 				return -1
-			case 80:
+			case 78:
 				return -1
+			case 79:
+				return 7
 			case 82:
-				return 3
+				return -1
 			case 84:
 				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
-			case 69:
+			case 68:
 				return -1
-			case 71:
+			case 73:
 				return -1
-			case 80:
+			case 78:
+				return 8
+			case 79:
 				return -1
 			case 82:
 				return -1
 			case 84:
-				return 4
+				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return 5
 			case 61:
-				return -1
+				return 9
 			case 65:
 				return -1
-			case 69:
+			case 68:
 				return -1
-			case 71:
+			case 73:
 				return -1
-			case 80:
+			case 78:
+				return -1
+			case 79:
 				return -1
 			case 82:
 				return -1
 			case 84:
 				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
-			case 69:
+			case 68:
 				return -1
-			case 71:
+			case 73:
 				return -1
-			case 80:
+			case 78:
+				return -1
+			case 79:
 				return -1
 			case 82:
 				return -1
 			case 84:
-				return 6
+				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// INDEPENDENT=
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
-			case 65:
-				return 7
+			case 68:
+				return -1
 			case 69:
 				return -1
-			case 71:
+			case 73:
+				return 1
+			case 78:
 				return -1
 			case 80:
 				return -1
-			case 82:
-				return -1
 			case 84:
 				return -1
 			}
@@ -14776,20 +25610,18 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
-			case 65:
+			case 68:
 				return -1
 			case 69:
 				return -1
-			case 71:
+			case 73:
 				return -1
+			case 78:
+				return 2
 			case 80:
 				return -1
-			case 82:
-				return 8
 			case 84:
 				return -1
 			}
@@ -14797,19 +25629,17 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
-			case 65:
-				return -1
+			case 68:
+				return 3
 			case 69:
 				return -1
-			case 71:
-				return 9
-			case 80:
+			case 73:
 				return -1
-			case 82:
+			case 78:
+				return -1
+			case 80:
 				return -1
 			case 84:
 				return -1
@@ -14818,19 +25648,17 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
-			case 65:
+			case 68:
 				return -1
 			case 69:
-				return 10
-			case 71:
+				return 4
+			case 73:
 				return -1
-			case 80:
+			case 78:
 				return -1
-			case 82:
+			case 80:
 				return -1
 			case 84:
 				return -1
@@ -14839,41 +25667,37 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
-			case 65:
+			case 68:
 				return -1
 			case 69:
 				return -1
-			case 71:
-				return -1
-			case 80:
+			case 73:
 				return -1
-			case 82:
+			case 78:
 				return -1
+			case 80:
+				return 5
 			case 84:
-				return 11
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
-				return 12
-			case 65:
+				return -1
+			case 68:
 				return -1
 			case 69:
+				return 6
+			case 73:
 				return -1
-			case 71:
+			case 78:
 				return -1
 			case 80:
 				return -1
-			case 82:
-				return -1
 			case 84:
 				return -1
 			}
@@ -14881,53 +25705,37 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
-			case 65:
+			case 68:
 				return -1
 			case 69:
 				return -1
-			case 71:
+			case 73:
 				return -1
+			case 78:
+				return 7
 			case 80:
 				return -1
-			case 82:
-				return -1
 			case 84:
 				return -1
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-	// SKIPPED-SEGMENTS=
-	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
 			case 68:
-				return -1
+				return 8
 			case 69:
 				return -1
-			case 71:
-				return -1
 			case 73:
 				return -1
-			case 75:
-				return -1
-			case 77:
-				return -1
 			case 78:
 				return -1
 			case 80:
 				return -1
-			case 83:
-				return 1
 			case 84:
 				return -1
 			}
@@ -14935,28 +25743,18 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
 			case 68:
 				return -1
 			case 69:
-				return -1
-			case 71:
-				return -1
+				return 9
 			case 73:
 				return -1
-			case 75:
-				return 2
-			case 77:
-				return -1
 			case 78:
 				return -1
 			case 80:
 				return -1
-			case 83:
-				return -1
 			case 84:
 				return -1
 			}
@@ -14964,28 +25762,18 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
 			case 68:
 				return -1
 			case 69:
 				return -1
-			case 71:
-				return -1
 			case 73:
-				return 3
-			case 75:
-				return -1
-			case 77:
 				return -1
 			case 78:
-				return -1
+				return 10
 			case 80:
 				return -1
-			case 83:
-				return -1
 			case 84:
 				return -1
 			}
@@ -14993,56 +25781,36 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
 			case 68:
 				return -1
 			case 69:
 				return -1
-			case 71:
-				return -1
 			case 73:
 				return -1
-			case 75:
-				return -1
-			case 77:
-				return -1
 			case 78:
 				return -1
 			case 80:
-				return 4
-			case 83:
 				return -1
 			case 84:
-				return -1
+				return 11
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
-				return -1
+				return 12
 			case 68:
 				return -1
 			case 69:
 				return -1
-			case 71:
-				return -1
 			case 73:
 				return -1
-			case 75:
-				return -1
-			case 77:
-				return -1
 			case 78:
 				return -1
 			case 80:
-				return 5
-			case 83:
 				return -1
 			case 84:
 				return -1
@@ -15051,55 +25819,41 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
-			case 45:
-				return -1
 			case 61:
 				return -1
 			case 68:
 				return -1
 			case 69:
-				return 6
-			case 71:
 				return -1
 			case 73:
 				return -1
-			case 75:
-				return -1
-			case 77:
-				return -1
 			case 78:
 				return -1
 			case 80:
 				return -1
-			case 83:
-				return -1
 			case 84:
 				return -1
 			}
 			return -1
 		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// LAST-MSN=
+	{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
-			switch r {
-			case 45:
-				return -1
-			case 61:
-				return -1
-			case 68:
-				return 7
-			case 69:
-				return -1
-			case 71:
+			switch r {
+			case 45:
 				return -1
-			case 73:
+			case 61:
 				return -1
-			case 75:
+			case 65:
 				return -1
+			case 76:
+				return 1
 			case 77:
 				return -1
 			case 78:
 				return -1
-			case 80:
-				return -1
 			case 83:
 				return -1
 			case 84:
@@ -15110,25 +25864,17 @@ var dfas = []dfa{
 		func(r rune) int {
 			switch r {
 			case 45:
-				return 8
-			case 61:
-				return -1
-			case 68:
-				return -1
-			case 69:
 				return -1
-			case 71:
-				return -1
-			case 73:
+			case 61:
 				return -1
-			case 75:
+			case 65:
+				return 2
+			case 76:
 				return -1
 			case 77:
 				return -1
 			case 78:
 				return -1
-			case 80:
-				return -1
 			case 83:
 				return -1
 			case 84:
@@ -15142,24 +25888,16 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 68:
-				return -1
-			case 69:
-				return -1
-			case 71:
-				return -1
-			case 73:
+			case 65:
 				return -1
-			case 75:
+			case 76:
 				return -1
 			case 77:
 				return -1
 			case 78:
 				return -1
-			case 80:
-				return -1
 			case 83:
-				return 9
+				return 3
 			case 84:
 				return -1
 			}
@@ -15171,51 +25909,35 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 68:
-				return -1
-			case 69:
-				return 10
-			case 71:
-				return -1
-			case 73:
+			case 65:
 				return -1
-			case 75:
+			case 76:
 				return -1
 			case 77:
 				return -1
 			case 78:
 				return -1
-			case 80:
-				return -1
 			case 83:
 				return -1
 			case 84:
-				return -1
+				return 4
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
 			case 45:
-				return -1
+				return 5
 			case 61:
 				return -1
-			case 68:
-				return -1
-			case 69:
-				return -1
-			case 71:
-				return 11
-			case 73:
+			case 65:
 				return -1
-			case 75:
+			case 76:
 				return -1
 			case 77:
 				return -1
 			case 78:
 				return -1
-			case 80:
-				return -1
 			case 83:
 				return -1
 			case 84:
@@ -15229,22 +25951,14 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 68:
-				return -1
-			case 69:
-				return -1
-			case 71:
-				return -1
-			case 73:
+			case 65:
 				return -1
-			case 75:
+			case 76:
 				return -1
 			case 77:
-				return 12
+				return 6
 			case 78:
 				return -1
-			case 80:
-				return -1
 			case 83:
 				return -1
 			case 84:
@@ -15258,24 +25972,16 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 68:
-				return -1
-			case 69:
-				return 13
-			case 71:
-				return -1
-			case 73:
+			case 65:
 				return -1
-			case 75:
+			case 76:
 				return -1
 			case 77:
 				return -1
 			case 78:
 				return -1
-			case 80:
-				return -1
 			case 83:
-				return -1
+				return 7
 			case 84:
 				return -1
 			}
@@ -15287,22 +25993,14 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 68:
-				return -1
-			case 69:
-				return -1
-			case 71:
-				return -1
-			case 73:
+			case 65:
 				return -1
-			case 75:
+			case 76:
 				return -1
 			case 77:
 				return -1
 			case 78:
-				return 14
-			case 80:
-				return -1
+				return 8
 			case 83:
 				return -1
 			case 84:
@@ -15315,27 +26013,19 @@ var dfas = []dfa{
 			case 45:
 				return -1
 			case 61:
+				return 9
+			case 65:
 				return -1
-			case 68:
-				return -1
-			case 69:
-				return -1
-			case 71:
-				return -1
-			case 73:
-				return -1
-			case 75:
+			case 76:
 				return -1
 			case 77:
 				return -1
 			case 78:
 				return -1
-			case 80:
-				return -1
 			case 83:
 				return -1
 			case 84:
-				return 15
+				return -1
 			}
 			return -1
 		},
@@ -15345,51 +26035,39 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 68:
-				return -1
-			case 69:
-				return -1
-			case 71:
-				return -1
-			case 73:
+			case 65:
 				return -1
-			case 75:
+			case 76:
 				return -1
 			case 77:
 				return -1
 			case 78:
 				return -1
-			case 80:
-				return -1
 			case 83:
-				return 16
+				return -1
 			case 84:
 				return -1
 			}
 			return -1
 		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// LAST-PART=
+	{[]bool{false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 45:
 				return -1
 			case 61:
-				return 17
-			case 68:
-				return -1
-			case 69:
-				return -1
-			case 71:
-				return -1
-			case 73:
 				return -1
-			case 75:
-				return -1
-			case 77:
-				return -1
-			case 78:
+			case 65:
 				return -1
+			case 76:
+				return 1
 			case 80:
 				return -1
+			case 82:
+				return -1
 			case 83:
 				return -1
 			case 84:
@@ -15403,22 +26081,14 @@ var dfas = []dfa{
 				return -1
 			case 61:
 				return -1
-			case 68:
-				return -1
-			case 69:
-				return -1
-			case 71:
-				return -1
-			case 73:
-				return -1
-			case 75:
-				return -1
-			case 77:
-				return -1
-			case 78:
+			case 65:
+				return 2
+			case 76:
 				return -1
 			case 80:
 				return -1
+			case 82:
+				return -1
 			case 83:
 				return -1
 			case 84:
@@ -15426,257 +26096,243 @@ var dfas = []dfa{
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-	// DURATION=
-	{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
-			case 68:
-				return 1
-			case 73:
-				return -1
-			case 78:
+			case 76:
 				return -1
-			case 79:
+			case 80:
 				return -1
 			case 82:
 				return -1
+			case 83:
+				return 3
 			case 84:
 				return -1
-			case 85:
-				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
-			case 68:
-				return -1
-			case 73:
-				return -1
-			case 78:
+			case 76:
 				return -1
-			case 79:
+			case 80:
 				return -1
 			case 82:
 				return -1
-			case 84:
+			case 83:
 				return -1
-			case 85:
-				return 2
+			case 84:
+				return 4
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return 5
 			case 61:
 				return -1
 			case 65:
 				return -1
-			case 68:
+			case 76:
 				return -1
-			case 73:
+			case 80:
 				return -1
-			case 78:
+			case 82:
 				return -1
-			case 79:
+			case 83:
 				return -1
-			case 82:
-				return 3
 			case 84:
 				return -1
-			case 85:
-				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
-				return 4
-			case 68:
 				return -1
-			case 73:
-				return -1
-			case 78:
-				return -1
-			case 79:
+			case 76:
 				return -1
+			case 80:
+				return 6
 			case 82:
 				return -1
-			case 84:
+			case 83:
 				return -1
-			case 85:
+			case 84:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
+				return 7
+			case 76:
 				return -1
-			case 68:
-				return -1
-			case 73:
-				return -1
-			case 78:
-				return -1
-			case 79:
+			case 80:
 				return -1
 			case 82:
 				return -1
+			case 83:
+				return -1
 			case 84:
-				return 5
-			case 85:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
-			case 68:
-				return -1
-			case 73:
-				return 6
-			case 78:
+			case 76:
 				return -1
-			case 79:
+			case 80:
 				return -1
 			case 82:
+				return 8
+			case 83:
 				return -1
 			case 84:
 				return -1
-			case 85:
-				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
 			case 61:
 				return -1
 			case 65:
 				return -1
-			case 68:
-				return -1
-			case 73:
+			case 76:
 				return -1
-			case 78:
+			case 80:
 				return -1
-			case 79:
-				return 7
 			case 82:
 				return -1
-			case 84:
-				return -1
-			case 85:
+			case 83:
 				return -1
+			case 84:
+				return 9
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 61:
+			case 45:
 				return -1
+			case 61:
+				return 10
 			case 65:
 				return -1
-			case 68:
-				return -1
-			case 73:
+			case 76:
 				return -1
-			case 78:
-				return 8
-			case 79:
+			case 80:
 				return -1
 			case 82:
 				return -1
-			case 84:
+			case 83:
 				return -1
-			case 85:
+			case 84:
 				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 61:
-				return 9
-			case 65:
+			case 45:
 				return -1
-			case 68:
+			case 61:
 				return -1
-			case 73:
+			case 65:
 				return -1
-			case 78:
+			case 76:
 				return -1
-			case 79:
+			case 80:
 				return -1
 			case 82:
 				return -1
-			case 84:
+			case 83:
 				return -1
-			case 85:
+			case 84:
 				return -1
 			}
 			return -1
 		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// SCTE35-CMD=
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
-			case 61:
+			case 45:
 				return -1
-			case 65:
+			case 51:
 				return -1
-			case 68:
+			case 53:
 				return -1
-			case 73:
+			case 61:
 				return -1
-			case 78:
+			case 67:
 				return -1
-			case 79:
+			case 68:
 				return -1
-			case 82:
+			case 69:
 				return -1
-			case 84:
+			case 77:
 				return -1
-			case 85:
+			case 83:
+				return 1
+			case 84:
 				return -1
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-	// INDEPENDENT=
-	{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
+			case 51:
+				return -1
+			case 53:
+				return -1
 			case 61:
 				return -1
+			case 67:
+				return 2
 			case 68:
 				return -1
 			case 69:
 				return -1
-			case 73:
-				return 1
-			case 78:
+			case 77:
 				return -1
-			case 80:
+			case 83:
 				return -1
 			case 84:
 				return -1
@@ -15685,36 +26341,48 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
+			case 51:
+				return -1
+			case 53:
+				return -1
 			case 61:
 				return -1
+			case 67:
+				return -1
 			case 68:
 				return -1
 			case 69:
 				return -1
-			case 73:
+			case 77:
 				return -1
-			case 78:
-				return 2
-			case 80:
+			case 83:
 				return -1
 			case 84:
-				return -1
+				return 3
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
+			case 51:
+				return -1
+			case 53:
+				return -1
 			case 61:
 				return -1
-			case 68:
-				return 3
-			case 69:
+			case 67:
 				return -1
-			case 73:
+			case 68:
 				return -1
-			case 78:
+			case 69:
+				return 4
+			case 77:
 				return -1
-			case 80:
+			case 83:
 				return -1
 			case 84:
 				return -1
@@ -15723,17 +26391,23 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
+			case 51:
+				return 5
+			case 53:
+				return -1
 			case 61:
 				return -1
+			case 67:
+				return -1
 			case 68:
 				return -1
 			case 69:
-				return 4
-			case 73:
 				return -1
-			case 78:
+			case 77:
 				return -1
-			case 80:
+			case 83:
 				return -1
 			case 84:
 				return -1
@@ -15742,18 +26416,24 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
+			case 51:
+				return -1
+			case 53:
+				return 6
 			case 61:
 				return -1
+			case 67:
+				return -1
 			case 68:
 				return -1
 			case 69:
 				return -1
-			case 73:
+			case 77:
 				return -1
-			case 78:
+			case 83:
 				return -1
-			case 80:
-				return 5
 			case 84:
 				return -1
 			}
@@ -15761,17 +26441,23 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return 7
+			case 51:
+				return -1
+			case 53:
+				return -1
 			case 61:
 				return -1
+			case 67:
+				return -1
 			case 68:
 				return -1
 			case 69:
-				return 6
-			case 73:
 				return -1
-			case 78:
+			case 77:
 				return -1
-			case 80:
+			case 83:
 				return -1
 			case 84:
 				return -1
@@ -15780,17 +26466,23 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
+			case 51:
+				return -1
+			case 53:
+				return -1
 			case 61:
 				return -1
+			case 67:
+				return 8
 			case 68:
 				return -1
 			case 69:
 				return -1
-			case 73:
+			case 77:
 				return -1
-			case 78:
-				return 7
-			case 80:
+			case 83:
 				return -1
 			case 84:
 				return -1
@@ -15799,17 +26491,23 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
+			case 51:
+				return -1
+			case 53:
+				return -1
 			case 61:
 				return -1
-			case 68:
-				return 8
-			case 69:
+			case 67:
 				return -1
-			case 73:
+			case 68:
 				return -1
-			case 78:
+			case 69:
 				return -1
-			case 80:
+			case 77:
+				return 9
+			case 83:
 				return -1
 			case 84:
 				return -1
@@ -15818,17 +26516,23 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
+			case 51:
+				return -1
+			case 53:
+				return -1
 			case 61:
 				return -1
-			case 68:
+			case 67:
 				return -1
+			case 68:
+				return 10
 			case 69:
-				return 9
-			case 73:
 				return -1
-			case 78:
+			case 77:
 				return -1
-			case 80:
+			case 83:
 				return -1
 			case 84:
 				return -1
@@ -15837,17 +26541,23 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
+			case 51:
+				return -1
+			case 53:
+				return -1
 			case 61:
+				return 11
+			case 67:
 				return -1
 			case 68:
 				return -1
 			case 69:
 				return -1
-			case 73:
+			case 77:
 				return -1
-			case 78:
-				return 10
-			case 80:
+			case 83:
 				return -1
 			case 84:
 				return -1
@@ -15856,82 +26566,104 @@ var dfas = []dfa{
 		},
 		func(r rune) int {
 			switch r {
+			case 45:
+				return -1
+			case 51:
+				return -1
+			case 53:
+				return -1
 			case 61:
 				return -1
+			case 67:
+				return -1
 			case 68:
 				return -1
 			case 69:
 				return -1
-			case 73:
-				return -1
-			case 78:
+			case 77:
 				return -1
-			case 80:
+			case 83:
 				return -1
 			case 84:
-				return 11
+				return -1
 			}
 			return -1
 		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+	// SCTE35-OUT=
+	{[]bool{false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
-			switch r {
-			case 61:
-				return 12
-			case 68:
+			switch r {
+			case 45:
 				return -1
-			case 69:
+			case 51:
 				return -1
-			case 73:
+			case 53:
 				return -1
-			case 78:
+			case 61:
 				return -1
-			case 80:
+			case 67:
+				return -1
+			case 69:
+				return -1
+			case 79:
 				return -1
+			case 83:
+				return 1
 			case 84:
 				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
-			case 61:
+			case 45:
 				return -1
-			case 68:
+			case 51:
 				return -1
-			case 69:
+			case 53:
 				return -1
-			case 73:
+			case 61:
 				return -1
-			case 78:
+			case 67:
+				return 2
+			case 69:
 				return -1
-			case 80:
+			case 79:
+				return -1
+			case 83:
 				return -1
 			case 84:
 				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-	// LAST-MSN=
-	{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 45:
 				return -1
+			case 51:
+				return -1
+			case 53:
+				return -1
 			case 61:
 				return -1
-			case 65:
+			case 67:
 				return -1
-			case 76:
-				return 1
-			case 77:
+			case 69:
 				return -1
-			case 78:
+			case 79:
 				return -1
 			case 83:
 				return -1
 			case 84:
+				return 3
+			case 85:
 				return -1
 			}
 			return -1
@@ -15940,20 +26672,24 @@ var dfas = []dfa{
 			switch r {
 			case 45:
 				return -1
-			case 61:
+			case 51:
 				return -1
-			case 65:
-				return 2
-			case 76:
+			case 53:
 				return -1
-			case 77:
+			case 61:
 				return -1
-			case 78:
+			case 67:
+				return -1
+			case 69:
+				return 4
+			case 79:
 				return -1
 			case 83:
 				return -1
 			case 84:
 				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
@@ -15961,20 +26697,24 @@ var dfas = []dfa{
 			switch r {
 			case 45:
 				return -1
-			case 61:
+			case 51:
+				return 5
+			case 53:
 				return -1
-			case 65:
+			case 61:
 				return -1
-			case 76:
+			case 67:
 				return -1
-			case 77:
+			case 69:
 				return -1
-			case 78:
+			case 79:
 				return -1
 			case 83:
-				return 3
+				return -1
 			case 84:
 				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
@@ -15982,41 +26722,49 @@ var dfas = []dfa{
 			switch r {
 			case 45:
 				return -1
-			case 61:
+			case 51:
 				return -1
-			case 65:
+			case 53:
+				return 6
+			case 61:
 				return -1
-			case 76:
+			case 67:
 				return -1
-			case 77:
+			case 69:
 				return -1
-			case 78:
+			case 79:
 				return -1
 			case 83:
 				return -1
 			case 84:
-				return 4
+				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
 			case 45:
-				return 5
-			case 61:
+				return 7
+			case 51:
 				return -1
-			case 65:
+			case 53:
 				return -1
-			case 76:
+			case 61:
 				return -1
-			case 77:
+			case 67:
 				return -1
-			case 78:
+			case 69:
+				return -1
+			case 79:
 				return -1
 			case 83:
 				return -1
 			case 84:
 				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
@@ -16024,20 +26772,24 @@ var dfas = []dfa{
 			switch r {
 			case 45:
 				return -1
-			case 61:
+			case 51:
 				return -1
-			case 65:
+			case 53:
 				return -1
-			case 76:
+			case 61:
 				return -1
-			case 77:
-				return 6
-			case 78:
+			case 67:
 				return -1
+			case 69:
+				return -1
+			case 79:
+				return 8
 			case 83:
 				return -1
 			case 84:
 				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
@@ -16045,20 +26797,24 @@ var dfas = []dfa{
 			switch r {
 			case 45:
 				return -1
-			case 61:
+			case 51:
 				return -1
-			case 65:
+			case 53:
 				return -1
-			case 76:
+			case 61:
 				return -1
-			case 77:
+			case 67:
 				return -1
-			case 78:
+			case 69:
+				return -1
+			case 79:
 				return -1
 			case 83:
-				return 7
+				return -1
 			case 84:
 				return -1
+			case 85:
+				return 9
 			}
 			return -1
 		},
@@ -16066,19 +26822,23 @@ var dfas = []dfa{
 			switch r {
 			case 45:
 				return -1
+			case 51:
+				return -1
+			case 53:
+				return -1
 			case 61:
 				return -1
-			case 65:
+			case 67:
 				return -1
-			case 76:
+			case 69:
 				return -1
-			case 77:
+			case 79:
 				return -1
-			case 78:
-				return 8
 			case 83:
 				return -1
 			case 84:
+				return 10
+			case 85:
 				return -1
 			}
 			return -1
@@ -16087,20 +26847,24 @@ var dfas = []dfa{
 			switch r {
 			case 45:
 				return -1
-			case 61:
-				return 9
-			case 65:
+			case 51:
 				return -1
-			case 76:
+			case 53:
 				return -1
-			case 77:
+			case 61:
+				return 11
+			case 67:
 				return -1
-			case 78:
+			case 69:
+				return -1
+			case 79:
 				return -1
 			case 83:
 				return -1
 			case 84:
 				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
@@ -16108,43 +26872,51 @@ var dfas = []dfa{
 			switch r {
 			case 45:
 				return -1
-			case 61:
+			case 51:
 				return -1
-			case 65:
+			case 53:
 				return -1
-			case 76:
+			case 61:
 				return -1
-			case 77:
+			case 67:
 				return -1
-			case 78:
+			case 69:
+				return -1
+			case 79:
 				return -1
 			case 83:
 				return -1
 			case 84:
 				return -1
+			case 85:
+				return -1
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
 
-	// LAST-PART=
+	// SCTE35-IN=
 	{[]bool{false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 45:
 				return -1
+			case 51:
+				return -1
+			case 53:
+				return -1
 			case 61:
 				return -1
-			case 65:
+			case 67:
 				return -1
-			case 76:
-				return 1
-			case 80:
+			case 69:
 				return -1
-			case 82:
+			case 73:
 				return -1
-			case 83:
+			case 78:
 				return -1
+			case 83:
+				return 1
 			case 84:
 				return -1
 			}
@@ -16154,15 +26926,19 @@ var dfas = []dfa{
 			switch r {
 			case 45:
 				return -1
+			case 51:
+				return -1
+			case 53:
+				return -1
 			case 61:
 				return -1
-			case 65:
+			case 67:
 				return 2
-			case 76:
+			case 69:
 				return -1
-			case 80:
+			case 73:
 				return -1
-			case 82:
+			case 78:
 				return -1
 			case 83:
 				return -1
@@ -16175,20 +26951,24 @@ var dfas = []dfa{
 			switch r {
 			case 45:
 				return -1
+			case 51:
+				return -1
+			case 53:
+				return -1
 			case 61:
 				return -1
-			case 65:
+			case 67:
 				return -1
-			case 76:
+			case 69:
 				return -1
-			case 80:
+			case 73:
 				return -1
-			case 82:
+			case 78:
 				return -1
 			case 83:
-				return 3
-			case 84:
 				return -1
+			case 84:
+				return 3
 			}
 			return -1
 		},
@@ -16196,36 +26976,44 @@ var dfas = []dfa{
 			switch r {
 			case 45:
 				return -1
-			case 61:
+			case 51:
 				return -1
-			case 65:
+			case 53:
 				return -1
-			case 76:
+			case 61:
 				return -1
-			case 80:
+			case 67:
 				return -1
-			case 82:
+			case 69:
+				return 4
+			case 73:
+				return -1
+			case 78:
 				return -1
 			case 83:
 				return -1
 			case 84:
-				return 4
+				return -1
 			}
 			return -1
 		},
 		func(r rune) int {
 			switch r {
 			case 45:
+				return -1
+			case 51:
 				return 5
+			case 53:
+				return -1
 			case 61:
 				return -1
-			case 65:
+			case 67:
 				return -1
-			case 76:
+			case 69:
 				return -1
-			case 80:
+			case 73:
 				return -1
-			case 82:
+			case 78:
 				return -1
 			case 83:
 				return -1
@@ -16238,15 +27026,19 @@ var dfas = []dfa{
 			switch r {
 			case 45:
 				return -1
+			case 51:
+				return -1
+			case 53:
+				return 6
 			case 61:
 				return -1
-			case 65:
+			case 67:
+				return -1
+			case 69:
 				return -1
-			case 76:
+			case 73:
 				return -1
-			case 80:
-				return 6
-			case 82:
+			case 78:
 				return -1
 			case 83:
 				return -1
@@ -16258,16 +27050,20 @@ var dfas = []dfa{
 		func(r rune) int {
 			switch r {
 			case 45:
+				return 7
+			case 51:
+				return -1
+			case 53:
 				return -1
 			case 61:
 				return -1
-			case 65:
-				return 7
-			case 76:
+			case 67:
 				return -1
-			case 80:
+			case 69:
 				return -1
-			case 82:
+			case 73:
+				return -1
+			case 78:
 				return -1
 			case 83:
 				return -1
@@ -16280,16 +27076,20 @@ var dfas = []dfa{
 			switch r {
 			case 45:
 				return -1
-			case 61:
+			case 51:
 				return -1
-			case 65:
+			case 53:
 				return -1
-			case 76:
+			case 61:
 				return -1
-			case 80:
+			case 67:
 				return -1
-			case 82:
+			case 69:
+				return -1
+			case 73:
 				return 8
+			case 78:
+				return -1
 			case 83:
 				return -1
 			case 84:
@@ -16301,20 +27101,24 @@ var dfas = []dfa{
 			switch r {
 			case 45:
 				return -1
-			case 61:
+			case 51:
 				return -1
-			case 65:
+			case 53:
 				return -1
-			case 76:
+			case 61:
 				return -1
-			case 80:
+			case 67:
 				return -1
-			case 82:
+			case 69:
 				return -1
+			case 73:
+				return -1
+			case 78:
+				return 9
 			case 83:
 				return -1
 			case 84:
-				return 9
+				return -1
 			}
 			return -1
 		},
@@ -16322,15 +27126,19 @@ var dfas = []dfa{
 			switch r {
 			case 45:
 				return -1
+			case 51:
+				return -1
+			case 53:
+				return -1
 			case 61:
 				return 10
-			case 65:
+			case 67:
 				return -1
-			case 76:
+			case 69:
 				return -1
-			case 80:
+			case 73:
 				return -1
-			case 82:
+			case 78:
 				return -1
 			case 83:
 				return -1
@@ -16343,15 +27151,19 @@ var dfas = []dfa{
 			switch r {
 			case 45:
 				return -1
+			case 51:
+				return -1
+			case 53:
+				return -1
 			case 61:
 				return -1
-			case 65:
+			case 67:
 				return -1
-			case 76:
+			case 69:
 				return -1
-			case 80:
+			case 73:
 				return -1
-			case 82:
+			case 78:
 				return -1
 			case 83:
 				return -1
@@ -17366,19 +28178,56 @@ var dfas = []dfa{
 		},
 	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
 
-	// \"[^\"\n\r]+\"
+	// [0-9]+@[0-9]+
 	{[]bool{false, false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
-			case 10:
-				return -1
-			case 13:
+			case 64:
 				return -1
-			case 34:
+			}
+			switch {
+			case 48 <= r && r <= 57:
+				return 1
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 64:
+				return 2
+			}
+			switch {
+			case 48 <= r && r <= 57:
 				return 1
 			}
 			return -1
 		},
+		func(r rune) int {
+			switch r {
+			case 64:
+				return -1
+			}
+			switch {
+			case 48 <= r && r <= 57:
+				return 3
+			}
+			return -1
+		},
+		func(r rune) int {
+			switch r {
+			case 64:
+				return -1
+			}
+			switch {
+			case 48 <= r && r <= 57:
+				return 3
+			}
+			return -1
+		},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
+
+	// \"[^\"\n\r]*\"
+	{[]bool{false, false, true}, []func(rune) int{ // Transitions
 		func(r rune) int {
 			switch r {
 			case 10:
@@ -17386,9 +28235,9 @@ var dfas = []dfa{
 			case 13:
 				return -1
 			case 34:
-				return -1
+				return 1
 			}
-			return 2
+			return -1
 		},
 		func(r rune) int {
 			switch r {
@@ -17397,9 +28246,9 @@ var dfas = []dfa{
 			case 13:
 				return -1
 			case 34:
-				return 3
+				return 2
 			}
-			return 2
+			return 1
 		},
 		func(r rune) int {
 			switch r {
@@ -17412,7 +28261,7 @@ var dfas = []dfa{
 			}
 			return -1
 		},
-	}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
+	}, []int{ /* Start-of-input transitions */ -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1}, nil},
 
 	// [A-Za-z][^\"\n, #=]+
 	{[]bool{false, false, true}, []func(rune) int{ // Transitions
@@ -17620,6 +28469,8 @@ OUTER0:
 			}
 		case 10:
 			{
+				t := yylex.Text()
+				lval.s = t[len("\n#EXTINF:"):]
 				lval.i = tag_EXTINF
 				return lval.i
 			}
@@ -17649,186 +28500,286 @@ OUTER0:
 				return lval.i
 			}
 		case 16:
+			{
+				lval.i = tag_EXT_X_GAP
+				return lval.i
+			}
+		case 17:
+			{
+				lval.i = tag_EXT_X_BITRATE
+				return lval.i
+			}
+		case 18:
+			{
+				lval.i = tag_EXT_X_DEFINE
+				return lval.i
+			}
+		case 19:
+			{
+				lval.i = tag_EXT_X_CONTENT_STEERING
+				return lval.i
+			}
+		case 20:
+			{
+				lval.i = tag_EXT_X_KEY
+				return lval.i
+			}
+		case 21:
+			{
+				lval.i = tag_EXT_X_DATERANGE
+				return lval.i
+			}
+		case 22:
+			{
+				lval.i = tag_EXT_X_SESSION_KEY
+				return lval.i
+			}
+		case 23:
+			{
+				lval.i = tag_EXT_X_SESSION_DATA
+				return lval.i
+			}
+		case 24:
+			{
+				lval.i = tag_EXT_X_START
+				return lval.i
+			}
+		case 25:
+			{
+				lval.i = tag_EXT_X_I_FRAME_STREAM_INF
+				return lval.i
+			}
+		case 26:
+			{
+				lval.i = tag_EXT_X_DISCONTINUITY
+				return lval.i
+			}
+		case 27:
+			{
+				lval.i = tag_EXT_X_ENDLIST
+				return lval.i
+			}
+		case 28:
+			{
+				lval.i = tag_EXT_X_PLAYLIST_TYPE
+				return lval.i
+			}
+		case 29:
+			{
+				lval.i = tag_EXT_X_BYTERANGE
+				return lval.i
+			}
+		case 30:
+			{
+				lval.i = tag_EXT_X_DISCONTINUITY_SEQUENCE
+				return lval.i
+			}
+		case 31:
+			{
+				lval.i = tag_EXT_X_I_FRAMES_ONLY
+				return lval.i
+			}
+		case 32:
 			{
 				t := yylex.Text()
 				lval.s = t[1:]
 				return token_SECONDLINEVALUE
 			}
-		case 17:
+		case 33:
 			{ /* ignore empty line */
 			}
-		case 18:
+		case 34:
 			{ /* ignore #comment lines */
 			}
-		case 19:
+		case 35:
 			{
 				lval.i = token_ATTR_BANDWIDTH
 				return lval.i
 			}
-		case 20:
+		case 36:
 			{
 				lval.i = token_ATTR_AVERAGE_BANDWIDTH
 				return lval.i
 			}
-		case 21:
+		case 37:
 			{
 				lval.i = token_ATTR_RESOLUTION
 				return lval.i
 			}
-		case 22:
+		case 38:
 			{
 				lval.i = token_ATTR_FRAME_RATE
 				return lval.i
 			}
-		case 23:
+		case 39:
 			{
 				lval.i = token_ATTR_CODECS
 				return lval.i
 			}
-		case 24:
+		case 40:
 			{
 				lval.i = token_ATTR_AUDIO
 				return lval.i
 			}
-		case 25:
+		case 41:
 			{
 				lval.i = token_ATTR_TYPE
 				return lval.i
 			}
-		case 26:
+		case 42:
 			{
 				lval.i = token_ATTR_GROUP_ID
 				return lval.i
 			}
-		case 27:
+		case 43:
 			{
 				lval.i = token_ATTR_NAME
 				return lval.i
 			}
-		case 28:
+		case 44:
 			{
 				lval.i = token_ATTR_DEFAULT
 				return lval.i
 			}
-		case 29:
+		case 45:
 			{
 				lval.i = token_ATTR_AUTOSELECT
 				return lval.i
 			}
-		case 30:
+		case 46:
 			{
 				lval.i = token_ATTR_LANGUAGE
 				return lval.i
 			}
-		case 31:
+		case 47:
 			{
 				lval.i = token_ATTR_CHANNELS
 				return lval.i
 			}
-		case 32:
+		case 48:
 			{
 				lval.i = token_ATTR_URI
 				return lval.i
 			}
-		case 33:
+		case 49:
 			{
 				lval.i = token_ATTR_CAN_BLOCK_RELOAD
 				return lval.i
 			}
-		case 34:
+		case 50:
 			{
 				lval.i = token_ATTR_CAN_SKIP_UNTIL
 				return lval.i
 			}
-		case 35:
+		case 51:
 			{
 				lval.i = token_ATTR_PART_HOLD_BACK
 				return lval.i
 			}
-		case 36:
+		case 52:
 			{
 				lval.i = token_ATTR_PART_TARGET
 				return lval.i
 			}
-		case 37:
+		case 53:
 			{
 				lval.i = token_ATTR_SKIPPED_SEGMENTS
 				return lval.i
 			}
-		case 38:
+		case 54:
 			{
 				lval.i = token_ATTR_DURATION
 				return lval.i
 			}
-		case 39:
+		case 55:
 			{
 				lval.i = token_ATTR_INDEPENDENT
 				return lval.i
 			}
-		case 40:
+		case 56:
 			{
 				lval.i = token_ATTR_LAST_MSN
 				return lval.i
 			}
-		case 41:
+		case 57:
 			{
 				lval.i = token_ATTR_LAST_PART
 				return lval.i
 			}
-		case 42:
+		case 58:
+			{
+				lval.s = "SCTE35-CMD"
+				return token_ATTRKEY
+			}
+		case 59:
+			{
+				lval.s = "SCTE35-OUT"
+				return token_ATTRKEY
+			}
+		case 60:
+			{
+				lval.s = "SCTE35-IN"
+				return token_ATTRKEY
+			}
+		case 61:
 			{
 				t := yylex.Text()
 				lval.s = t[0 : len(t)-1]
 				return token_ATTRKEY
 			}
-		case 43:
+		case 62:
 			{
 				lval.t, _ = time.Parse(time.RFC3339Nano, yylex.Text())
 				return token_TIMEVAL
 			}
-		case 44:
+		case 63:
 			{
 				lval.t, _ = time.Parse(time.RFC3339Nano, yylex.Text())
 				return token_TIMEVAL
 			}
-		case 45:
+		case 64:
 			{
 				lval.f, _ = strconv.ParseFloat(yylex.Text(), 64)
 				return token_FLOATVAL
 			}
-		case 46:
+		case 65:
 			{
 				lval.f, _ = strconv.ParseFloat(yylex.Text(), 64)
 				return token_FLOATVAL
 			}
-		case 47:
+		case 66:
 			{
 				lval.r = yylex.Text()
 				return token_RESOLUTIONVAL
 			}
-		case 48:
+		case 67:
 			{
 				lval.i64, _ = strconv.ParseInt(yylex.Text(), 10, 64)
 				return token_INTEGERVAL
 			}
-		case 49:
+		case 68:
 			{
-				lval.i64, _ = strconv.ParseInt(yylex.Text(), 16, 64)
-				return token_INTEGERVAL
+				lval.s = yylex.Text()
+				return token_STRINGVAL
 			}
-		case 50:
+		case 69:
+			{
+				lval.s = yylex.Text()
+				return token_STRINGVAL
+			}
+		case 70:
 			{
 				t := yylex.Text()
-				lval.s = t[1 : len(t)-2]
+				lval.s = t[1 : len(t)-1]
 				return token_STRINGVAL
 			}
-		case 51:
+		case 71:
 			{
 				t := yylex.Text()
 				lval.s = t
 				return token_STRINGVAL
 			}
-		case 52:
+		case 72:
 			{
 				lval.i = token_COMMA
 				return lval.i
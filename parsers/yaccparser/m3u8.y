@@ -12,20 +12,17 @@ func attrTokenToTagId(token int) common.AttrId {
 	return common.AttrId(token - token_ATTR_FIRST - 1)
 }
 
+//getHandler - nil if the lexer isn't set up with a handler, the error is reported to the lexer
 func getHandler(l yyLexer) parsers.M3u8Handler {
-	var lexer *Lexer
-	var ok bool
-	var obj parsers.M3u8Handler
-	lexer, ok = l.(*Lexer)
+	lexer, ok := l.(*Lexer)
 	if !ok {
-		panic("\nunknown lexer")
+		l.Error("unknown lexer")
+		return nil
 	}
-	obj, ok = lexer.parseResult.(parsers.M3u8Handler)
-	if !ok {
-		panic("\nunknown object")
-	}
-	if obj == nil {
-		panic("\nnil object")
+	obj, ok := lexer.parseResult.(parsers.M3u8Handler)
+	if !ok || obj == nil {
+		l.Error("no handler for the entries")
+		return nil
 	}
 	return obj
 }
@@ -67,21 +64,22 @@ Also the m3u8.nex file needs to include the REGEX for the Tag
 %token <i> tag_EXT_X_PRELOAD_HINT
 %token <i> tag_EXT_X_RENDITION_REPORT
 %token <i> tag_EXT_X_MAP
-/*
-TBD
-	"EXT-X-I-FRAME-STREAM-INF",
-	"EXT-X-DISCONTINUITY",
-	"EXT-X-ENDLIST",
-	"EXT-X-PLAYLIST-TYPE",
-	"EXT-X-BYTERANGE",
-	"EXT-X-KEY",
-	"EXT-X-DATERANGE",
-	"EXT-X-DISCONTINUITY-SEQUENCE",
-	"EXT-X-I-FRAMES-ONLY",
-	"EXT-X-SESSION-KEY",
-	"EXT-X-SESSION-DATA",
-	"EXT-X-START",
-*/
+%token <i> tag_EXT_X_I_FRAME_STREAM_INF
+%token <i> tag_EXT_X_DISCONTINUITY
+%token <i> tag_EXT_X_ENDLIST
+%token <i> tag_EXT_X_PLAYLIST_TYPE
+%token <i> tag_EXT_X_BYTERANGE
+%token <i> tag_EXT_X_KEY
+%token <i> tag_EXT_X_DATERANGE
+%token <i> tag_EXT_X_DISCONTINUITY_SEQUENCE
+%token <i> tag_EXT_X_I_FRAMES_ONLY
+%token <i> tag_EXT_X_SESSION_KEY
+%token <i> tag_EXT_X_SESSION_DATA
+%token <i> tag_EXT_X_START
+%token <i> tag_EXT_X_GAP
+%token <i> tag_EXT_X_BITRATE
+%token <i> tag_EXT_X_DEFINE
+%token <i> tag_EXT_X_CONTENT_STEERING
 
 %token <i> token_COMMA
 %token <s> token_SECONDLINEVALUE
@@ -166,8 +164,8 @@ Also the m3u8.nex file needs to include the REGEX for the Attribute
 
 manifest: entries
 
-entries :  entry  { if $$ == nil { $$ = getHandler(yylex) }; $$.PostRecord($1.tag, $1.kvs); $1.clear("ENTRY1") }
-        |  entries entry { if $$ == nil { $$ = getHandler(yylex) }; $$.PostRecord($2.tag, $2.kvs); $2.clear("ENTRY2") }
+entries :  entry  { if $$ == nil { $$ = getHandler(yylex) }; if $$ != nil { if err := $$.PostRecord($1.tag, $1.kvs); err != nil { yylex.Error(err.Error()) } }; $1.clear("ENTRY1") }
+        |  entries entry { if $$ == nil { $$ = getHandler(yylex) }; if $$ != nil { if err := $$.PostRecord($2.tag, $2.kvs); err != nil { yylex.Error(err.Error()) } }; $2.clear("ENTRY2") }
 
 entry : tag_EXTM3U { $$.tag = tokenIdToTagId($1); } 
       | tag_EXT_X_VERSION token_INTEGERVAL { $$.tag = tokenIdToTagId($1); $$.storeKVDebug("EXT_X_VERSION",common.INTUnknownAttr, $2) }
@@ -179,19 +177,34 @@ entry : tag_EXTM3U { $$.tag = tokenIdToTagId($1); }
       | tag_EXT_X_PART_INF ATTRLIST { $$.tag = tokenIdToTagId($1); $$.assignKVPS("EXT_X_PART_INF",$2); $2.clear("EXT_X_PART_INF"); } 
       | tag_EXT_X_MEDIA_SEQUENCE token_INTEGERVAL { $$.tag = tokenIdToTagId($1);  $$.storeKVDebug("EXT_X_MEDIA_SEQUENCE",common.INTUnknownAttr,$2) } 
       | tag_EXT_X_SKIP ATTRLIST { $$.tag = tokenIdToTagId($1); $$.assignKVPS("EXT_X_SKIP",$2); $2.clear("EXT_X_SKIP"); } 
-      | tag_EXTINF token_FLOATVAL token_COMMA token_SECONDLINEVALUE { $$.tag = tokenIdToTagId($1);  $$.storeKVDebug("EXTINF_FL_1",common.INTUnknownAttr,$2) ; $$.storeKVDebug("EXTINF_FL_2",common.M3U8Uri,$4) } 
-      | tag_EXTINF token_INTEGERVAL token_COMMA token_SECONDLINEVALUE { $$.tag = tokenIdToTagId($1);  $$.storeKVDebug("EXTINF_INT_1",common.INTUnknownAttr,float64($2)) ; $$.storeKVDebug("EXTINF_INT_2",common.M3U8Uri,$4) } 
+      | tag_EXTINF token_SECONDLINEVALUE { $$.tag = tokenIdToTagId($1); $$.storeExtInf("EXTINF_1", $<s>1); $$.storeKVDebug("EXTINF_2",common.M3U8Uri,$2) } 
       | tag_EXT_X_PROGRAM_DATE_TIME token_TIMEVAL { $$.tag = tokenIdToTagId($1); $$.storeKVDebug("EXT_X_PROGRAM_DATE_TIME",common.INTUnknownAttr,$2) } 
       | tag_EXT_X_PART ATTRLIST { $$.tag = tokenIdToTagId($1); $$.assignKVPS("EXT_X_PART",$2); $2.clear("EXT_X_PART");   } 
       | tag_EXT_X_PRELOAD_HINT ATTRLIST { $$.tag = tokenIdToTagId($1); $$.assignKVPS("EXT_X_PRELOAD_HINT",$2); $2.clear("EXT_X_PRELOAD_HINT");  } 
       | tag_EXT_X_RENDITION_REPORT ATTRLIST { $$.tag = tokenIdToTagId($1); $$.assignKVPS("EXT_X_RENDITION_REPORT",$2); $2.clear("EXT_X_RENDITION_REPORT");  } 
       | tag_EXT_X_MAP ATTRLIST { $$.tag = tokenIdToTagId($1); $$.assignKVPS("EXT_X_MAP",$2); $2.clear("EXT_X_MAP"); } 
-
-ATTRLIST : ATTRANDVAL { $$.storeKVDebug("ATTRANDVAL_1",$1.k, $1.v) }
-         | ATTRLIST token_COMMA ATTRANDVAL { $1.storeKVDebug("ATTRANDVAL_2",$3.k, $3.v); $$ = $1 } 
-
-ATTRANDVAL : ATTRTOKEN VALUE { $$.k = attrTokenToTagId($1); $$.v=$2 } 
-           | token_ATTRKEY VALUE { $$.k = common.AttrToAttrId[$1]; $$.v=$2 } 
+      | tag_EXT_X_GAP { $$.tag = tokenIdToTagId($1) } 
+      | tag_EXT_X_BITRATE token_INTEGERVAL { $$.tag = tokenIdToTagId($1); $$.storeKVDebug("EXT_X_BITRATE",common.INTUnknownAttr,$2) } 
+      | tag_EXT_X_DEFINE ATTRLIST { $$.tag = tokenIdToTagId($1); $$.assignKVPS("EXT_X_DEFINE",$2); $2.clear("EXT_X_DEFINE"); } 
+      | tag_EXT_X_CONTENT_STEERING ATTRLIST { $$.tag = tokenIdToTagId($1); $$.assignKVPS("EXT_X_CONTENT_STEERING",$2); $2.clear("EXT_X_CONTENT_STEERING"); } 
+      | tag_EXT_X_KEY ATTRLIST { $$.tag = tokenIdToTagId($1); $$.assignKVPS("EXT_X_KEY",$2); $2.clear("EXT_X_KEY"); } 
+      | tag_EXT_X_DATERANGE ATTRLIST { $$.tag = tokenIdToTagId($1); $$.assignKVPS("EXT_X_DATERANGE",$2); $2.clear("EXT_X_DATERANGE"); } 
+      | tag_EXT_X_SESSION_KEY ATTRLIST { $$.tag = tokenIdToTagId($1); $$.assignKVPS("EXT_X_SESSION_KEY",$2); $2.clear("EXT_X_SESSION_KEY"); } 
+      | tag_EXT_X_SESSION_DATA ATTRLIST { $$.tag = tokenIdToTagId($1); $$.assignKVPS("EXT_X_SESSION_DATA",$2); $2.clear("EXT_X_SESSION_DATA"); } 
+      | tag_EXT_X_START ATTRLIST { $$.tag = tokenIdToTagId($1); $$.assignKVPS("EXT_X_START",$2); $2.clear("EXT_X_START"); } 
+      | tag_EXT_X_I_FRAME_STREAM_INF ATTRLIST { $$.tag = tokenIdToTagId($1); $$.assignKVPS("EXT_X_I_FRAME_STREAM_INF",$2); $2.clear("EXT_X_I_FRAME_STREAM_INF"); } 
+      | tag_EXT_X_DISCONTINUITY { $$.tag = tokenIdToTagId($1) } 
+      | tag_EXT_X_ENDLIST { $$.tag = tokenIdToTagId($1) } 
+      | tag_EXT_X_I_FRAMES_ONLY { $$.tag = tokenIdToTagId($1) } 
+      | tag_EXT_X_PLAYLIST_TYPE token_STRINGVAL { $$.tag = tokenIdToTagId($1); $$.storeKVDebug("EXT_X_PLAYLIST_TYPE",common.INTUnknownAttr,$2) } 
+      | tag_EXT_X_BYTERANGE VALUE { $$.tag = tokenIdToTagId($1); $$.storeKVDebug("EXT_X_BYTERANGE",common.INTUnknownAttr,$2) } 
+      | tag_EXT_X_DISCONTINUITY_SEQUENCE token_INTEGERVAL { $$.tag = tokenIdToTagId($1); $$.storeKVDebug("EXT_X_DISCONTINUITY_SEQUENCE",common.INTUnknownAttr,$2) } 
+
+ATTRLIST : ATTRANDVAL { $$.storeAttr("ATTRANDVAL_1",$1) }
+         | ATTRLIST token_COMMA ATTRANDVAL { $1.storeAttr("ATTRANDVAL_2",$3); $$ = $1 } 
+
+ATTRANDVAL : ATTRTOKEN VALUE { $$.k = attrTokenToTagId($1); $$.v=$2; $$.unknown = false } 
+           | token_ATTRKEY VALUE { k, ok := common.AttrToAttrId[$1]; $$.k = k; $$.v=$2; $$.unknown = !ok } 
 
 ATTRTOKEN : token_ATTR_BANDWIDTH { $$ = $1 }
           | token_ATTR_AVERAGE_BANDWIDTH { $$ = $1 }
@@ -18,25 +18,22 @@ func attrTokenToTagId(token int) common.AttrId {
 	return common.AttrId(token - token_ATTR_FIRST - 1)
 }
 
+// getHandler - nil if the lexer isn't set up with a handler, the error is reported to the lexer
 func getHandler(l yyLexer) parsers.M3u8Handler {
-	var lexer *Lexer
-	var ok bool
-	var obj parsers.M3u8Handler
-	lexer, ok = l.(*Lexer)
+	lexer, ok := l.(*Lexer)
 	if !ok {
-		panic("\nunknown lexer")
+		l.Error("unknown lexer")
+		return nil
 	}
-	obj, ok = lexer.parseResult.(parsers.M3u8Handler)
-	if !ok {
-		panic("\nunknown object")
-	}
-	if obj == nil {
-		panic("\nnil object")
+	obj, ok := lexer.parseResult.(parsers.M3u8Handler)
+	if !ok || obj == nil {
+		l.Error("no handler for the entries")
+		return nil
 	}
 	return obj
 }
 
-//line m3u8.y:35
+//line m3u8.y:32
 type yySymType struct {
 	yys     int
 	i       int
@@ -69,38 +66,54 @@ const tag_EXT_X_PART = 57359
 const tag_EXT_X_PRELOAD_HINT = 57360
 const tag_EXT_X_RENDITION_REPORT = 57361
 const tag_EXT_X_MAP = 57362
-const token_COMMA = 57363
-const token_SECONDLINEVALUE = 57364
-const token_ATTR_FIRST = 57365
-const token_ATTR_BANDWIDTH = 57366
-const token_ATTR_AVERAGE_BANDWIDTH = 57367
-const token_ATTR_RESOLUTION = 57368
-const token_ATTR_FRAME_RATE = 57369
-const token_ATTR_CODECS = 57370
-const token_ATTR_AUDIO = 57371
-const token_ATTR_TYPE = 57372
-const token_ATTR_GROUP_ID = 57373
-const token_ATTR_NAME = 57374
-const token_ATTR_DEFAULT = 57375
-const token_ATTR_AUTOSELECT = 57376
-const token_ATTR_LANGUAGE = 57377
-const token_ATTR_CHANNELS = 57378
-const token_ATTR_URI = 57379
-const token_ATTR_CAN_BLOCK_RELOAD = 57380
-const token_ATTR_CAN_SKIP_UNTIL = 57381
-const token_ATTR_PART_HOLD_BACK = 57382
-const token_ATTR_PART_TARGET = 57383
-const token_ATTR_SKIPPED_SEGMENTS = 57384
-const token_ATTR_DURATION = 57385
-const token_ATTR_INDEPENDENT = 57386
-const token_ATTR_LAST_MSN = 57387
-const token_ATTR_LAST_PART = 57388
-const token_ATTRKEY = 57389
-const token_INTEGERVAL = 57390
-const token_FLOATVAL = 57391
-const token_STRINGVAL = 57392
-const token_RESOLUTIONVAL = 57393
-const token_TIMEVAL = 57394
+const tag_EXT_X_I_FRAME_STREAM_INF = 57363
+const tag_EXT_X_DISCONTINUITY = 57364
+const tag_EXT_X_ENDLIST = 57365
+const tag_EXT_X_PLAYLIST_TYPE = 57366
+const tag_EXT_X_BYTERANGE = 57367
+const tag_EXT_X_KEY = 57368
+const tag_EXT_X_DATERANGE = 57369
+const tag_EXT_X_DISCONTINUITY_SEQUENCE = 57370
+const tag_EXT_X_I_FRAMES_ONLY = 57371
+const tag_EXT_X_SESSION_KEY = 57372
+const tag_EXT_X_SESSION_DATA = 57373
+const tag_EXT_X_START = 57374
+const tag_EXT_X_GAP = 57375
+const tag_EXT_X_BITRATE = 57376
+const tag_EXT_X_DEFINE = 57377
+const tag_EXT_X_CONTENT_STEERING = 57378
+const token_COMMA = 57379
+const token_SECONDLINEVALUE = 57380
+const token_ATTR_FIRST = 57381
+const token_ATTR_BANDWIDTH = 57382
+const token_ATTR_AVERAGE_BANDWIDTH = 57383
+const token_ATTR_RESOLUTION = 57384
+const token_ATTR_FRAME_RATE = 57385
+const token_ATTR_CODECS = 57386
+const token_ATTR_AUDIO = 57387
+const token_ATTR_TYPE = 57388
+const token_ATTR_GROUP_ID = 57389
+const token_ATTR_NAME = 57390
+const token_ATTR_DEFAULT = 57391
+const token_ATTR_AUTOSELECT = 57392
+const token_ATTR_LANGUAGE = 57393
+const token_ATTR_CHANNELS = 57394
+const token_ATTR_URI = 57395
+const token_ATTR_CAN_BLOCK_RELOAD = 57396
+const token_ATTR_CAN_SKIP_UNTIL = 57397
+const token_ATTR_PART_HOLD_BACK = 57398
+const token_ATTR_PART_TARGET = 57399
+const token_ATTR_SKIPPED_SEGMENTS = 57400
+const token_ATTR_DURATION = 57401
+const token_ATTR_INDEPENDENT = 57402
+const token_ATTR_LAST_MSN = 57403
+const token_ATTR_LAST_PART = 57404
+const token_ATTRKEY = 57405
+const token_INTEGERVAL = 57406
+const token_FLOATVAL = 57407
+const token_STRINGVAL = 57408
+const token_RESOLUTIONVAL = 57409
+const token_TIMEVAL = 57410
 
 var yyToknames = [...]string{
 	"$end",
@@ -123,6 +136,22 @@ var yyToknames = [...]string{
 	"tag_EXT_X_PRELOAD_HINT",
 	"tag_EXT_X_RENDITION_REPORT",
 	"tag_EXT_X_MAP",
+	"tag_EXT_X_I_FRAME_STREAM_INF",
+	"tag_EXT_X_DISCONTINUITY",
+	"tag_EXT_X_ENDLIST",
+	"tag_EXT_X_PLAYLIST_TYPE",
+	"tag_EXT_X_BYTERANGE",
+	"tag_EXT_X_KEY",
+	"tag_EXT_X_DATERANGE",
+	"tag_EXT_X_DISCONTINUITY_SEQUENCE",
+	"tag_EXT_X_I_FRAMES_ONLY",
+	"tag_EXT_X_SESSION_KEY",
+	"tag_EXT_X_SESSION_DATA",
+	"tag_EXT_X_START",
+	"tag_EXT_X_GAP",
+	"tag_EXT_X_BITRATE",
+	"tag_EXT_X_DEFINE",
+	"tag_EXT_X_CONTENT_STEERING",
 	"token_COMMA",
 	"token_SECONDLINEVALUE",
 	"token_ATTR_FIRST",
@@ -163,10 +192,10 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line m3u8.y:225
+//line m3u8.y:238
 
 //line yacctab:1
-var yyExca = [...]int{
+var yyExca = [...]int8{
 	-1, 1,
 	1, -1,
 	-2, 0,
@@ -174,89 +203,101 @@ var yyExca = [...]int{
 
 const yyPrivate = 57344
 
-const yyLast = 89
-
-var yyAct = [...]int{
-	26, 27, 28, 29, 30, 31, 32, 33, 34, 35,
-	36, 37, 38, 39, 40, 41, 42, 43, 44, 45,
-	46, 47, 48, 25, 23, 65, 66, 67, 68, 69,
-	57, 56, 55, 53, 50, 21, 4, 5, 7, 8,
-	6, 9, 10, 11, 12, 13, 14, 15, 16, 17,
-	18, 19, 22, 64, 63, 62, 75, 74, 63, 72,
-	71, 49, 3, 51, 52, 20, 54, 1, 2, 58,
-	59, 60, 61, 24, 0, 0, 0, 0, 0, 70,
-	0, 0, 0, 0, 0, 0, 0, 0, 73,
+const yyLast = 112
+
+var yyAct = [...]int8{
+	39, 42, 43, 44, 45, 46, 47, 48, 49, 50,
+	51, 52, 53, 54, 55, 56, 57, 58, 59, 60,
+	61, 62, 63, 64, 41, 72, 88, 89, 90, 91,
+	92, 4, 5, 7, 8, 6, 9, 10, 11, 12,
+	13, 14, 15, 16, 17, 18, 19, 29, 30, 31,
+	33, 34, 24, 25, 35, 32, 26, 27, 28, 20,
+	21, 22, 23, 86, 93, 77, 69, 66, 37, 87,
+	95, 94, 71, 95, 38, 3, 1, 2, 36, 40,
+	0, 0, 0, 65, 0, 67, 68, 0, 70, 0,
+	0, 73, 74, 75, 76, 0, 98, 78, 79, 80,
+	81, 82, 83, 84, 85, 0, 0, 0, 0, 0,
+	96, 97,
 }
 
-var yyPact = [...]int{
-	31, -1000, 31, -1000, -1000, -13, -24, -1000, -24, -14,
-	-24, -24, -15, -24, -17, -22, -24, -24, -24, -24,
-	-1000, -1000, 33, -1000, -23, -23, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 37,
-	-1000, 37, 37, -1000, 37, 39, 38, -1000, 37, 37,
-	37, 37, -1000, -24, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 35, 34, -1000, -1000, -1000,
+var yyPact = [...]int16{
+	26, -32768, 26, -32768, -32768, 4, -39, -32768, -39, 3,
+	-39, -39, 2, -39, 34, -43, -39, -39, -39, -39,
+	-32768, 1, -39, -39, -39, -39, -39, -39, -39, -39,
+	-32768, -32768, -32768, -3, -38, 0, -32768, -32768, 33, -32768,
+	-38, -38, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, 36, -32768, 36, 36, -32768,
+	36, -32768, -32768, 36, 36, 36, 36, -32768, 36, 36,
+	36, 36, 36, 36, 36, 36, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -39, -32768, -32768, -32768,
 }
 
-var yyPgo = [...]int{
-	0, 73, 53, 24, 52, 62, 68, 67,
+var yyPgo = [...]int8{
+	0, 79, 69, 0, 74, 75, 77, 76,
 }
 
-var yyR1 = [...]int{
+var yyR1 = [...]int8{
 	0, 7, 6, 6, 5, 5, 5, 5, 5, 5,
 	5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
-	5, 4, 4, 3, 3, 1, 1, 1, 1, 1,
+	5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+	5, 5, 5, 5, 5, 5, 4, 4, 3, 3,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
-	2, 2, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 2, 2, 2, 2, 2,
 }
 
-var yyR2 = [...]int{
+var yyR2 = [...]int8{
 	0, 1, 1, 2, 1, 2, 3, 1, 2, 2,
-	2, 2, 2, 2, 4, 4, 2, 2, 2, 2,
-	2, 1, 3, 2, 2, 1, 1, 1, 1, 1,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	1, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	1, 1, 1, 2, 2, 2, 1, 3, 2, 2,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
 }
 
-var yyChk = [...]int{
-	-1000, -7, -6, -5, 5, 6, 9, 7, 8, 10,
+var yyChk = [...]int16{
+	-32768, -7, -6, -5, 5, 6, 9, 7, 8, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	-5, 48, -4, -3, -1, 47, 24, 25, 26, 27,
-	28, 29, 30, 31, 32, 33, 34, 35, 36, 37,
-	38, 39, 40, 41, 42, 43, 44, 45, 46, -4,
-	48, -4, -4, 48, -4, 49, 48, 52, -4, -4,
-	-4, -4, 22, 21, -2, 48, 49, 50, 51, 52,
-	-2, 21, 21, -3, 22, 22,
+	33, 34, 35, 36, 26, 27, 30, 31, 32, 21,
+	22, 23, 29, 24, 25, 28, -5, 64, -4, -3,
+	-1, 63, 40, 41, 42, 43, 44, 45, 46, 47,
+	48, 49, 50, 51, 52, 53, 54, 55, 56, 57,
+	58, 59, 60, 61, 62, -4, 64, -4, -4, 64,
+	-4, 38, 68, -4, -4, -4, -4, 64, -4, -4,
+	-4, -4, -4, -4, -4, -4, 66, -2, 64, 65,
+	66, 67, 68, 64, 38, 37, -2, -2, -3,
 }
 
-var yyDef = [...]int{
+var yyDef = [...]int8{
 	0, -2, 1, 2, 4, 0, 0, 7, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	3, 5, 0, 21, 0, 0, 25, 26, 27, 28,
-	29, 30, 31, 32, 33, 34, 35, 36, 37, 38,
-	39, 40, 41, 42, 43, 44, 45, 46, 47, 8,
-	9, 10, 11, 12, 13, 0, 0, 16, 17, 18,
-	19, 20, 6, 0, 23, 48, 49, 50, 51, 52,
-	24, 0, 0, 22, 14, 15,
+	20, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	30, 31, 32, 0, 0, 0, 3, 5, 0, 36,
+	0, 0, 40, 41, 42, 43, 44, 45, 46, 47,
+	48, 49, 50, 51, 52, 53, 54, 55, 56, 57,
+	58, 59, 60, 61, 62, 8, 9, 10, 11, 12,
+	13, 14, 15, 16, 17, 18, 19, 21, 22, 23,
+	24, 25, 26, 27, 28, 29, 33, 34, 63, 64,
+	65, 66, 67, 35, 6, 0, 38, 39, 37,
 }
 
-var yyTok1 = [...]int{
+var yyTok1 = [...]int8{
 	1,
 }
 
-var yyTok2 = [...]int{
+var yyTok2 = [...]int8{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 64, 65, 66, 67, 68,
 }
 
-var yyTok3 = [...]int{
+var yyTok3 = [...]int8{
 	0,
 }
 
@@ -299,7 +340,7 @@ func yyNewParser() yyParser {
 	return &yyParserImpl{}
 }
 
-const yyFlag = -1000
+const yyFlag = -32768
 
 func yyTokname(c int) string {
 	if c >= 1 && c-1 < len(yyToknames) {
@@ -338,9 +379,9 @@ func yyErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := yyPact[state]
+	base := int(yyPact[state])
 	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -350,13 +391,13 @@ func yyErrorMessage(state, lookAhead int) string {
 
 	if yyDef[state] == -2 {
 		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
+			tok := int(yyExca[i])
 			if tok < TOKSTART || yyExca[i+1] == 0 {
 				continue
 			}
@@ -387,30 +428,30 @@ func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = yyTok1[0]
+		token = int(yyTok1[0])
 		goto out
 	}
 	if char < len(yyTok1) {
-		token = yyTok1[char]
+		token = int(yyTok1[char])
 		goto out
 	}
 	if char >= yyPrivate {
 		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
+			token = int(yyTok2[char-yyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
+		token = int(yyTok3[i+0])
 		if token == char {
-			token = yyTok3[i+1]
+			token = int(yyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = yyTok2[1] /* unknown char */
+		token = int(yyTok2[1]) /* unknown char */
 	}
 	if yyDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
@@ -465,7 +506,7 @@ yystack:
 	yyS[yyp].yys = yystate
 
 yynewstate:
-	yyn = yyPact[yystate]
+	yyn = int(yyPact[yystate])
 	if yyn <= yyFlag {
 		goto yydefault /* simple state */
 	}
@@ -476,8 +517,8 @@ yynewstate:
 	if yyn < 0 || yyn >= yyLast {
 		goto yydefault
 	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
 		yyrcvr.char = -1
 		yytoken = -1
 		yyVAL = yyrcvr.lval
@@ -490,7 +531,7 @@ yynewstate:
 
 yydefault:
 	/* default state action */
-	yyn = yyDef[yystate]
+	yyn = int(yyDef[yystate])
 	if yyn == -2 {
 		if yyrcvr.char < 0 {
 			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
@@ -499,18 +540,18 @@ yydefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
+			yyn = int(yyExca[xi+0])
 			if yyn < 0 || yyn == yytoken {
 				break
 			}
 		}
-		yyn = yyExca[xi+1]
+		yyn = int(yyExca[xi+1])
 		if yyn < 0 {
 			goto ret0
 		}
@@ -532,10 +573,10 @@ yydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
 				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
 						goto yystack
 					}
 				}
@@ -571,7 +612,7 @@ yydefault:
 	yypt := yyp
 	_ = yypt // guard against "declared and not used"
 
-	yyp -= yyR2[yyn]
+	yyp -= int(yyR2[yyn])
 	// yyp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if yyp+1 >= len(yyS) {
@@ -582,16 +623,16 @@ yydefault:
 	yyVAL = yyS[yyp+1]
 
 	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
 	yyj := yyg + yyS[yyp].yys + 1
 
 	if yyj >= yyLast {
-		yystate = yyAct[yyg]
+		yystate = int(yyAct[yyg])
 	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -599,40 +640,48 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line m3u8.y:169
+//line m3u8.y:167
 		{
 			if yyVAL.hdlr == nil {
 				yyVAL.hdlr = getHandler(yylex)
 			}
-			yyVAL.hdlr.PostRecord(yyDollar[1].entry.tag, yyDollar[1].entry.kvs)
+			if yyVAL.hdlr != nil {
+				if err := yyVAL.hdlr.PostRecord(yyDollar[1].entry.tag, yyDollar[1].entry.kvs); err != nil {
+					yylex.Error(err.Error())
+				}
+			}
 			yyDollar[1].entry.clear("ENTRY1")
 		}
 	case 3:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line m3u8.y:170
+//line m3u8.y:168
 		{
 			if yyVAL.hdlr == nil {
 				yyVAL.hdlr = getHandler(yylex)
 			}
-			yyVAL.hdlr.PostRecord(yyDollar[2].entry.tag, yyDollar[2].entry.kvs)
+			if yyVAL.hdlr != nil {
+				if err := yyVAL.hdlr.PostRecord(yyDollar[2].entry.tag, yyDollar[2].entry.kvs); err != nil {
+					yylex.Error(err.Error())
+				}
+			}
 			yyDollar[2].entry.clear("ENTRY2")
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line m3u8.y:172
+//line m3u8.y:170
 		{
 			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
 		}
 	case 5:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line m3u8.y:173
+//line m3u8.y:171
 		{
 			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
 			yyVAL.entry.storeKVDebug("EXT_X_VERSION", common.INTUnknownAttr, yyDollar[2].i64)
 		}
 	case 6:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line m3u8.y:174
+//line m3u8.y:172
 		{
 			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
 			yyVAL.entry.assignKVPS("EXT_X_STREAM_INF_1", yyDollar[2].kvpairs)
@@ -641,13 +690,13 @@ yydefault:
 		}
 	case 7:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line m3u8.y:175
+//line m3u8.y:173
 		{
 			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
 		}
 	case 8:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line m3u8.y:176
+//line m3u8.y:174
 		{
 			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
 			yyVAL.entry.assignKVPS("EXT_X_MEDIA", yyDollar[2].kvpairs)
@@ -655,14 +704,14 @@ yydefault:
 		}
 	case 9:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line m3u8.y:177
+//line m3u8.y:175
 		{
 			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
 			yyVAL.entry.storeKVDebug("EXT_X_TARGETDURATION", common.INTUnknownAttr, yyDollar[2].i64)
 		}
 	case 10:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line m3u8.y:178
+//line m3u8.y:176
 		{
 			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
 			yyVAL.entry.assignKVPS("EXT_X_SERVER_CONTROL", yyDollar[2].kvpairs)
@@ -670,7 +719,7 @@ yydefault:
 		}
 	case 11:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line m3u8.y:179
+//line m3u8.y:177
 		{
 			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
 			yyVAL.entry.assignKVPS("EXT_X_PART_INF", yyDollar[2].kvpairs)
@@ -678,266 +727,377 @@ yydefault:
 		}
 	case 12:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line m3u8.y:180
+//line m3u8.y:178
 		{
 			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
 			yyVAL.entry.storeKVDebug("EXT_X_MEDIA_SEQUENCE", common.INTUnknownAttr, yyDollar[2].i64)
 		}
 	case 13:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line m3u8.y:181
+//line m3u8.y:179
 		{
 			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
 			yyVAL.entry.assignKVPS("EXT_X_SKIP", yyDollar[2].kvpairs)
 			yyDollar[2].kvpairs.clear("EXT_X_SKIP")
 		}
 	case 14:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line m3u8.y:182
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line m3u8.y:180
 		{
 			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
-			yyVAL.entry.storeKVDebug("EXTINF_FL_1", common.INTUnknownAttr, yyDollar[2].f)
-			yyVAL.entry.storeKVDebug("EXTINF_FL_2", common.M3U8Uri, yyDollar[4].s)
+			yyVAL.entry.storeExtInf("EXTINF_1", yyDollar[1].s)
+			yyVAL.entry.storeKVDebug("EXTINF_2", common.M3U8Uri, yyDollar[2].s)
 		}
 	case 15:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line m3u8.y:183
-		{
-			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
-			yyVAL.entry.storeKVDebug("EXTINF_INT_1", common.INTUnknownAttr, float64(yyDollar[2].i64))
-			yyVAL.entry.storeKVDebug("EXTINF_INT_2", common.M3U8Uri, yyDollar[4].s)
-		}
-	case 16:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line m3u8.y:184
+//line m3u8.y:181
 		{
 			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
 			yyVAL.entry.storeKVDebug("EXT_X_PROGRAM_DATE_TIME", common.INTUnknownAttr, yyDollar[2].t)
 		}
-	case 17:
+	case 16:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line m3u8.y:185
+//line m3u8.y:182
 		{
 			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
 			yyVAL.entry.assignKVPS("EXT_X_PART", yyDollar[2].kvpairs)
 			yyDollar[2].kvpairs.clear("EXT_X_PART")
 		}
-	case 18:
+	case 17:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line m3u8.y:186
+//line m3u8.y:183
 		{
 			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
 			yyVAL.entry.assignKVPS("EXT_X_PRELOAD_HINT", yyDollar[2].kvpairs)
 			yyDollar[2].kvpairs.clear("EXT_X_PRELOAD_HINT")
 		}
-	case 19:
+	case 18:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line m3u8.y:187
+//line m3u8.y:184
 		{
 			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
 			yyVAL.entry.assignKVPS("EXT_X_RENDITION_REPORT", yyDollar[2].kvpairs)
 			yyDollar[2].kvpairs.clear("EXT_X_RENDITION_REPORT")
 		}
-	case 20:
+	case 19:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line m3u8.y:188
+//line m3u8.y:185
 		{
 			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
 			yyVAL.entry.assignKVPS("EXT_X_MAP", yyDollar[2].kvpairs)
 			yyDollar[2].kvpairs.clear("EXT_X_MAP")
 		}
-	case 21:
+	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line m3u8.y:190
+//line m3u8.y:186
+		{
+			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
+		}
+	case 21:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line m3u8.y:187
 		{
-			yyVAL.kvpairs.storeKVDebug("ATTRANDVAL_1", yyDollar[1].kv.k, yyDollar[1].kv.v)
+			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
+			yyVAL.entry.storeKVDebug("EXT_X_BITRATE", common.INTUnknownAttr, yyDollar[2].i64)
 		}
 	case 22:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line m3u8.y:191
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line m3u8.y:188
 		{
-			yyDollar[1].kvpairs.storeKVDebug("ATTRANDVAL_2", yyDollar[3].kv.k, yyDollar[3].kv.v)
-			yyVAL.kvpairs = yyDollar[1].kvpairs
+			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
+			yyVAL.entry.assignKVPS("EXT_X_DEFINE", yyDollar[2].kvpairs)
+			yyDollar[2].kvpairs.clear("EXT_X_DEFINE")
 		}
 	case 23:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line m3u8.y:193
+//line m3u8.y:189
 		{
-			yyVAL.kv.k = attrTokenToTagId(yyDollar[1].i)
-			yyVAL.kv.v = yyDollar[2].val
+			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
+			yyVAL.entry.assignKVPS("EXT_X_CONTENT_STEERING", yyDollar[2].kvpairs)
+			yyDollar[2].kvpairs.clear("EXT_X_CONTENT_STEERING")
 		}
 	case 24:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line m3u8.y:194
+//line m3u8.y:190
 		{
-			yyVAL.kv.k = common.AttrToAttrId[yyDollar[1].s]
-			yyVAL.kv.v = yyDollar[2].val
+			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
+			yyVAL.entry.assignKVPS("EXT_X_KEY", yyDollar[2].kvpairs)
+			yyDollar[2].kvpairs.clear("EXT_X_KEY")
 		}
 	case 25:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line m3u8.y:196
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line m3u8.y:191
 		{
-			yyVAL.i = yyDollar[1].i
+			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
+			yyVAL.entry.assignKVPS("EXT_X_DATERANGE", yyDollar[2].kvpairs)
+			yyDollar[2].kvpairs.clear("EXT_X_DATERANGE")
 		}
 	case 26:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line m3u8.y:197
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line m3u8.y:192
 		{
-			yyVAL.i = yyDollar[1].i
+			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
+			yyVAL.entry.assignKVPS("EXT_X_SESSION_KEY", yyDollar[2].kvpairs)
+			yyDollar[2].kvpairs.clear("EXT_X_SESSION_KEY")
 		}
 	case 27:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line m3u8.y:198
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line m3u8.y:193
 		{
-			yyVAL.i = yyDollar[1].i
+			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
+			yyVAL.entry.assignKVPS("EXT_X_SESSION_DATA", yyDollar[2].kvpairs)
+			yyDollar[2].kvpairs.clear("EXT_X_SESSION_DATA")
 		}
 	case 28:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line m3u8.y:199
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line m3u8.y:194
 		{
-			yyVAL.i = yyDollar[1].i
+			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
+			yyVAL.entry.assignKVPS("EXT_X_START", yyDollar[2].kvpairs)
+			yyDollar[2].kvpairs.clear("EXT_X_START")
 		}
 	case 29:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line m3u8.y:200
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line m3u8.y:195
 		{
-			yyVAL.i = yyDollar[1].i
+			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
+			yyVAL.entry.assignKVPS("EXT_X_I_FRAME_STREAM_INF", yyDollar[2].kvpairs)
+			yyDollar[2].kvpairs.clear("EXT_X_I_FRAME_STREAM_INF")
 		}
 	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line m3u8.y:201
+//line m3u8.y:196
 		{
-			yyVAL.i = yyDollar[1].i
+			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
 		}
 	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line m3u8.y:202
+//line m3u8.y:197
 		{
-			yyVAL.i = yyDollar[1].i
+			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
 		}
 	case 32:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line m3u8.y:203
+//line m3u8.y:198
 		{
-			yyVAL.i = yyDollar[1].i
+			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
 		}
 	case 33:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line m3u8.y:204
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line m3u8.y:199
 		{
-			yyVAL.i = yyDollar[1].i
+			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
+			yyVAL.entry.storeKVDebug("EXT_X_PLAYLIST_TYPE", common.INTUnknownAttr, yyDollar[2].s)
 		}
 	case 34:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line m3u8.y:205
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line m3u8.y:200
 		{
-			yyVAL.i = yyDollar[1].i
+			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
+			yyVAL.entry.storeKVDebug("EXT_X_BYTERANGE", common.INTUnknownAttr, yyDollar[2].val)
 		}
 	case 35:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line m3u8.y:206
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line m3u8.y:201
 		{
-			yyVAL.i = yyDollar[1].i
+			yyVAL.entry.tag = tokenIdToTagId(yyDollar[1].i)
+			yyVAL.entry.storeKVDebug("EXT_X_DISCONTINUITY_SEQUENCE", common.INTUnknownAttr, yyDollar[2].i64)
 		}
 	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line m3u8.y:207
+//line m3u8.y:203
 		{
-			yyVAL.i = yyDollar[1].i
+			yyVAL.kvpairs.storeAttr("ATTRANDVAL_1", yyDollar[1].kv)
 		}
 	case 37:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line m3u8.y:208
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line m3u8.y:204
 		{
-			yyVAL.i = yyDollar[1].i
+			yyDollar[1].kvpairs.storeAttr("ATTRANDVAL_2", yyDollar[3].kv)
+			yyVAL.kvpairs = yyDollar[1].kvpairs
 		}
 	case 38:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line m3u8.y:206
+		{
+			yyVAL.kv.k = attrTokenToTagId(yyDollar[1].i)
+			yyVAL.kv.v = yyDollar[2].val
+			yyVAL.kv.unknown = false
+		}
+	case 39:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line m3u8.y:207
+		{
+			k, ok := common.AttrToAttrId[yyDollar[1].s]
+			yyVAL.kv.k = k
+			yyVAL.kv.v = yyDollar[2].val
+			yyVAL.kv.unknown = !ok
+		}
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line m3u8.y:209
 		{
 			yyVAL.i = yyDollar[1].i
 		}
-	case 39:
+	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line m3u8.y:210
 		{
 			yyVAL.i = yyDollar[1].i
 		}
-	case 40:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line m3u8.y:211
 		{
 			yyVAL.i = yyDollar[1].i
 		}
-	case 41:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line m3u8.y:212
 		{
 			yyVAL.i = yyDollar[1].i
 		}
-	case 42:
+	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line m3u8.y:213
 		{
 			yyVAL.i = yyDollar[1].i
 		}
-	case 43:
+	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line m3u8.y:214
 		{
 			yyVAL.i = yyDollar[1].i
 		}
-	case 44:
+	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line m3u8.y:215
 		{
 			yyVAL.i = yyDollar[1].i
 		}
-	case 45:
+	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line m3u8.y:216
 		{
 			yyVAL.i = yyDollar[1].i
 		}
-	case 46:
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line m3u8.y:217
 		{
 			yyVAL.i = yyDollar[1].i
 		}
-	case 47:
+	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line m3u8.y:218
 		{
 			yyVAL.i = yyDollar[1].i
 		}
-	case 48:
+	case 50:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line m3u8.y:219
+		{
+			yyVAL.i = yyDollar[1].i
+		}
+	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line m3u8.y:220
 		{
-			yyVAL.val = yyDollar[1].i64
+			yyVAL.i = yyDollar[1].i
 		}
-	case 49:
+	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line m3u8.y:221
 		{
-			yyVAL.val = yyDollar[1].f
+			yyVAL.i = yyDollar[1].i
 		}
-	case 50:
+	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line m3u8.y:222
 		{
-			yyVAL.val = yyDollar[1].s
+			yyVAL.i = yyDollar[1].i
 		}
-	case 51:
+	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line m3u8.y:223
 		{
-			yyVAL.val = yyDollar[1].r
+			yyVAL.i = yyDollar[1].i
 		}
-	case 52:
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line m3u8.y:224
+		{
+			yyVAL.i = yyDollar[1].i
+		}
+	case 56:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line m3u8.y:225
+		{
+			yyVAL.i = yyDollar[1].i
+		}
+	case 57:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line m3u8.y:226
+		{
+			yyVAL.i = yyDollar[1].i
+		}
+	case 58:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line m3u8.y:227
+		{
+			yyVAL.i = yyDollar[1].i
+		}
+	case 59:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line m3u8.y:228
+		{
+			yyVAL.i = yyDollar[1].i
+		}
+	case 60:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line m3u8.y:229
+		{
+			yyVAL.i = yyDollar[1].i
+		}
+	case 61:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line m3u8.y:230
+		{
+			yyVAL.i = yyDollar[1].i
+		}
+	case 62:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line m3u8.y:231
+		{
+			yyVAL.i = yyDollar[1].i
+		}
+	case 63:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line m3u8.y:233
+		{
+			yyVAL.val = yyDollar[1].i64
+		}
+	case 64:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line m3u8.y:234
+		{
+			yyVAL.val = yyDollar[1].f
+		}
+	case 65:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line m3u8.y:235
+		{
+			yyVAL.val = yyDollar[1].s
+		}
+	case 66:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line m3u8.y:236
+		{
+			yyVAL.val = yyDollar[1].r
+		}
+	case 67:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line m3u8.y:237
 		{
 			yyVAL.val = yyDollar[1].t
 		}
@@ -2,8 +2,10 @@ package yaccparser
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/eswarantg/m3u8reader/common"
 	"github.com/eswarantg/m3u8reader/parsers"
@@ -11,31 +13,103 @@ import (
 
 type YaccParser struct {
 	extHander parsers.M3u8Handler
+	//lines given to the lexer, for the position of the errors
+	lines []keptLine
+	//position of the tag lines, an entry is posted for each of them in order
+	tags    []parsers.Position
+	entries int
+	//error of the entry posted, the parsing is stopped with it reported to the lexer
+	err error
 }
 
-func (y *YaccParser) PostRecord(tag common.TagId, kvpairs *parsers.AttrKVPairs) error {
-	return y.extHander.PostRecord(tag, kvpairs)
+//PostRecord - types the values as per RFC 8216 before passing to the handler
+func (y *YaccParser) PostRecord(tag common.TagId, kvpairs *parsers.AttrKVPairs) (err error) {
+	pos := parsers.StartPosition()
+	if y.entries < len(y.tags) {
+		pos = y.tags[y.entries]
+	}
+	y.entries++
+	err = parsers.TypeValues(tag, kvpairs)
+	if err != nil {
+		y.err = parsers.Locate(err, parsers.ErrBadValue, tag, pos)
+		return y.err
+	}
+	err = y.extHander.PostRecord(tag, kvpairs)
+	y.err = parsers.Locate(err, parsers.ErrHandler, tag, pos)
+	return y.err
 }
 
-func (y *YaccParser) yyparse(r io.Reader, handler parsers.M3u8Handler) (nbytes int, err error) {
+//lexPosition - position in the data of the token read by the lexer and the tag of its line
+func (y *YaccParser) lexPosition(lex *Lexer) (pos parsers.Position, tag common.TagId) {
+	if len(y.lines) == 0 {
+		return parsers.StartPosition(), common.M3U8UNKNOWNTAG
+	}
+	//lexer line 0 is the line end added before the first line
+	i, col := lex.Line()-1, lex.Column()
+	switch {
+	case len(lex.stack) == 0:
+		//all the data is read
+		i, col = len(y.lines)-1, 0
+	case strings.HasPrefix(lex.Text(), "\n"):
+		//tags are matched with the line end before them
+		i, col = lex.Line(), 0
+	}
+	if i < 0 {
+		i = 0
+	}
+	if i >= len(y.lines) {
+		i = len(y.lines) - 1
+	}
+	pos = y.lines[i].pos
+	pos.Offset += col
+	pos.Column += col
+	//URI lines are part of the tag before them
+	for ; i >= 0; i-- {
+		if y.lines[i].tag != common.M3U8UNKNOWNTAG {
+			tag = y.lines[i].tag
+			break
+		}
+	}
+	return
+}
+
+func (y *YaccParser) yyparse(data []byte, handler parsers.M3u8Handler) (nbytes int, err error) {
 	nbytes = -1 //don't know how to get number of bytes read... for now put -1
-	lex := NewLexerWithInit(r, func(l *Lexer) {
-		l.parseResult = handler
+	data, y.lines = knownTagLines(data)
+	y.tags = y.tags[:0]
+	for _, line := range y.lines {
+		if line.tag != common.M3U8UNKNOWNTAG {
+			y.tags = append(y.tags, line.pos)
+		}
+	}
+	y.entries = 0
+	y.err = nil
+	lex := NewLexerWithInit(bytes.NewReader(data), func(l *Lexer) {
+		l.parseResult = y
 	})
+	syntaxError := func(cause error) error {
+		pos, tag := y.lexPosition(lex)
+		return parsers.Locate(parsers.NewParseError(parsers.ErrSyntax, tag, parsers.NoAttr, cause), parsers.ErrSyntax, tag, pos)
+	}
 	defer func() {
 		if err1 := recover(); err1 != nil {
+			if y.err != nil {
+				//error of the entry reported to the lexer
+				err = y.err
+				return
+			}
 			msg, ok := err1.(string)
 			if ok {
-				err = fmt.Errorf("%v : at line %v", msg, lex.Line())
+				err = syntaxError(errors.New(msg))
 				return
 			}
-			err = fmt.Errorf("%v : panic handled : at line %v", err1, lex.Line())
+			err = syntaxError(fmt.Errorf("%v : panic handled", err1))
 			return
 		}
 	}()
 	result := yyParse(lex)
 	if result != 0 && err == nil {
-		err = fmt.Errorf("yyParse returned non-zero")
+		err = syntaxError(fmt.Errorf("yyParse returned non-zero"))
 	}
 	return
 }
@@ -44,8 +118,12 @@ func (y *YaccParser) Parse(rdr io.Reader, handler parsers.M3u8Handler, buffer []
 	defer func() {
 		y.extHander = nil
 	}()
+	data, err := io.ReadAll(rdr)
+	if err != nil {
+		return 0, err
+	}
 	y.extHander = handler
-	return y.yyparse(rdr, handler)
+	return y.yyparse(data, handler)
 }
 
 func (y *YaccParser) ParseData(data []byte, handler parsers.M3u8Handler, buffer []byte) (nBytes int, err error) {
@@ -53,6 +131,5 @@ func (y *YaccParser) ParseData(data []byte, handler parsers.M3u8Handler, buffer
 		y.extHander = nil
 	}()
 	y.extHander = handler
-	rdr := bytes.NewReader(data)
-	return y.yyparse(rdr, handler)
+	return y.yyparse(data, handler)
 }
@@ -30,15 +30,15 @@ func Test_M3u8Lex(t *testing.T) {
 	yyDebug = 5
 	yyErrorVerbose = true
 	tests := []string{
-		//"test/ll_hls_byte_range.m3u8",
-		//"test/ll_hls_delta_update.m3u8",
-		//"test/ll_hls_pl.m3u8",
-		//"test/index_new.m3u8",
-		//"test/index_new_Variant_450k.m3u8",
-		//"test/tv5.m3u8",
-		//"test/tv5_TS-50002_1_video.m3u8",
-		"test/master.m3u8",
-		"test/sub.m3u8",
+		//"../../test/ll_hls_byte_range.m3u8",
+		//"../../test/ll_hls_delta_update.m3u8",
+		//"../../test/ll_hls_pl.m3u8",
+		//"../../test/index_new.m3u8",
+		//"../../test/index_new_Variant_450k.m3u8",
+		//"../../test/tv5.m3u8",
+		//"../../test/tv5_TS-50002_1_video.m3u8",
+		"../../test/master.m3u8",
+		"../../test/sub.m3u8",
 	}
 	for i, file := range tests {
 		fmt.Printf("\n********* Test %v - %v ************", i, file)
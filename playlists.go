@@ -0,0 +1,481 @@
+package m3u8reader
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+//ByteRange - sub-range of a resource <n>[@<o>]
+type ByteRange struct {
+	Length int64
+	Offset int64 //-1 when not specified
+}
+
+func (b ByteRange) String() string {
+	if b.Offset < 0 {
+		return strconv.FormatInt(b.Length, 10)
+	}
+	return fmt.Sprintf("%v@%v", b.Length, b.Offset)
+}
+
+//ParseByteRange - parses <n>[@<o>], see parsers.ParseByteRange
+func ParseByteRange(s string) (*ByteRange, error) {
+	v, err := parsers.ParseByteRange(s)
+	if err != nil {
+		return nil, err
+	}
+	return &ByteRange{Length: v[0], Offset: v[1]}, nil
+}
+
+//Key - EXT-X-KEY or EXT-X-SESSION-KEY
+type Key struct {
+	Method            string
+	URI               string
+	IV                []byte
+	KeyFormat         string
+	KeyFormatVersions []string
+	Entry             *M3U8Entry
+}
+
+//Variant - EXT-X-STREAM-INF
+type Variant struct {
+	Bandwidth        int64
+	AverageBandwidth int64
+	Codecs           []string
+	Resolution       common.Resolution
+	FrameRate        float64
+	HDCPLevel        string
+	Audio            string
+	Video            string
+	Subtitles        string
+	ClosedCaptions   string
+	ProgramId        int64
+	PathwayId        string
+	VideoRange       string  //SDR, HLG or PQ, empty if not specified
+	Score            float64 //-1 when not specified
+	URI              string
+	Entry            *M3U8Entry
+}
+
+//IFrameVariant - EXT-X-I-FRAME-STREAM-INF
+type IFrameVariant struct {
+	Bandwidth        int64
+	AverageBandwidth int64
+	Codecs           []string
+	Resolution       common.Resolution
+	HDCPLevel        string
+	Video            string
+	PathwayId        string
+	VideoRange       string
+	URI              string
+	Entry            *M3U8Entry
+}
+
+//Rendition - EXT-X-MEDIA
+type Rendition struct {
+	Type            string
+	URI             string
+	GroupId         string
+	Language        string
+	AssocLanguage   string
+	Name            string
+	Default         bool
+	AutoSelect      bool
+	Forced          bool
+	InStreamId      string
+	Characteristics []string
+	Channels        string
+	PathwayId       string
+	Entry           *M3U8Entry
+}
+
+//SessionData - EXT-X-SESSION-DATA
+type SessionData struct {
+	DataId   string
+	Value    string
+	URI      string
+	Language string
+	Entry    *M3U8Entry
+}
+
+//Define - EXT-X-DEFINE
+type Define struct {
+	Name       string
+	Value      string
+	Import     string
+	QueryParam string
+	Entry      *M3U8Entry
+}
+
+//ContentSteering - EXT-X-CONTENT-STEERING
+type ContentSteering struct {
+	ServerURI string
+	PathwayId string
+	Entry     *M3U8Entry
+}
+
+//Start - EXT-X-START
+type Start struct {
+	TimeOffset time.Duration
+	Precise    bool
+}
+
+//ServerControl - EXT-X-SERVER-CONTROL
+type ServerControl struct {
+	CanBlockReload    bool
+	CanSkipUntil      time.Duration //0 if Playlist Delta Updates are not supported
+	CanSkipDateRanges bool
+	HoldBack          time.Duration
+	PartHoldBack      time.Duration
+}
+
+//Segment - EXTINF along with the state inherited from the preceding tags
+type Segment struct {
+	SequenceNumber        int64
+	Duration              time.Duration
+	Title                 string
+	URI                   string
+	ProgramDateTime       time.Time //zero if no EXT-X-PROGRAM-DATE-TIME applies
+	Keys                  []Key     //one per KEYFORMAT, nil if not encrypted
+	Map                   *Map
+	ByteRange             *ByteRange //Offset always resolved
+	Discontinuity         bool       //EXT-X-DISCONTINUITY precedes the segment
+	DiscontinuitySequence int64
+	Gap                   bool  //EXT-X-GAP, the segment is not available
+	Bitrate               int64 //kbps from EXT-X-BITRATE, 0 if not specified
+	Parts                 []Part
+	Entry                 *M3U8Entry
+}
+
+//Map - EXT-X-MAP
+type Map struct {
+	URI       string
+	ByteRange *ByteRange //Offset always resolved
+	Entry     *M3U8Entry
+}
+
+//Part - EXT-X-PART
+type Part struct {
+	SequenceNumber  int64 //Media Sequence Number of the parent segment
+	PartNumber      int64
+	Duration        time.Duration
+	URI             string
+	Independent     bool
+	Gap             bool
+	ByteRange       *ByteRange
+	ProgramDateTime time.Time //zero if no EXT-X-PROGRAM-DATE-TIME applies
+	Entry           *M3U8Entry
+}
+
+//PreloadHint - EXT-X-PRELOAD-HINT
+type PreloadHint struct {
+	Type            string
+	URI             string
+	ByteRangeStart  int64
+	ByteRangeLength int64     //-1 when not specified
+	ProgramDateTime time.Time //zero if no EXT-X-PROGRAM-DATE-TIME applies
+	Entry           *M3U8Entry
+}
+
+//RenditionReport - EXT-X-RENDITION-REPORT
+type RenditionReport struct {
+	URI      string
+	LastMsn  int64
+	LastPart int64 //-1 when not specified
+	Entry    *M3U8Entry
+}
+
+//DateRange - EXT-X-DATERANGE
+type DateRange struct {
+	Id              string
+	Class           string
+	StartDate       time.Time
+	EndDate         time.Time
+	Duration        time.Duration
+	PlannedDuration time.Duration
+	EndOnNext       bool
+	Scte35Cmd       []byte
+	Scte35Out       []byte
+	Scte35In        []byte
+	Entry           *M3U8Entry
+}
+
+//MasterPlaylist - typed view of a Multivariant Playlist
+type MasterPlaylist struct {
+	Version             int64
+	IndependentSegments bool
+	Start               *Start
+	Variants            []Variant
+	Renditions          []Rendition
+	IFrameVariants      []IFrameVariant
+	SessionData         []SessionData
+	SessionKeys         []Key
+	Defines             []Define
+	ContentSteering     *ContentSteering
+}
+
+//MediaPlaylist - typed view of a Media Playlist
+type MediaPlaylist struct {
+	Version               int64
+	TargetDuration        time.Duration
+	MediaSequence         int64
+	DiscontinuitySequence int64
+	PlaylistType          string
+	EndList               bool
+	IFramesOnly           bool
+	IndependentSegments   bool
+	Start                 *Start
+	ServerControl         *ServerControl
+	PartTarget            time.Duration
+	SkippedSegments       int64
+	Segments              []Segment
+	Parts                 []Part
+	PreloadHints          []PreloadHint
+	RenditionReports      []RenditionReport
+	DateRanges            []DateRange
+	Defines               []Define
+}
+
+//IsMasterPlaylist - true if any of the Multivariant Playlist tags are present
+func (m *M3U8) IsMasterPlaylist() bool {
+	for _, entry := range m.Entries {
+		switch entry.Tag {
+		case common.M3U8ExtXStreamInf, common.M3U8ExtXMedia, common.M3U8ExtXIFrameStreamInf,
+			common.M3U8ExtXSessionData, common.M3U8ExtXSesionKey, common.M3U8ExtXContentSteering:
+			return true
+		}
+	}
+	return false
+}
+
+func readKey(entry *M3U8Entry) (Key, error) {
+	r := newAttrReader(entry)
+	k := Key{
+		Method:            r.str(common.M3U8Method),
+		URI:               r.str(common.M3U8Uri),
+		IV:                r.hex(common.M3U8IV),
+		KeyFormat:         r.str(common.M3U8KeyFormat),
+		KeyFormatVersions: r.list(common.M3U8KeyFormatVersions, "/"),
+		Entry:             entry,
+	}
+	return k, r.err
+}
+
+func readDefine(r *attrReader, entry *M3U8Entry) Define {
+	return Define{
+		Name:       r.str(common.M3U8Name),
+		Value:      r.str(common.M3U8Value),
+		Import:     r.str(common.M3U8Import),
+		QueryParam: r.str(common.M3U8QueryParam),
+		Entry:      entry,
+	}
+}
+
+func readStart(entry *M3U8Entry) (*Start, error) {
+	r := newAttrReader(entry)
+	s := &Start{
+		TimeOffset: r.duration(common.M3U8TimeOffset),
+		Precise:    r.bool(common.M3U8Precise),
+	}
+	return s, r.err
+}
+
+//MasterPlaylist - builds the typed Multivariant Playlist from Entries
+func (m *M3U8) MasterPlaylist() (*MasterPlaylist, error) {
+	toret := &MasterPlaylist{}
+	for i := range m.Entries {
+		entry := &m.Entries[i]
+		r := newAttrReader(entry)
+		switch entry.Tag {
+		case common.M3U8ExtXVersion:
+			toret.Version = r.int64(common.INTUnknownAttr)
+		case common.M3U8ExtXIndependentSegments:
+			toret.IndependentSegments = true
+		case common.M3U8ExtXStart:
+			toret.Start, r.err = readStart(entry)
+		case common.M3U8ExtXDefine:
+			toret.Defines = append(toret.Defines, readDefine(r, entry))
+		case common.M3U8ExtXStreamInf:
+			score := float64(-1)
+			if r.has(common.M3U8Score) {
+				score = r.float64(common.M3U8Score)
+			}
+			toret.Variants = append(toret.Variants, Variant{
+				Bandwidth:        r.int64(common.M3U8Bandwidth),
+				AverageBandwidth: r.int64(common.M3U8AverageBandwidth),
+				Codecs:           r.list(common.M3U8Codecs, ","),
+				Resolution:       r.resolution(common.M3U8Resolution),
+				FrameRate:        r.float64(common.M3U8FrameRate),
+				HDCPLevel:        r.str(common.M3U8HdcpLevel),
+				Audio:            r.str(common.M3U8Audio),
+				Video:            r.str(common.M3U8Video),
+				Subtitles:        r.str(common.M3U8Subtitles),
+				ClosedCaptions:   r.str(common.M3U8ClosedCaptions),
+				ProgramId:        r.int64(common.M3U8ProgramId),
+				PathwayId:        r.str(common.M3U8PathwayId),
+				VideoRange:       r.str(common.M3U8VideoRange),
+				Score:            score,
+				URI:              r.str(common.INTUnknownAttr),
+				Entry:            entry,
+			})
+		case common.M3U8ExtXIFrameStreamInf:
+			toret.IFrameVariants = append(toret.IFrameVariants, IFrameVariant{
+				Bandwidth:        r.int64(common.M3U8Bandwidth),
+				AverageBandwidth: r.int64(common.M3U8AverageBandwidth),
+				Codecs:           r.list(common.M3U8Codecs, ","),
+				Resolution:       r.resolution(common.M3U8Resolution),
+				HDCPLevel:        r.str(common.M3U8HdcpLevel),
+				Video:            r.str(common.M3U8Video),
+				PathwayId:        r.str(common.M3U8PathwayId),
+				VideoRange:       r.str(common.M3U8VideoRange),
+				URI:              r.str(common.M3U8Uri),
+				Entry:            entry,
+			})
+		case common.M3U8ExtXMedia:
+			toret.Renditions = append(toret.Renditions, Rendition{
+				Type:            r.str(common.M3U8Type),
+				URI:             r.str(common.M3U8Uri),
+				GroupId:         r.str(common.M3U8GroupId),
+				Language:        r.str(common.M3U8Language),
+				AssocLanguage:   r.str(common.M3U8AssocLanguage),
+				Name:            r.str(common.M3U8Name),
+				Default:         r.bool(common.M3U8Default),
+				AutoSelect:      r.bool(common.M3U8AutoSelect),
+				Forced:          r.bool(common.M3U8Forced),
+				InStreamId:      r.str(common.M3U8InStreamId),
+				Characteristics: r.list(common.M3U8Characteristics, ","),
+				Channels:        r.str(common.M3U8Channels),
+				PathwayId:       r.str(common.M3U8PathwayId),
+				Entry:           entry,
+			})
+		case common.M3U8ExtXSessionData:
+			toret.SessionData = append(toret.SessionData, SessionData{
+				DataId:   r.str(common.M3U8DataId),
+				Value:    r.str(common.M3U8Value),
+				URI:      r.str(common.M3U8Uri),
+				Language: r.str(common.M3U8Language),
+				Entry:    entry,
+			})
+		case common.M3U8ExtXContentSteering:
+			toret.ContentSteering = &ContentSteering{
+				ServerURI: r.str(common.M3U8ServerUri),
+				PathwayId: r.str(common.M3U8PathwayId),
+				Entry:     entry,
+			}
+		case common.M3U8ExtXSesionKey:
+			var k Key
+			k, r.err = readKey(entry)
+			toret.SessionKeys = append(toret.SessionKeys, k)
+		}
+		if r.err != nil {
+			return nil, r.err
+		}
+	}
+	return toret, nil
+}
+
+//MediaPlaylist - builds the typed Media Playlist from Entries
+func (m *M3U8) MediaPlaylist() (*MediaPlaylist, error) {
+	toret := &MediaPlaylist{}
+	for i := range m.Entries {
+		entry := &m.Entries[i]
+		r := newAttrReader(entry)
+		switch entry.Tag {
+		case common.M3U8ExtXVersion:
+			toret.Version = r.int64(common.INTUnknownAttr)
+		case common.M3U8TargetDuration:
+			toret.TargetDuration = time.Duration(r.int64(common.INTUnknownAttr)) * time.Second
+		case common.M3U8ExtXMediaSequence:
+			toret.MediaSequence = r.int64(common.INTUnknownAttr)
+		case common.M3U8ExtXDiscontinuitySequence:
+			toret.DiscontinuitySequence = r.int64(common.INTUnknownAttr)
+		case common.M3U8ExtXPlaylistType:
+			toret.PlaylistType = r.str(common.INTUnknownAttr)
+		case common.M3U8ExtXEndList:
+			toret.EndList = true
+		case common.M3U8ExtXIFramesOnly:
+			toret.IFramesOnly = true
+		case common.M3U8ExtXIndependentSegments:
+			toret.IndependentSegments = true
+		case common.M3U8ExtXStart:
+			toret.Start, r.err = readStart(entry)
+		case common.M3U8ExtXDefine:
+			toret.Defines = append(toret.Defines, readDefine(r, entry))
+		case common.M3U8ExtXServerControl:
+			toret.ServerControl = &ServerControl{
+				CanBlockReload:    r.bool(common.M3U8CanBlockReload),
+				CanSkipUntil:      r.duration(common.M3U8CanSkipUntil),
+				CanSkipDateRanges: r.bool(common.M3U8CanSkipDateRanges),
+				HoldBack:          r.duration(common.M3U8HoldBack),
+				PartHoldBack:      r.duration(common.M3U8PartHoldBack),
+			}
+		case common.M3U8ExtXPartInf:
+			toret.PartTarget = r.duration(common.M3U8PartTarget)
+		case common.M3U8XSkip:
+			toret.SkippedSegments = r.int64(common.M3U8SkippedSegments)
+		case common.M3U8ExtXPreLoadHint:
+			hint := PreloadHint{
+				Type:            r.str(common.M3U8Type),
+				URI:             r.str(common.M3U8Uri),
+				ByteRangeStart:  r.int64(common.M3U8ByteRangeStart),
+				ByteRangeLength: -1,
+				ProgramDateTime: r.time(common.INTProgramDateTime),
+				Entry:           entry,
+			}
+			if r.has(common.M3U8ByteRangeLength) {
+				hint.ByteRangeLength = r.int64(common.M3U8ByteRangeLength)
+			}
+			toret.PreloadHints = append(toret.PreloadHints, hint)
+		case common.M3U8ExtXRenditionReport:
+			report := RenditionReport{
+				URI:      r.str(common.M3U8Uri),
+				LastMsn:  r.int64(common.M3U8LastMsn),
+				LastPart: -1,
+				Entry:    entry,
+			}
+			if r.has(common.M3U8LastPart) {
+				report.LastPart = r.int64(common.M3U8LastPart)
+			}
+			toret.RenditionReports = append(toret.RenditionReports, report)
+		case common.M3U8ExtXDataRange:
+			toret.DateRanges = append(toret.DateRanges, DateRange{
+				Id:              r.str(common.M3U8Id),
+				Class:           r.str(common.M3U8Class),
+				StartDate:       r.time(common.M3U8StartDate),
+				EndDate:         r.time(common.M3U8EndDate),
+				Duration:        r.duration(common.M3U8Duration),
+				PlannedDuration: r.duration(common.M3U8PlannedDuration),
+				EndOnNext:       r.bool(common.M3U8EndOnNext),
+				Scte35Cmd:       r.hex(common.M3U8Scte35Cmd),
+				Scte35Out:       r.hex(common.M3U8Scte35Out),
+				Scte35In:        r.hex(common.M3U8Scte35In),
+				Entry:           entry,
+			})
+		}
+		if r.err != nil {
+			return nil, r.err
+		}
+	}
+	var err error
+	var trailing []Part
+	var pdt time.Time
+	toret.Segments, trailing, pdt, err = m.segments()
+	if err != nil {
+		return nil, err
+	}
+	if pdt.IsZero() {
+		//no EXT-X-PROGRAM-DATE-TIME applies after the last segment
+		for i := range toret.PreloadHints {
+			toret.PreloadHints[i].ProgramDateTime = time.Time{}
+		}
+	}
+	for _, seg := range toret.Segments {
+		toret.Parts = append(toret.Parts, seg.Parts...)
+	}
+	toret.Parts = append(toret.Parts, trailing...)
+	return toret, nil
+}
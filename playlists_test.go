@@ -0,0 +1,415 @@
+package m3u8reader_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eswarantg/m3u8reader"
+	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+func readManifest(t *testing.T, file string) *m3u8reader.M3U8 {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("Unable to open file %v", file)
+	}
+	parsers.AttrKVPairsSyncPool = false
+	manifest := &m3u8reader.M3U8{}
+	manifest.SetBuffer(make([]byte, 4096))
+	_, err = manifest.ParseData(data)
+	if err != nil {
+		t.Fatalf("%v : %v", file, err)
+	}
+	return manifest
+}
+
+func Test_MasterPlaylist(t *testing.T) {
+	manifest := readManifest(t, "test/main-manifest.m3u8")
+	if !manifest.IsMasterPlaylist() {
+		t.Fatalf("expected master playlist")
+	}
+	master, err := manifest.MasterPlaylist()
+	if err != nil {
+		t.Fatalf("MasterPlaylist : %v", err)
+	}
+	if master.Version != 6 || !master.IndependentSegments {
+		t.Errorf("version/independent segments : got %v/%v", master.Version, master.IndependentSegments)
+	}
+	if len(master.Variants) != 4 {
+		t.Fatalf("variants expected %v : got %v", 4, len(master.Variants))
+	}
+	v := master.Variants[0]
+	if v.Bandwidth != 2519768 || v.AverageBandwidth != 1983848 {
+		t.Errorf("bandwidth : got %v/%v", v.Bandwidth, v.AverageBandwidth)
+	}
+	if v.Resolution != (common.Resolution{Width: 1024, Height: 576}) {
+		t.Errorf("resolution : got %v", v.Resolution)
+	}
+	if v.FrameRate != 25 {
+		t.Errorf("frame rate : got %v", v.FrameRate)
+	}
+	if len(v.Codecs) != 2 || v.Codecs[0] != "avc1.4d401f" || v.Codecs[1] != "mp4a.40.2" {
+		t.Errorf("codecs : got %v", v.Codecs)
+	}
+	if v.Audio != "AudioMaster" || v.URI != "sixhd_TS-49410_1_video.m3u8" {
+		t.Errorf("audio/uri : got %v/%v", v.Audio, v.URI)
+	}
+	if len(master.Renditions) != 1 {
+		t.Fatalf("renditions expected %v : got %v", 1, len(master.Renditions))
+	}
+	r := master.Renditions[0]
+	if r.Type != "AUDIO" || r.GroupId != "AudioMaster" || r.Language != "eng" ||
+		!r.Default || !r.AutoSelect || r.Forced || r.URI != "sixhd_TS-49410_3_audio.m3u8" {
+		t.Errorf("rendition : got %+v", r)
+	}
+}
+
+func Test_MediaPlaylist(t *testing.T) {
+	manifest := readManifest(t, "test/ll_hls_pl.m3u8")
+	if manifest.IsMasterPlaylist() {
+		t.Fatalf("expected media playlist")
+	}
+	media, err := manifest.MediaPlaylist()
+	if err != nil {
+		t.Fatalf("MediaPlaylist : %v", err)
+	}
+	if media.TargetDuration != 4*time.Second || media.MediaSequence != 266 || media.Version != 6 {
+		t.Errorf("header : got %v/%v/%v", media.TargetDuration, media.MediaSequence, media.Version)
+	}
+	if media.ServerControl == nil {
+		t.Fatalf("server control expected")
+	}
+	if !media.ServerControl.CanBlockReload || media.ServerControl.PartHoldBack != time.Second ||
+		media.ServerControl.CanSkipUntil != 12*time.Second {
+		t.Errorf("server control : got %+v", *media.ServerControl)
+	}
+	if media.PartTarget != 333340*time.Microsecond {
+		t.Errorf("part target : got %v", media.PartTarget)
+	}
+	if len(media.Segments) == 0 {
+		t.Fatalf("segments expected")
+	}
+	seg := media.Segments[0]
+	if seg.SequenceNumber != 266 || seg.Duration != 4000080*time.Microsecond || seg.URI != "fileSequence266.mp4" {
+		t.Errorf("segment : got %v/%v/%v", seg.SequenceNumber, seg.Duration, seg.URI)
+	}
+	pdt, _ := time.Parse(time.RFC3339Nano, "2019-02-14T02:13:36.106Z")
+	if !seg.ProgramDateTime.Equal(pdt) {
+		t.Errorf("program date time : got %v", seg.ProgramDateTime)
+	}
+	if len(media.Parts) == 0 {
+		t.Fatalf("parts expected")
+	}
+	part := media.Parts[4]
+	if part.SequenceNumber != 271 || part.PartNumber != 4 || !part.Independent || part.URI != "filePart271.4.mp4" {
+		t.Errorf("part : got %v/%v/%v/%v", part.SequenceNumber, part.PartNumber, part.Independent, part.URI)
+	}
+	if len(media.PreloadHints) != 1 || len(media.RenditionReports) == 0 {
+		t.Errorf("preload hints/rendition reports : got %v/%v", len(media.PreloadHints), len(media.RenditionReports))
+	}
+	if part.ProgramDateTime.IsZero() || (len(media.PreloadHints) > 0 && media.PreloadHints[0].ProgramDateTime.IsZero()) {
+		t.Errorf("part/preload hint program date time expected")
+	}
+}
+
+func Test_MediaPlaylistNoPDT(t *testing.T) {
+	manifest := parseString(t, strings.Join([]string{
+		"#EXTM3U",
+		"#EXT-X-VERSION:6",
+		"#EXT-X-TARGETDURATION:4",
+		"#EXT-X-PART-INF:PART-TARGET=1.0",
+		"#EXT-X-MEDIA-SEQUENCE:10",
+		"#EXT-X-PART:DURATION=1.0,URI=\"part10.0.mp4\"",
+		"#EXTINF:1.0,",
+		"seg10.mp4",
+		"#EXT-X-PART:DURATION=1.0,URI=\"part11.0.mp4\"",
+		"#EXT-X-PRELOAD-HINT:TYPE=PART,URI=\"part11.1.mp4\"",
+	}, "\n"))
+	media, err := manifest.MediaPlaylist()
+	if err != nil {
+		t.Fatalf("MediaPlaylist : %v", err)
+	}
+	if len(media.Segments) != 1 || len(media.Parts) != 2 || len(media.PreloadHints) != 1 {
+		t.Fatalf("segments/parts/preload hints : got %v/%v/%v", len(media.Segments), len(media.Parts), len(media.PreloadHints))
+	}
+	//no EXT-X-PROGRAM-DATE-TIME, no date time
+	if !media.Segments[0].ProgramDateTime.IsZero() {
+		t.Errorf("segment : no program date time expected : got %v", media.Segments[0].ProgramDateTime)
+	}
+	for _, part := range media.Parts {
+		if !part.ProgramDateTime.IsZero() {
+			t.Errorf("%v : no program date time expected : got %v", part.URI, part.ProgramDateTime)
+		}
+	}
+	if !media.PreloadHints[0].ProgramDateTime.IsZero() {
+		t.Errorf("preload hint : no program date time expected : got %v", media.PreloadHints[0].ProgramDateTime)
+	}
+}
+
+func Test_ParseByteRange(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected m3u8reader.ByteRange
+		err      bool
+	}{
+		{"1000", m3u8reader.ByteRange{Length: 1000, Offset: -1}, false},
+		{"1000@200", m3u8reader.ByteRange{Length: 1000, Offset: 200}, false},
+		{"a@200", m3u8reader.ByteRange{}, true},
+		{"1@2@3", m3u8reader.ByteRange{}, true},
+	}
+	for _, test := range tests {
+		br, err := m3u8reader.ParseByteRange(test.in)
+		if test.err {
+			if err == nil {
+				t.Errorf("%v : expected error", test.in)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v : %v", test.in, err)
+			continue
+		}
+		if *br != test.expected {
+			t.Errorf("%v : expected %v : got %v", test.in, test.expected, *br)
+		}
+	}
+}
+
+func Test_Segments(t *testing.T) {
+	data := strings.Join([]string{
+		"#EXTM3U",
+		"#EXT-X-VERSION:7",
+		"#EXT-X-TARGETDURATION:4",
+		"#EXT-X-MEDIA-SEQUENCE:10",
+		"#EXT-X-DISCONTINUITY-SEQUENCE:3",
+		"#EXT-X-MAP:URI=\"init.mp4\",BYTERANGE=\"720\"",
+		"#EXT-X-KEY:METHOD=AES-128,URI=\"k1\"",
+		"#EXT-X-PROGRAM-DATE-TIME:2022-01-20T12:16:44.000Z",
+		"#EXT-X-BYTERANGE:1000@720",
+		"#EXTINF:4.0,",
+		"main.mp4",
+		"#EXT-X-BYTERANGE:2000",
+		"#EXTINF:4.0,",
+		"main.mp4",
+		"#EXT-X-KEY:METHOD=NONE",
+		"#EXT-X-DISCONTINUITY",
+		"#EXT-X-MAP:URI=\"init2.mp4\"",
+		"#EXTINF:4.0,",
+		"seg12.mp4",
+		"#EXT-X-KEY:METHOD=SAMPLE-AES,URI=\"k2\",KEYFORMAT=\"com.apple.streamingkeydelivery\"",
+		"#EXT-X-PART:DURATION=2.0,URI=\"seg13.mp4\",BYTERANGE=\"500@0\"",
+		"#EXT-X-PART:DURATION=2.0,URI=\"seg13.mp4\",BYTERANGE=\"600\"",
+		"#EXTINF:4.0,",
+		"seg13.mp4",
+		"#EXT-X-PART:DURATION=2.0,URI=\"seg14.0.mp4\"",
+		"",
+	}, "\n")
+	parsers.AttrKVPairsSyncPool = false
+	manifest := m3u8reader.M3U8{}
+	manifest.SetBuffer(make([]byte, 4096))
+	_, err := manifest.ParseData([]byte(data))
+	if err != nil {
+		t.Fatalf("ParseData : %v", err)
+	}
+	segments, err := manifest.Segments()
+	if err != nil {
+		t.Fatalf("Segments : %v", err)
+	}
+	if len(segments) != 4 {
+		t.Fatalf("segments expected %v : got %v", 4, len(segments))
+	}
+	pdt, _ := time.Parse(time.RFC3339Nano, "2022-01-20T12:16:44.000Z")
+	tests := []struct {
+		seq       int64
+		keys      int
+		mapURI    string
+		byteRange *m3u8reader.ByteRange
+		discSeq   int64
+		disc      bool
+		pdt       time.Time
+		parts     int
+	}{
+		{10, 1, "init.mp4", &m3u8reader.ByteRange{Length: 1000, Offset: 720}, 3, false, pdt, 0},
+		{11, 1, "init.mp4", &m3u8reader.ByteRange{Length: 2000, Offset: 1720}, 3, false, pdt.Add(4 * time.Second), 0},
+		{12, 0, "init2.mp4", nil, 4, true, time.Time{}, 0},
+		{13, 1, "init2.mp4", nil, 4, false, time.Time{}, 2},
+	}
+	for i, test := range tests {
+		seg := segments[i]
+		if seg.SequenceNumber != test.seq || len(seg.Keys) != test.keys || seg.Map == nil || seg.Map.URI != test.mapURI {
+			t.Errorf("%v : seq/keys/map : got %v/%v/%v", i, seg.SequenceNumber, len(seg.Keys), seg.Map)
+		}
+		if (seg.ByteRange == nil) != (test.byteRange == nil) || (seg.ByteRange != nil && *seg.ByteRange != *test.byteRange) {
+			t.Errorf("%v : byte range expected %v : got %v", i, test.byteRange, seg.ByteRange)
+		}
+		if seg.DiscontinuitySequence != test.discSeq || seg.Discontinuity != test.disc {
+			t.Errorf("%v : discontinuity : got %v/%v", i, seg.DiscontinuitySequence, seg.Discontinuity)
+		}
+		if !seg.ProgramDateTime.Equal(test.pdt) {
+			t.Errorf("%v : program date time expected %v : got %v", i, test.pdt, seg.ProgramDateTime)
+		}
+		if len(seg.Parts) != test.parts {
+			t.Errorf("%v : parts expected %v : got %v", i, test.parts, len(seg.Parts))
+		}
+	}
+	if *segments[0].Map.ByteRange != (m3u8reader.ByteRange{Length: 720, Offset: 0}) {
+		t.Errorf("map byte range : got %v", segments[0].Map.ByteRange)
+	}
+	if segments[3].Keys[0].KeyFormat != "com.apple.streamingkeydelivery" {
+		t.Errorf("key format : got %v", segments[3].Keys[0].KeyFormat)
+	}
+	if br := segments[3].Parts[1].ByteRange; br == nil || *br != (m3u8reader.ByteRange{Length: 600, Offset: 500}) {
+		t.Errorf("part byte range : got %v", br)
+	}
+}
+
+func Test_SegmentsGap(t *testing.T) {
+	manifest := readManifest(t, "test/ll_hls_gap.m3u8")
+	segments, err := manifest.Segments()
+	if err != nil {
+		t.Fatalf("Segments : %v", err)
+	}
+	if len(segments) != 3 {
+		t.Fatalf("segments expected %v : got %v", 3, len(segments))
+	}
+	tests := []struct {
+		gap     bool
+		bitrate int64
+	}{
+		{false, 2500},
+		{true, 2500},
+		{false, 1800},
+	}
+	for i, test := range tests {
+		if segments[i].Gap != test.gap || segments[i].Bitrate != test.bitrate {
+			t.Errorf("%v : gap/bitrate expected %v/%v : got %v/%v", i, test.gap, test.bitrate, segments[i].Gap, segments[i].Bitrate)
+		}
+	}
+	media, err := manifest.MediaPlaylist()
+	if err != nil {
+		t.Fatalf("MediaPlaylist : %v", err)
+	}
+	if len(media.Parts) != 3 || media.Parts[0].Gap || !media.Parts[1].Gap {
+		t.Errorf("part gap : got %+v", media.Parts)
+	}
+}
+
+func Test_ParseErrorPosition(t *testing.T) {
+	data := strings.Join([]string{
+		"#EXTM3U",
+		"#EXT-X-TARGETDURATION:4",
+		"# vendor comment",
+		"#EXTINF:4.0,",
+		"seg1.ts",
+		"#EXTINF:abc,",
+		"seg2.ts",
+		"",
+	}, "\n")
+	parsers.AttrKVPairsSyncPool = false
+	for _, lossless := range []bool{false, true} {
+		manifest := m3u8reader.M3U8{}
+		manifest.SetLossless(lossless)
+		_, err := manifest.ParseData([]byte(data))
+		var pe *parsers.ParseError
+		if !errors.As(err, &pe) || !errors.Is(err, parsers.ErrBadValue) {
+			t.Errorf("lossless %v : bad value ParseError expected : got %v", lossless, err)
+			continue
+		}
+		if pe.Line != 6 || pe.Offset != strings.Index(data, "#EXTINF:abc") || pe.Tag != common.M3U8ExtInf {
+			t.Errorf("lossless %v : line/offset/tag : got %v/%v/%v", lossless, pe.Line, pe.Offset, common.TagNames[pe.Tag])
+		}
+	}
+}
+
+func Test_Lenient(t *testing.T) {
+	data := strings.Join([]string{
+		"#EXTM3U",
+		"#EXT-X-TARGETDURATION:4",
+		"#EXT-X-MEDIA-SEQUENCE:10",
+		"#EXTINF:4.0,",
+		"seg1.ts",
+		"#EXTINF:abc,",
+		"seg2.ts",
+		"#EXT-X-KEY:METHOD=AES-128,URI=\"key.bin\",IV=0xZZ",
+		"#EXTINF:4.0,",
+		"seg3.ts",
+		"",
+	}, "\n")
+	parsers.AttrKVPairsSyncPool = false
+	options := []m3u8reader.ParserOption{
+		m3u8reader.M3U8ParserScanner1,
+		m3u8reader.M3U8ParserScanner2,
+		m3u8reader.M3U8ParserScanner3,
+		m3u8reader.M3U8ParserGrammar,
+		m3u8reader.M3U8ParserYacc,
+	}
+	for _, opt := range options {
+		for _, lossless := range []bool{false, true} {
+			name := fmt.Sprintf("option %v lossless %v", opt, lossless)
+			manifest := m3u8reader.M3U8{}
+			manifest.SetParserOption(opt)
+			manifest.SetLossless(lossless)
+			_, err := manifest.ParseData([]byte(data))
+			if err == nil {
+				t.Errorf("%v : strict error expected", name)
+			}
+			manifest.SetLenient(true)
+			_, err = manifest.ParseData([]byte(data))
+			if err != nil {
+				t.Errorf("%v : %v", name, err)
+				continue
+			}
+			var lines []int
+			for _, d := range manifest.Diagnostics {
+				if d.Severity != m3u8reader.SeverityError {
+					t.Errorf("%v : severity expected %v : got %v", name, m3u8reader.SeverityError, d.Severity)
+				}
+				lines = append(lines, d.Line)
+			}
+			expected := []int{6, 8}
+			if fmt.Sprint(lines) != fmt.Sprint(expected) {
+				t.Errorf("%v : diagnostic lines expected %v : got %v : %v", name, expected, lines, manifest.Diagnostics)
+			}
+			segments, err := manifest.Segments()
+			if err != nil {
+				t.Errorf("%v : Segments : %v", name, err)
+				continue
+			}
+			if len(segments) != 2 || segments[1].URI != "seg3.ts" || segments[1].SequenceNumber != 12 {
+				t.Errorf("%v : segments expected seg1.ts, seg3.ts : got %+v", name, segments)
+			}
+			if lossless && manifest.String() == "" {
+				t.Errorf("%v : entries expected", name)
+			}
+		}
+	}
+}
+
+func Test_LenientValid(t *testing.T) {
+	files := []string{"test/LLHLS.m3u8", "test/master.m3u8", "test/hls-ts-main.m3u8"}
+	for _, file := range files {
+		strict := readManifest(t, file)
+		data, _ := os.ReadFile(file)
+		lenient := m3u8reader.M3U8{}
+		lenient.SetLenient(true)
+		_, err := lenient.ParseData(data)
+		if err != nil || len(lenient.Diagnostics) != 0 {
+			t.Errorf("%v : no diagnostics expected : got %v %v", file, err, lenient.Diagnostics)
+		}
+		if len(lenient.Entries) != len(strict.Entries) {
+			t.Errorf("%v : entries expected %v : got %v", file, len(strict.Entries), len(lenient.Entries))
+		}
+	}
+	lenient := m3u8reader.M3U8{}
+	lenient.SetLenient(true)
+	_, err := lenient.ParseData([]byte("#EXT-X-TARGETDURATION:4\n#EXTINF:4.0,\nseg1.ts\n"))
+	if err != nil || len(lenient.Diagnostics) != 1 || lenient.Diagnostics[0].Severity != m3u8reader.SeverityWarning ||
+		lenient.Diagnostics[0].Tag != common.M3U8FormatIdentifier || len(lenient.Entries) != 2 {
+		t.Errorf("missing header : warning expected : got %v %v", err, lenient.Diagnostics)
+	}
+}
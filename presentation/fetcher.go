@@ -0,0 +1,70 @@
+package presentation
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+//Fetcher - reads the playlists of a Presentation
+type Fetcher interface {
+	//Fetch - body of the playlist at u
+	//base is the URL the relative URIs of the playlist are resolved against, u unless redirected
+	Fetch(ctx context.Context, u *url.URL) (body []byte, base *url.URL, err error)
+}
+
+//HTTPFetcher - playlists served over HTTP
+type HTTPFetcher struct {
+	//Client - http.DefaultClient if nil
+	Client *http.Client
+}
+
+func (f *HTTPFetcher) Fetch(ctx context.Context, u *url.URL) (body []byte, base *url.URL, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return
+	}
+	client := f.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("playlist %v : status %v", u, resp.Status)
+		return
+	}
+	body, err = io.ReadAll(resp.Body)
+	//RFC 8216bis section 4.1, relative to the URL after redirects
+	return body, resp.Request.URL, err
+}
+
+//FileFetcher - playlists read from files, the path of the URL is the path of the file
+type FileFetcher struct {
+	//FS - the path is taken relative to its root, the local filesystem if nil
+	FS fs.FS
+}
+
+func (f *FileFetcher) Fetch(ctx context.Context, u *url.URL) (body []byte, base *url.URL, err error) {
+	if u.Scheme != "" && u.Scheme != "file" {
+		err = fmt.Errorf("playlist %v : not a file", u)
+		return
+	}
+	if err = ctx.Err(); err != nil {
+		return
+	}
+	if f.FS == nil {
+		body, err = os.ReadFile(u.Path)
+	} else {
+		body, err = fs.ReadFile(f.FS, strings.TrimPrefix(u.Path, "/"))
+	}
+	return body, u, err
+}
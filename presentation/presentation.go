@@ -0,0 +1,205 @@
+package presentation
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+
+	"github.com/eswarantg/m3u8reader"
+)
+
+//DefaultParallelism - playlists loaded at the same time when Loader.Parallelism is 0
+const DefaultParallelism = 4
+
+//Playlist - Media Playlist of the Presentation, loaded once however many entries refer to it
+type Playlist struct {
+	URL   *url.URL //nil if the URI could not be resolved
+	M3U8  *m3u8reader.M3U8
+	Media *m3u8reader.MediaPlaylist
+	Err   error //M3U8 and Media are nil if the playlist could not be loaded
+}
+
+//Variant - EXT-X-STREAM-INF and its Media Playlist
+type Variant struct {
+	m3u8reader.Variant
+	Playlist *Playlist
+}
+
+//IFrameVariant - EXT-X-I-FRAME-STREAM-INF and its I-frame Media Playlist
+type IFrameVariant struct {
+	m3u8reader.IFrameVariant
+	Playlist *Playlist
+}
+
+//Rendition - EXT-X-MEDIA and its Media Playlist, nil if the rendition has no URI
+type Rendition struct {
+	m3u8reader.Rendition
+	Playlist *Playlist
+}
+
+//Presentation - Multivariant Playlist along with all the Media Playlists it refers to
+type Presentation struct {
+	URL    *url.URL //base URL of the Multivariant Playlist
+	M3U8   *m3u8reader.M3U8
+	Master *m3u8reader.MasterPlaylist
+	//Variables - EXT-X-DEFINE of the Multivariant Playlist, imported by the Media Playlists
+	Variables      map[string]string
+	Variants       []Variant
+	IFrameVariants []IFrameVariant
+	Renditions     []Rendition
+	Playlists      []*Playlist //in the order they are first referred to
+
+	byEntry map[*m3u8reader.M3U8Entry]*Playlist
+}
+
+//PlaylistOf - Media Playlist of the EXT-X-STREAM-INF, EXT-X-I-FRAME-STREAM-INF or EXT-X-MEDIA entry
+//nil if the entry refers to none
+func (p *Presentation) PlaylistOf(entry *m3u8reader.M3U8Entry) *Playlist {
+	return p.byEntry[entry]
+}
+
+//Err - error of the first Media Playlist not loaded, nil if all are loaded
+func (p *Presentation) Err() error {
+	for _, pl := range p.Playlists {
+		if pl.Err != nil {
+			return pl.Err
+		}
+	}
+	return nil
+}
+
+//Loader - loads Presentations
+type Loader struct {
+	Fetcher Fetcher
+	//Parallelism - Media Playlists loaded at the same time, DefaultParallelism if 0
+	Parallelism  int
+	ParserOption m3u8reader.ParserOption
+	QueryInherit m3u8reader.QueryInherit
+}
+
+func NewLoader(fetcher Fetcher) *Loader {
+	return &Loader{Fetcher: fetcher}
+}
+
+//parse - fetches the playlist and substitutes its variables, parent as in M3U8.SubstituteVariables
+func (l *Loader) parse(ctx context.Context, u *url.URL, parent map[string]string) (m *m3u8reader.M3U8, vars map[string]string, err error) {
+	body, base, err := l.Fetcher.Fetch(ctx, u)
+	if err != nil {
+		return
+	}
+	if base == nil {
+		base = u
+	}
+	m = &m3u8reader.M3U8{}
+	m.SetParserOption(l.ParserOption)
+	m.SetBaseURL(base)
+	m.SetQueryInherit(l.QueryInherit)
+	_, err = m.ParseData(body)
+	if err == nil {
+		vars, err = m.SubstituteVariables(parent, base.String())
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("playlist %v : %w", u, err)
+	}
+	return
+}
+
+//loadMedia - sets the M3U8 and Media of pl, or its Err
+func (l *Loader) loadMedia(ctx context.Context, pl *Playlist, vars map[string]string) {
+	m, _, err := l.parse(ctx, pl.URL, vars)
+	if err != nil {
+		pl.Err = err
+		return
+	}
+	if m.IsMasterPlaylist() {
+		pl.Err = fmt.Errorf("playlist %v : not a Media Playlist", pl.URL)
+		return
+	}
+	media, err := m.MediaPlaylist()
+	if err != nil {
+		pl.Err = fmt.Errorf("playlist %v : %w", pl.URL, err)
+		return
+	}
+	pl.M3U8, pl.Media = m, media
+}
+
+//Load - the Multivariant Playlist at masterURL and its Media Playlists
+//err is set if the Multivariant Playlist can't be loaded or ctx is done,
+//a Media Playlist not loaded has its Err set, see Presentation.Err
+func (l *Loader) Load(ctx context.Context, masterURL string) (p *Presentation, err error) {
+	u, err := url.Parse(masterURL)
+	if err != nil {
+		return
+	}
+	m, vars, err := l.parse(ctx, u, nil)
+	if err != nil {
+		return
+	}
+	if !m.IsMasterPlaylist() {
+		return nil, fmt.Errorf("playlist %v : not a Multivariant Playlist", u)
+	}
+	master, err := m.MasterPlaylist()
+	if err != nil {
+		return nil, fmt.Errorf("playlist %v : %w", u, err)
+	}
+	p = &Presentation{
+		URL:       m.BaseURL(),
+		M3U8:      m,
+		Master:    master,
+		Variables: vars,
+		byEntry:   make(map[*m3u8reader.M3U8Entry]*Playlist),
+	}
+	byURL := make(map[string]*Playlist)
+	link := func(entry *m3u8reader.M3U8Entry, uri string) *Playlist {
+		resolved, err := m.ResolveURI(uri)
+		if err != nil {
+			pl := &Playlist{Err: err}
+			p.Playlists = append(p.Playlists, pl)
+			p.byEntry[entry] = pl
+			return pl
+		}
+		pl, ok := byURL[resolved.String()]
+		if !ok {
+			pl = &Playlist{URL: resolved}
+			byURL[resolved.String()] = pl
+			p.Playlists = append(p.Playlists, pl)
+		}
+		p.byEntry[entry] = pl
+		return pl
+	}
+	for _, v := range master.Variants {
+		p.Variants = append(p.Variants, Variant{Variant: v, Playlist: link(v.Entry, v.URI)})
+	}
+	for _, v := range master.IFrameVariants {
+		p.IFrameVariants = append(p.IFrameVariants, IFrameVariant{IFrameVariant: v, Playlist: link(v.Entry, v.URI)})
+	}
+	for _, r := range master.Renditions {
+		rendition := Rendition{Rendition: r}
+		if r.URI != "" {
+			rendition.Playlist = link(r.Entry, r.URI)
+		}
+		p.Renditions = append(p.Renditions, rendition)
+	}
+
+	parallelism := l.Parallelism
+	if parallelism <= 0 {
+		parallelism = DefaultParallelism
+	}
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for _, pl := range p.Playlists {
+		if pl.Err != nil {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(pl *Playlist) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			l.loadMedia(ctx, pl, vars)
+		}(pl)
+	}
+	wg.Wait()
+	return p, ctx.Err()
+}
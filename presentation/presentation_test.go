@@ -0,0 +1,270 @@
+package presentation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/eswarantg/m3u8reader"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+const master = `#EXTM3U
+#EXT-X-VERSION:6
+#EXT-X-DEFINE:NAME="dir",VALUE="video"
+#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID="aac",NAME="English",LANGUAGE="en",DEFAULT=YES,URI="audio/en.m3u8"
+#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID="aac",NAME="French",LANGUAGE="fr",URI="audio/fr.m3u8"
+#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID="ac3",NAME="English",LANGUAGE="en",DEFAULT=YES,URI="audio/en.m3u8"
+#EXT-X-MEDIA:TYPE=CLOSED-CAPTIONS,GROUP-ID="cc",NAME="English",LANGUAGE="en",INSTREAM-ID="CC1"
+#EXT-X-STREAM-INF:BANDWIDTH=1000000,AUDIO="aac",CLOSED-CAPTIONS="cc"
+{$dir}/low.m3u8
+#EXT-X-STREAM-INF:BANDWIDTH=1100000,AUDIO="ac3",CLOSED-CAPTIONS="cc"
+{$dir}/low.m3u8
+#EXT-X-STREAM-INF:BANDWIDTH=3000000,AUDIO="aac",CLOSED-CAPTIONS="cc"
+{$dir}/high.m3u8
+#EXT-X-I-FRAME-STREAM-INF:BANDWIDTH=100000,URI="video/iframes.m3u8"
+`
+
+//mediaPlaylist - VOD playlist of count segments named prefix<n>.ts
+func mediaPlaylist(prefix string, count int) string {
+	var sb strings.Builder
+	sb.WriteString("#EXTM3U\n#EXT-X-VERSION:6\n#EXT-X-TARGETDURATION:4\n#EXT-X-PLAYLIST-TYPE:VOD\n")
+	for i := 0; i < count; i++ {
+		fmt.Fprintf(&sb, "#EXTINF:4.0,\n%v%v.ts\n", prefix, i)
+	}
+	sb.WriteString("#EXT-X-ENDLIST\n")
+	return sb.String()
+}
+
+func Test_LoadFile(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	fsys := fstest.MapFS{
+		"vod/master.m3u8":        {Data: []byte(master)},
+		"vod/audio/en.m3u8":      {Data: []byte(mediaPlaylist("en", 3))},
+		"vod/video/low.m3u8":     {Data: []byte("#EXTM3U\n#EXT-X-DEFINE:IMPORT=\"dir\"\n" + mediaPlaylist("{$dir}-low", 3)[len("#EXTM3U\n"):])},
+		"vod/video/high.m3u8":    {Data: []byte(mediaPlaylist("high", 3))},
+		"vod/video/iframes.m3u8": {Data: []byte(strings.Replace(mediaPlaylist("iframe", 3), "#EXT-X-PLAYLIST-TYPE:VOD\n", "#EXT-X-PLAYLIST-TYPE:VOD\n#EXT-X-I-FRAMES-ONLY\n", 1))},
+	}
+	p, err := NewLoader(&FileFetcher{FS: fsys}).Load(context.Background(), "/vod/master.m3u8")
+	if err != nil {
+		t.Fatalf("Load : %v", err)
+	}
+	//low, high, iframes, en, fr
+	if len(p.Playlists) != 5 || len(p.Variants) != 3 || len(p.IFrameVariants) != 1 || len(p.Renditions) != 4 {
+		t.Fatalf("presentation : got %v %v %v %v", len(p.Playlists), len(p.Variants), len(p.IFrameVariants), len(p.Renditions))
+	}
+	tests := []struct {
+		name     string
+		playlist *Playlist
+		url      string
+		first    string
+	}{
+		{"variant 0", p.Variants[0].Playlist, "/vod/video/low.m3u8", "video-low0.ts"},
+		{"variant 1", p.Variants[1].Playlist, "/vod/video/low.m3u8", "video-low0.ts"},
+		{"variant 2", p.Variants[2].Playlist, "/vod/video/high.m3u8", "high0.ts"},
+		{"iframes", p.IFrameVariants[0].Playlist, "/vod/video/iframes.m3u8", "iframe0.ts"},
+		{"rendition 0", p.Renditions[0].Playlist, "/vod/audio/en.m3u8", "en0.ts"},
+		{"rendition 2", p.Renditions[2].Playlist, "/vod/audio/en.m3u8", "en0.ts"},
+	}
+	for _, test := range tests {
+		pl := test.playlist
+		if pl == nil || pl.Err != nil || pl.URL.String() != test.url || len(pl.Media.Segments) != 3 || pl.Media.Segments[0].URI != test.first {
+			t.Errorf("%v : expected %v %v : got %+v", test.name, test.url, test.first, pl)
+		}
+	}
+	//loaded once
+	if p.Variants[0].Playlist != p.Variants[1].Playlist || p.Renditions[0].Playlist != p.Renditions[2].Playlist {
+		t.Errorf("shared playlists loaded more than once")
+	}
+	if !p.IFrameVariants[0].Playlist.Media.IFramesOnly {
+		t.Errorf("iframes : EXT-X-I-FRAMES-ONLY expected")
+	}
+	if p.Renditions[1].Playlist == nil || p.Renditions[1].Playlist.Err == nil || p.Err() != p.Renditions[1].Playlist.Err {
+		t.Errorf("missing playlist : error expected : got %+v %v", p.Renditions[1].Playlist, p.Err())
+	}
+	if p.Renditions[3].Playlist != nil {
+		t.Errorf("closed captions : no playlist expected : got %+v", p.Renditions[3].Playlist)
+	}
+	for _, v := range p.Variants {
+		if p.PlaylistOf(v.Entry) != v.Playlist {
+			t.Errorf("PlaylistOf %v : expected %v : got %v", v.URI, v.Playlist, p.PlaylistOf(v.Entry))
+		}
+	}
+	if p.Variables["dir"] != "video" {
+		t.Errorf("variables : got %v", p.Variables)
+	}
+}
+
+func Test_LoadHTTP(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	const httpMaster = `#EXTM3U
+#EXT-X-STREAM-INF:BANDWIDTH=1000000
+low.m3u8
+#EXT-X-STREAM-INF:BANDWIDTH=3000000
+/other/high.m3u8
+`
+	mux := http.NewServeMux()
+	mux.HandleFunc("/master.m3u8", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/v1/master.m3u8?token=abc", http.StatusFound)
+	})
+	serve := func(body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("token") != "abc" {
+				http.Error(w, "token expected", http.StatusForbidden)
+				return
+			}
+			w.Write([]byte(body))
+		}
+	}
+	mux.HandleFunc("/v1/master.m3u8", serve(httpMaster))
+	mux.HandleFunc("/v1/low.m3u8", serve(mediaPlaylist("low", 2)))
+	mux.HandleFunc("/other/high.m3u8", serve(mediaPlaylist("high", 2)))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		inherit m3u8reader.QueryInherit
+		loaded  int
+	}{
+		{"token not inherited", m3u8reader.QueryInheritNone, 0},
+		{"token inherited", m3u8reader.QueryInheritIfEmpty, 2},
+	}
+	for _, test := range tests {
+		l := NewLoader(&HTTPFetcher{Client: server.Client()})
+		l.QueryInherit = test.inherit
+		p, err := l.Load(context.Background(), server.URL+"/master.m3u8")
+		if err != nil {
+			t.Fatalf("%v : Load : %v", test.name, err)
+		}
+		if p.URL.String() != server.URL+"/v1/master.m3u8?token=abc" {
+			t.Errorf("%v : base URL expected after redirect : got %v", test.name, p.URL)
+		}
+		var loaded int
+		for _, pl := range p.Playlists {
+			if pl.Err == nil {
+				loaded++
+			}
+		}
+		if loaded != test.loaded {
+			t.Errorf("%v : loaded expected %v : got %v %v", test.name, test.loaded, loaded, p.Err())
+		}
+	}
+}
+
+//countingFetcher - records the most fetches at the same time
+type countingFetcher struct {
+	Fetcher
+	mu      sync.Mutex
+	current int
+	max     int
+}
+
+func (f *countingFetcher) Fetch(ctx context.Context, u *url.URL) ([]byte, *url.URL, error) {
+	f.mu.Lock()
+	f.current++
+	if f.current > f.max {
+		f.max = f.current
+	}
+	f.mu.Unlock()
+	time.Sleep(5 * time.Millisecond)
+	defer func() {
+		f.mu.Lock()
+		f.current--
+		f.mu.Unlock()
+	}()
+	return f.Fetcher.Fetch(ctx, u)
+}
+
+func Test_LoadParallelism(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	var sb strings.Builder
+	sb.WriteString("#EXTM3U\n")
+	fsys := fstest.MapFS{}
+	for i := 0; i < 8; i++ {
+		fmt.Fprintf(&sb, "#EXT-X-STREAM-INF:BANDWIDTH=%v\nv%v.m3u8\n", (i+1)*100000, i)
+		fsys[fmt.Sprintf("v%v.m3u8", i)] = &fstest.MapFile{Data: []byte(mediaPlaylist("seg", 1))}
+	}
+	fsys["master.m3u8"] = &fstest.MapFile{Data: []byte(sb.String())}
+	for _, parallelism := range []int{1, 3} {
+		f := &countingFetcher{Fetcher: &FileFetcher{FS: fsys}}
+		l := NewLoader(f)
+		l.Parallelism = parallelism
+		p, err := l.Load(context.Background(), "master.m3u8")
+		if err != nil || p.Err() != nil || len(p.Playlists) != 8 {
+			t.Errorf("%v : Load : got %v %v", parallelism, err, p)
+			continue
+		}
+		if f.max > parallelism {
+			t.Errorf("%v : fetches at the same time expected at most %v : got %v", parallelism, parallelism, f.max)
+		}
+	}
+}
+
+//nilBaseFetcher - returns no base URL, the URL fetched is used
+type nilBaseFetcher struct {
+	Fetcher
+}
+
+func (f *nilBaseFetcher) Fetch(ctx context.Context, u *url.URL) ([]byte, *url.URL, error) {
+	body, _, err := f.Fetcher.Fetch(ctx, u)
+	return body, nil, err
+}
+
+func Test_LoadNilBase(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	fsys := fstest.MapFS{
+		"live/master.m3u8": {Data: []byte("#EXTM3U\n#EXT-X-STREAM-INF:BANDWIDTH=1000\nmedia.m3u8\n")},
+		"live/media.m3u8":  {Data: []byte(mediaPlaylist("seg", 1))},
+	}
+	p, err := NewLoader(&nilBaseFetcher{Fetcher: &FileFetcher{FS: fsys}}).Load(context.Background(), "live/master.m3u8")
+	if err != nil || p.Err() != nil {
+		t.Fatalf("Load : got %v %v", err, p)
+	}
+	if p.URL.String() != "live/master.m3u8" {
+		t.Errorf("URL expected live/master.m3u8 : got %v", p.URL)
+	}
+	if len(p.Playlists) != 1 || p.Playlists[0].URL.String() != "/live/media.m3u8" {
+		t.Errorf("playlists expected [/live/media.m3u8] : got %v", p.Playlists)
+	}
+}
+
+func Test_LoadErrors(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	fsys := fstest.MapFS{
+		"media.m3u8":  {Data: []byte(mediaPlaylist("seg", 1))},
+		"master.m3u8": {Data: []byte("#EXTM3U\n#EXT-X-STREAM-INF:BANDWIDTH=1000\nmaster.m3u8\n")},
+	}
+	tests := []struct {
+		name string
+		url  string
+	}{
+		{"missing", "missing.m3u8"},
+		{"media playlist", "media.m3u8"},
+		{"not a file", "https://example.com/master.m3u8"},
+	}
+	for _, test := range tests {
+		_, err := NewLoader(&FileFetcher{FS: fsys}).Load(context.Background(), test.url)
+		if err == nil {
+			t.Errorf("%v : error expected", test.name)
+		}
+	}
+	//the variant refers to the Multivariant Playlist
+	p, err := NewLoader(&FileFetcher{FS: fsys}).Load(context.Background(), "master.m3u8")
+	if err != nil || p.Err() == nil {
+		t.Errorf("variant not a Media Playlist : error expected : got %v %v", err, p.Err())
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = NewLoader(&FileFetcher{FS: fsys}).Load(ctx, "master.m3u8")
+	if err == nil {
+		t.Errorf("context canceled : error expected")
+	}
+}
@@ -0,0 +1,183 @@
+package m3u8reader
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+//EXT-X-MEDIA TYPE values
+const (
+	MediaTypeAudio          = "AUDIO"
+	MediaTypeVideo          = "VIDEO"
+	MediaTypeSubtitles      = "SUBTITLES"
+	MediaTypeClosedCaptions = "CLOSED-CAPTIONS"
+)
+
+//RenditionPreferences - choices of the user, with none DEFAULT and AUTOSELECT decide
+type RenditionPreferences struct {
+	//Languages - BCP 47 language tags most preferred first, en-US falls back to en
+	Languages []string
+	//Characteristics - CHARACTERISTICS asked for, e.g. public.accessibility.describes-video
+	//Renditions with other characteristics rank after the others of the same language
+	Characteristics []string
+	//Forced - SUBTITLES with FORCED=YES are chosen, only they if set and never otherwise
+	//Used for the subtitles to show in the language of the audio when the user hasn't turned subtitles on
+	Forced bool
+}
+
+//GroupId - GROUP-ID of the renditions of the type for the Variant, empty if none
+func (v Variant) GroupId(mediaType string) string {
+	switch mediaType {
+	case MediaTypeAudio:
+		return v.Audio
+	case MediaTypeVideo:
+		return v.Video
+	case MediaTypeSubtitles:
+		return v.Subtitles
+	case MediaTypeClosedCaptions:
+		if v.ClosedCaptions == "NONE" {
+			return ""
+		}
+		return v.ClosedCaptions
+	}
+	return ""
+}
+
+//InVariant - the rendition has no URI, its media is in the Variant Stream
+func (r Rendition) InVariant() bool {
+	return r.URI == ""
+}
+
+//languageMatch - how well the language of the rendition matches the preferred one, -1 if not at all
+//0 same tag, 1 the preferred tag truncated as per RFC 4647 section 3.4, 2 same primary language
+func languageMatch(preferred string, language string) int {
+	preferred, language = strings.ToLower(preferred), strings.ToLower(language)
+	if preferred == "" || language == "" {
+		return -1
+	}
+	if preferred == language {
+		return 0
+	}
+	for tag := preferred; strings.Contains(tag, "-"); {
+		tag = tag[:strings.LastIndex(tag, "-")]
+		if tag == language {
+			return 1
+		}
+	}
+	primary := func(tag string) string {
+		if pos := strings.IndexByte(tag, '-'); pos > 0 {
+			return tag[:pos]
+		}
+		return tag
+	}
+	if primary(preferred) == primary(language) {
+		return 2
+	}
+	return -1
+}
+
+//renditionRank - how well a rendition matches the preferences, lower first
+type renditionRank struct {
+	rendition  Rendition
+	language   int //-1 if no preferred language matches
+	unasked    int //CHARACTERISTICS not asked for
+	asked      int //CHARACTERISTICS asked for
+	playlistAt int
+}
+
+func (p *RenditionPreferences) rank(r Rendition, at int) (ret renditionRank) {
+	ret = renditionRank{rendition: r, language: -1, playlistAt: at}
+	for i, preferred := range p.Languages {
+		if match := languageMatch(preferred, r.Language); match >= 0 {
+			ret.language = 3*i + match
+			break
+		}
+	}
+	for _, c := range r.Characteristics {
+		var asked bool
+		for _, a := range p.Characteristics {
+			asked = asked || a == c
+		}
+		if asked {
+			ret.asked++
+		} else {
+			ret.unasked++
+		}
+	}
+	return
+}
+
+//less - language, then CHARACTERISTICS, then DEFAULT, then AUTOSELECT, then the order of the playlist
+func (a renditionRank) less(b renditionRank) bool {
+	switch {
+	case a.language != b.language:
+		return a.language < b.language
+	case a.unasked != b.unasked:
+		return a.unasked < b.unasked
+	case a.asked != b.asked:
+		return a.asked > b.asked
+	case a.rendition.Default != b.rendition.Default:
+		return a.rendition.Default
+	case a.rendition.AutoSelect != b.rendition.AutoSelect:
+		return a.rendition.AutoSelect
+	}
+	return a.playlistAt < b.playlistAt
+}
+
+//SelectRenditions - renditions of the type for the Variant which can be chosen, most preferred first
+//Renditions of a preferred language are chosen, with none DEFAULT=YES or AUTOSELECT=YES ones are
+//chosen as per RFC 8216bis section 4.4.6.1, for AUDIO any rendition if none of these,
+//FORCED=YES SUBTITLES only in a preferred language
+//Renditions without URI are part of the Variant Stream, see Rendition.InVariant
+//error if the Variant refers to a group without renditions of the type
+func (m *MasterPlaylist) SelectRenditions(v Variant, mediaType string, prefs RenditionPreferences) (renditions []Rendition, err error) {
+	groupId := v.GroupId(mediaType)
+	if groupId == "" {
+		return nil, nil
+	}
+	var group, matched, auto []renditionRank
+	for i, r := range m.Renditions {
+		if r.Type != mediaType || r.GroupId != groupId {
+			continue
+		}
+		rank := prefs.rank(r, i)
+		group = append(group, rank)
+		if mediaType == MediaTypeSubtitles && r.Forced != prefs.Forced {
+			continue
+		}
+		if rank.language >= 0 {
+			matched = append(matched, rank)
+		} else if r.Default || r.AutoSelect {
+			auto = append(auto, rank)
+		}
+	}
+	if len(group) == 0 {
+		return nil, fmt.Errorf("%v group %v not found", mediaType, groupId)
+	}
+	chosen := matched
+	if len(chosen) == 0 && !(mediaType == MediaTypeSubtitles && prefs.Forced) {
+		//forced subtitles only in the language asked for
+		chosen = auto
+	}
+	if len(chosen) == 0 && mediaType == MediaTypeAudio {
+		//audio is played whatever the language
+		chosen = group
+	}
+	sort.Slice(chosen, func(i, j int) bool {
+		return chosen[i].less(chosen[j])
+	})
+	for _, rank := range chosen {
+		renditions = append(renditions, rank.rendition)
+	}
+	return
+}
+
+//SelectRendition - most preferred rendition as per SelectRenditions, nil if none
+func (m *MasterPlaylist) SelectRendition(v Variant, mediaType string, prefs RenditionPreferences) (*Rendition, error) {
+	renditions, err := m.SelectRenditions(v, mediaType, prefs)
+	if err != nil || len(renditions) == 0 {
+		return nil, err
+	}
+	return &renditions[0], nil
+}
@@ -0,0 +1,196 @@
+package m3u8reader_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eswarantg/m3u8reader"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+const renditionsMaster = `#EXTM3U
+#EXT-X-VERSION:7
+#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID="aud",NAME="English",LANGUAGE="en",DEFAULT=YES,AUTOSELECT=YES,URI="audio/en.m3u8"
+#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID="aud",NAME="English AD",LANGUAGE="en",AUTOSELECT=YES,CHARACTERISTICS="public.accessibility.describes-video",URI="audio/en-ad.m3u8"
+#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID="aud",NAME="French",LANGUAGE="fr-CA",AUTOSELECT=YES,URI="audio/fr.m3u8"
+#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID="aud",NAME="German",LANGUAGE="de",URI="audio/de.m3u8"
+#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID="muxed",NAME="Main",LANGUAGE="es"
+#EXT-X-MEDIA:TYPE=SUBTITLES,GROUP-ID="subs",NAME="English",LANGUAGE="en",AUTOSELECT=YES,URI="subs/en.m3u8"
+#EXT-X-MEDIA:TYPE=SUBTITLES,GROUP-ID="subs",NAME="English forced",LANGUAGE="en",AUTOSELECT=YES,FORCED=YES,URI="subs/en-forced.m3u8"
+#EXT-X-MEDIA:TYPE=SUBTITLES,GROUP-ID="subs",NAME="French",LANGUAGE="fr",URI="subs/fr.m3u8"
+#EXT-X-MEDIA:TYPE=SUBTITLES,GROUP-ID="subs",NAME="English SDH",LANGUAGE="en",CHARACTERISTICS="public.accessibility.transcribes-spoken-dialog,public.accessibility.describes-music-and-sound",URI="subs/en-sdh.m3u8"
+#EXT-X-MEDIA:TYPE=CLOSED-CAPTIONS,GROUP-ID="cc",NAME="English",LANGUAGE="en",DEFAULT=YES,AUTOSELECT=YES,INSTREAM-ID="CC1"
+#EXT-X-MEDIA:TYPE=CLOSED-CAPTIONS,GROUP-ID="cc",NAME="Spanish",LANGUAGE="es",INSTREAM-ID="CC3"
+#EXT-X-STREAM-INF:BANDWIDTH=1000000,AUDIO="aud",SUBTITLES="subs",CLOSED-CAPTIONS="cc"
+v1.m3u8
+#EXT-X-STREAM-INF:BANDWIDTH=1000000,AUDIO="muxed",CLOSED-CAPTIONS=NONE
+v2.m3u8
+#EXT-X-STREAM-INF:BANDWIDTH=1000000,AUDIO="missing"
+v3.m3u8
+`
+
+func Test_SelectRenditions(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	m := &m3u8reader.M3U8{}
+	_, err := m.ParseData([]byte(renditionsMaster))
+	if err != nil {
+		t.Fatalf("ParseData : %v", err)
+	}
+	master, err := m.MasterPlaylist()
+	if err != nil {
+		t.Fatalf("MasterPlaylist : %v", err)
+	}
+	const describesVideo = "public.accessibility.describes-video"
+	tests := []struct {
+		name      string
+		variant   int
+		mediaType string
+		prefs     m3u8reader.RenditionPreferences
+		expected  string //NAME of the renditions in order
+	}{
+		{"audio default", 0, m3u8reader.MediaTypeAudio, m3u8reader.RenditionPreferences{}, "English,French,English AD"},
+		{"audio region", 0, m3u8reader.MediaTypeAudio, m3u8reader.RenditionPreferences{Languages: []string{"fr-FR"}}, "French"},
+		{"audio fallback", 0, m3u8reader.MediaTypeAudio, m3u8reader.RenditionPreferences{Languages: []string{"en-US"}}, "English,English AD"},
+		{"audio characteristics", 0, m3u8reader.MediaTypeAudio,
+			m3u8reader.RenditionPreferences{Languages: []string{"en-US"}, Characteristics: []string{describesVideo}}, "English AD,English"},
+		{"audio second language", 0, m3u8reader.MediaTypeAudio, m3u8reader.RenditionPreferences{Languages: []string{"ja", "DE"}}, "German"},
+		{"audio no language", 0, m3u8reader.MediaTypeAudio, m3u8reader.RenditionPreferences{Languages: []string{"ja"}}, "English,French,English AD"},
+		{"audio muxed", 1, m3u8reader.MediaTypeAudio, m3u8reader.RenditionPreferences{}, "Main"},
+		{"subtitles default", 0, m3u8reader.MediaTypeSubtitles, m3u8reader.RenditionPreferences{}, "English"},
+		{"subtitles language", 0, m3u8reader.MediaTypeSubtitles, m3u8reader.RenditionPreferences{Languages: []string{"fr"}}, "French"},
+		{"subtitles sdh last", 0, m3u8reader.MediaTypeSubtitles, m3u8reader.RenditionPreferences{Languages: []string{"en"}}, "English,English SDH"},
+		{"subtitles forced", 0, m3u8reader.MediaTypeSubtitles, m3u8reader.RenditionPreferences{Languages: []string{"en-GB"}, Forced: true}, "English forced"},
+		{"subtitles forced other language", 0, m3u8reader.MediaTypeSubtitles, m3u8reader.RenditionPreferences{Languages: []string{"fr"}, Forced: true}, ""},
+		{"subtitles none", 1, m3u8reader.MediaTypeSubtitles, m3u8reader.RenditionPreferences{}, ""},
+		{"captions default", 0, m3u8reader.MediaTypeClosedCaptions, m3u8reader.RenditionPreferences{}, "English"},
+		{"captions language", 0, m3u8reader.MediaTypeClosedCaptions, m3u8reader.RenditionPreferences{Languages: []string{"es-MX"}}, "Spanish"},
+		{"captions NONE", 1, m3u8reader.MediaTypeClosedCaptions, m3u8reader.RenditionPreferences{}, ""},
+	}
+	for _, test := range tests {
+		renditions, err := master.SelectRenditions(master.Variants[test.variant], test.mediaType, test.prefs)
+		var names []string
+		for _, r := range renditions {
+			names = append(names, r.Name)
+		}
+		if err != nil || strings.Join(names, ",") != test.expected {
+			t.Errorf("%v : expected %v : got %v %v", test.name, test.expected, names, err)
+		}
+	}
+
+	r, err := master.SelectRendition(master.Variants[1], m3u8reader.MediaTypeAudio, m3u8reader.RenditionPreferences{})
+	if err != nil || r == nil || !r.InVariant() {
+		t.Errorf("muxed audio : rendition in the variant expected : got %v %v", r, err)
+	}
+	r, err = master.SelectRendition(master.Variants[0], m3u8reader.MediaTypeClosedCaptions, m3u8reader.RenditionPreferences{})
+	if err != nil || r == nil || !r.InVariant() || r.InStreamId != "CC1" {
+		t.Errorf("closed captions : CC1 expected : got %v %v", r, err)
+	}
+	_, err = master.SelectRendition(master.Variants[2], m3u8reader.MediaTypeAudio, m3u8reader.RenditionPreferences{})
+	if err == nil {
+		t.Errorf("group not found : error expected")
+	}
+}
+
+func Test_GetAudioMediaPlaylist(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	m := &m3u8reader.M3U8{}
+	_, err := m.ParseData([]byte(renditionsMaster))
+	if err != nil {
+		t.Fatalf("ParseData : %v", err)
+	}
+	master, _ := m.MasterPlaylist()
+	tests := []struct {
+		name     string
+		variant  int
+		lang     string
+		expected string
+	}{
+		{"exact", 0, "de", "audio/de.m3u8"},
+		{"region", 0, "en-US", "audio/en.m3u8"},
+		{"primary language", 0, "fr", "audio/fr.m3u8"},
+		{"no match", 0, "ja", ""},
+		{"group not found", 2, "en", ""},
+	}
+	for _, test := range tests {
+		entry, err := m.GetAudioMediaPlaylist(*master.Variants[test.variant].Entry, test.lang)
+		var uri string
+		if entry != nil {
+			uri, _ = entry.URI()
+			//the entry of the playlist, not a copy
+			var found bool
+			for i := range m.Entries {
+				found = found || entry == &m.Entries[i]
+			}
+			if !found {
+				t.Errorf("%v : entry of the playlist expected", test.name)
+			}
+		}
+		if err != nil || uri != test.expected {
+			t.Errorf("%v : expected %v : got %v %v", test.name, test.expected, uri, err)
+		}
+	}
+	_, err = m.GetAudioMediaPlaylist(m.Entries[0], "en")
+	if err == nil {
+		t.Errorf("not an EXT-X-STREAM-INF : error expected")
+	}
+}
+
+//LANGUAGE is optional on EXT-X-MEDIA
+const noLanguageMaster = `#EXTM3U
+#EXT-X-VERSION:7
+#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID="aud",NAME="Main",DEFAULT=YES,AUTOSELECT=YES,URI="audio/main.m3u8"
+#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID="aud",NAME="Commentary",URI="audio/commentary.m3u8"
+#EXT-X-MEDIA:TYPE=CLOSED-CAPTIONS,GROUP-ID="cc",NAME="Captions",DEFAULT=YES,AUTOSELECT=YES,INSTREAM-ID="CC1"
+#EXT-X-STREAM-INF:BANDWIDTH=1000000,AUDIO="aud",CLOSED-CAPTIONS="cc"
+v1.m3u8
+`
+
+func Test_SelectRenditionsNoLanguage(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	m := &m3u8reader.M3U8{}
+	_, err := m.ParseData([]byte(noLanguageMaster))
+	if err != nil {
+		t.Fatalf("ParseData : %v", err)
+	}
+	master, err := m.MasterPlaylist()
+	if err != nil {
+		t.Fatalf("MasterPlaylist : %v", err)
+	}
+	if len(master.Renditions) != 3 || len(master.Variants) != 1 {
+		t.Fatalf("renditions expected 3 : got %v %v", len(master.Renditions), len(master.Variants))
+	}
+	tests := []struct {
+		name      string
+		mediaType string
+		prefs     m3u8reader.RenditionPreferences
+		expected  string //NAME of the renditions in order
+	}{
+		{"audio default", m3u8reader.MediaTypeAudio, m3u8reader.RenditionPreferences{}, "Main"},
+		{"audio language", m3u8reader.MediaTypeAudio, m3u8reader.RenditionPreferences{Languages: []string{"en"}}, "Main"},
+		{"captions default", m3u8reader.MediaTypeClosedCaptions, m3u8reader.RenditionPreferences{}, "Captions"},
+		{"captions language", m3u8reader.MediaTypeClosedCaptions, m3u8reader.RenditionPreferences{Languages: []string{"en"}}, "Captions"},
+	}
+	for _, test := range tests {
+		renditions, err := master.SelectRenditions(master.Variants[0], test.mediaType, test.prefs)
+		var names []string
+		for _, r := range renditions {
+			names = append(names, r.Name)
+		}
+		if err != nil || strings.Join(names, ",") != test.expected {
+			t.Errorf("%v : expected %v : got %v %v", test.name, test.expected, names, err)
+		}
+	}
+	r, err := master.SelectRendition(master.Variants[0], m3u8reader.MediaTypeAudio, m3u8reader.RenditionPreferences{})
+	if err != nil || r == nil || r.URI != "audio/main.m3u8" || r.Language != "" {
+		t.Errorf("audio : audio/main.m3u8 expected : got %v %v", r, err)
+	}
+	r, err = master.SelectRendition(master.Variants[0], m3u8reader.MediaTypeClosedCaptions, m3u8reader.RenditionPreferences{})
+	if err != nil || r == nil || !r.InVariant() || r.InStreamId != "CC1" {
+		t.Errorf("closed captions : CC1 expected : got %v %v", r, err)
+	}
+	//no language to match
+	entry, err := m.GetAudioMediaPlaylist(*master.Variants[0].Entry, "en")
+	if err != nil || entry != nil {
+		t.Errorf("GetAudioMediaPlaylist : no entry expected : got %v %v", entry, err)
+	}
+}
@@ -0,0 +1,201 @@
+package m3u8reader
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/eswarantg/m3u8reader/common"
+)
+
+const defaultKeyFormat = "identity"
+
+//segmentState - state carried from the tags preceding a segment
+type segmentState struct {
+	keys          []Key
+	curMap        *Map
+	byteRange     *ByteRange
+	discontinuity bool
+	discSeq       int64
+	gap           bool
+	bitrate       int64
+	pdt           time.Time
+	parts         []Part
+	//end of the previous sub-range to resolve implicit offsets
+	prevURI     string
+	prevEnd     int64
+	prevPartURI string
+	prevPartEnd int64
+}
+
+//resolveByteRange - sets the offset if not specified
+//The sub-range then begins at the next byte following the previous sub-range of the same resource
+func resolveByteRange(br *ByteRange, uri string, prevURI string, prevEnd int64) error {
+	if br == nil || br.Offset >= 0 {
+		return nil
+	}
+	if prevEnd < 0 || uri != prevURI {
+		return fmt.Errorf("byteRange offset not specified and previous sub-range of %v not available", uri)
+	}
+	br.Offset = prevEnd
+	return nil
+}
+
+//setKey - replaces the key of the same KEYFORMAT, METHOD=NONE removes all keys
+func (s *segmentState) setKey(key Key) {
+	if key.Method == "NONE" {
+		s.keys = nil
+		return
+	}
+	format := key.KeyFormat
+	if format == "" {
+		format = defaultKeyFormat
+	}
+	//copy so that slices held by previous segments are not modified
+	keys := make([]Key, 0, len(s.keys)+1)
+	for _, k := range s.keys {
+		kf := k.KeyFormat
+		if kf == "" {
+			kf = defaultKeyFormat
+		}
+		if kf != format {
+			keys = append(keys, k)
+		}
+	}
+	s.keys = append(keys, key)
+}
+
+func readPart(entry *M3U8Entry) (Part, error) {
+	r := newAttrReader(entry)
+	p := Part{
+		SequenceNumber:  r.int64(common.INTMediaSequenceNumber),
+		PartNumber:      r.int64(common.INTPartNumber),
+		Duration:        r.duration(common.M3U8Duration),
+		URI:             r.str(common.M3U8Uri),
+		Independent:     r.bool(common.M3U8Independent),
+		Gap:             r.bool(common.M3U8Gap),
+		ByteRange:       r.byteRange(common.M3U8ByteRange),
+		ProgramDateTime: r.time(common.INTProgramDateTime),
+		Entry:           entry,
+	}
+	return p, r.err
+}
+
+//Segments - one Segment per EXTINF with the effective key, map, byte range,
+//discontinuity sequence number, gap flag, program date time and its parts
+func (m *M3U8) Segments() ([]Segment, error) {
+	segments, _, _, err := m.segments()
+	return segments, err
+}
+
+//segments - also returns the parts following the last EXTINF
+//and the date time following the last segment, zero if no EXT-X-PROGRAM-DATE-TIME applies
+func (m *M3U8) segments() (segments []Segment, trailing []Part, pdt time.Time, err error) {
+	s := segmentState{prevEnd: -1, prevPartEnd: -1}
+	for i := range m.Entries {
+		entry := &m.Entries[i]
+		r := newAttrReader(entry)
+		switch entry.Tag {
+		case common.M3U8ExtXDiscontinuitySequence:
+			s.discSeq = r.int64(common.INTUnknownAttr)
+		case common.M3U8ExtXDiscontinuity:
+			s.discontinuity = true
+			s.discSeq++
+			//date time of the earlier segments doesn't carry over
+			s.pdt = time.Time{}
+		case common.M3U8ExtXIProgramDateTime:
+			s.pdt = r.time(common.INTUnknownAttr)
+		case common.M3U8ExtXKey:
+			var key Key
+			key, err = readKey(entry)
+			if err != nil {
+				return
+			}
+			s.setKey(key)
+		case common.M3U8ExtXMap:
+			s.curMap = &Map{
+				URI:       r.str(common.M3U8Uri),
+				ByteRange: r.byteRange(common.M3U8ByteRange),
+				Entry:     entry,
+			}
+			if s.curMap.ByteRange != nil && s.curMap.ByteRange.Offset < 0 {
+				//EXT-X-MAP sub-range without offset starts at 0
+				s.curMap.ByteRange.Offset = 0
+			}
+		case common.M3U8ExtXByteRange:
+			s.byteRange = r.byteRange(common.INTUnknownAttr)
+		case common.M3U8ExtXGap:
+			s.gap = true
+		case common.M3U8ExtXBitrate:
+			s.bitrate = r.int64(common.INTUnknownAttr)
+		case common.M3U8ExtXPart:
+			var part Part
+			part, err = readPart(entry)
+			if err != nil {
+				return
+			}
+			err = resolveByteRange(part.ByteRange, part.URI, s.prevPartURI, s.prevPartEnd)
+			if err != nil {
+				err = fmt.Errorf("%v : %w", common.TagNames[entry.Tag], err)
+				return
+			}
+			if part.ByteRange != nil {
+				s.prevPartURI = part.URI
+				s.prevPartEnd = part.ByteRange.Offset + part.ByteRange.Length
+			}
+			if s.pdt.IsZero() {
+				//the date time computed is from the start of time
+				part.ProgramDateTime = time.Time{}
+			}
+			s.parts = append(s.parts, part)
+		case common.M3U8ExtInf:
+			seg := Segment{
+				SequenceNumber:        r.int64(common.INTMediaSequenceNumber),
+				Duration:              r.duration(common.INTUnknownAttr),
+				Title:                 r.str(common.M3U8Title),
+				URI:                   r.str(common.M3U8Uri),
+				ProgramDateTime:       s.pdt,
+				Keys:                  s.keys,
+				Map:                   s.curMap,
+				ByteRange:             s.byteRange,
+				Discontinuity:         s.discontinuity,
+				DiscontinuitySequence: s.discSeq,
+				Gap:                   s.gap,
+				Bitrate:               s.bitrate,
+				Parts:                 s.parts,
+				Entry:                 entry,
+			}
+			if r.err != nil {
+				err = r.err
+				return
+			}
+			err = resolveByteRange(seg.ByteRange, seg.URI, s.prevURI, s.prevEnd)
+			if err != nil {
+				err = fmt.Errorf("%v : %w", common.TagNames[common.M3U8ExtXByteRange], err)
+				return
+			}
+			if seg.ByteRange != nil {
+				s.prevURI = seg.URI
+				s.prevEnd = seg.ByteRange.Offset + seg.ByteRange.Length
+			} else {
+				s.prevURI = ""
+				s.prevEnd = -1
+			}
+			if !s.pdt.IsZero() {
+				s.pdt = s.pdt.Add(seg.Duration)
+			}
+			//tags that apply to the next segment only
+			s.byteRange = nil
+			s.discontinuity = false
+			s.gap = false
+			s.parts = nil
+			segments = append(segments, seg)
+		}
+		if r.err != nil {
+			err = r.err
+			return
+		}
+	}
+	trailing = s.parts
+	pdt = s.pdt
+	return
+}
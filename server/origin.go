@@ -0,0 +1,253 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/eswarantg/m3u8reader"
+	"github.com/eswarantg/m3u8reader/live"
+)
+
+//ContentType - of the playlist responses
+const ContentType = "application/vnd.apple.mpegurl"
+
+//snapshot - playlist published along with its responses
+type snapshot struct {
+	playlist       *m3u8reader.M3U8
+	body           []byte
+	nextMSN        int64 //first segment not yet complete
+	nextPart       int64 //first part of nextMSN not yet published
+	targetDuration time.Duration
+	partTarget     time.Duration
+	canSkipUntil   time.Duration
+	ended          bool
+	dateRanges     map[string]bool
+	deltas         map[string][]byte //by _HLS_skip
+}
+
+//removedDateRange - EXT-X-DATERANGE no longer in the playlist
+type removedDateRange struct {
+	id      string
+	removed time.Time
+}
+
+//Origin - serves a live Media Playlist held in memory, as per RFC 8216bis section 6.2.5
+//Requests with _HLS_msn/_HLS_part are held till the segment or part is published,
+//_HLS_skip is answered with a Playlist Delta Update if the playlist has CAN-SKIP-UNTIL
+type Origin struct {
+	now   func() time.Time
+	after func(d time.Duration) <-chan time.Time
+
+	mu      sync.Mutex
+	current *snapshot
+	updated chan struct{} //closed on Publish
+	removed []removedDateRange
+}
+
+//NewOrigin - requests are answered with 404 till the first Publish
+func NewOrigin() *Origin {
+	return &Origin{
+		now:     time.Now,
+		after:   time.After,
+		updated: make(chan struct{}),
+	}
+}
+
+//Publish - replaces the playlist served, the requests waiting for its segments or parts are answered
+//The packager publishes the playlist every time a segment or part is added, m is not to be changed after
+func (o *Origin) Publish(m *m3u8reader.M3U8) error {
+	if m.IsMasterPlaylist() {
+		return fmt.Errorf("not a Media Playlist")
+	}
+	media, err := m.MediaPlaylist()
+	if err != nil {
+		return err
+	}
+	body, err := m.Marshal()
+	if err != nil {
+		return err
+	}
+	s := &snapshot{
+		playlist:       m,
+		body:           body,
+		targetDuration: media.TargetDuration,
+		partTarget:     media.PartTarget,
+		ended:          media.EndList,
+		dateRanges:     make(map[string]bool),
+		deltas:         make(map[string][]byte),
+	}
+	s.nextMSN, s.nextPart = m.NextPart()
+	if media.ServerControl != nil {
+		s.canSkipUntil = media.ServerControl.CanSkipUntil
+	}
+	for _, dr := range media.DateRanges {
+		s.dateRanges[dr.Id] = true
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	now := o.now()
+	var removed []removedDateRange
+	for _, r := range o.removed {
+		//clients loading the playlist earlier than CAN-SKIP-UNTIL get a full playlist
+		if now.Sub(r.removed) <= s.canSkipUntil && !s.dateRanges[r.id] {
+			removed = append(removed, r)
+		}
+	}
+	if o.current != nil {
+		for id := range o.current.dateRanges {
+			if !s.dateRanges[id] {
+				removed = append(removed, removedDateRange{id: id, removed: now})
+			}
+		}
+	}
+	o.removed = removed
+	o.current = s
+	close(o.updated)
+	o.updated = make(chan struct{})
+	return nil
+}
+
+//has - true if the playlist has the segment msn, or its part if part >= 0
+func (s *snapshot) has(msn int64, part int64) bool {
+	if s.ended || msn < s.nextMSN {
+		return true
+	}
+	return part >= 0 && msn == s.nextMSN && part < s.nextPart
+}
+
+//tooFar - true if the segment or part is too far in the future to be waited for
+func (s *snapshot) tooFar(msn int64, part int64) bool {
+	//the last segment in the playlist plus two
+	if msn > s.nextMSN+1 {
+		return true
+	}
+	if part < 0 || msn != s.nextMSN {
+		return false
+	}
+	//Advance Part Limit
+	limit := int64(3)
+	if s.partTarget > 0 && s.partTarget < time.Second {
+		limit = int64(3 * time.Second / s.partTarget)
+	}
+	return part-(s.nextPart-1) > limit
+}
+
+//response - full playlist or the Playlist Delta Update for skip
+func (o *Origin) response(s *snapshot, skip string) (body []byte, err error) {
+	if skip == "" || s.canSkipUntil <= 0 {
+		return s.body, nil
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if body, ok := s.deltas[skip]; ok {
+		return body, nil
+	}
+	var delta *m3u8reader.M3U8
+	if skip == live.SkipDateRanges {
+		var ids []string
+		for _, r := range o.removed {
+			ids = append(ids, r.id)
+		}
+		sort.Strings(ids)
+		delta, err = s.playlist.DeltaUpdate(true, ids)
+	}
+	if skip == live.SkipSegments || err != nil {
+		//v2 not supported by the playlist, segments are skipped all the same
+		delta, err = s.playlist.DeltaUpdate(false, nil)
+	}
+	if err != nil {
+		return
+	}
+	body, err = delta.Marshal()
+	if err != nil {
+		return
+	}
+	s.deltas[skip] = body
+	return
+}
+
+//parseDirectives - _HLS_msn and _HLS_part, -1 if absent
+func parseDirectives(r *http.Request) (msn int64, part int64, skip string, err error) {
+	q := r.URL.Query()
+	msn, part = -1, -1
+	if v := q.Get(live.QueryMSN); v != "" {
+		msn, err = strconv.ParseInt(v, 10, 64)
+		if err != nil || msn < 0 {
+			err = fmt.Errorf("invalid %v %v", live.QueryMSN, v)
+			return
+		}
+	}
+	if v := q.Get(live.QueryPart); v != "" {
+		if msn < 0 {
+			err = fmt.Errorf("%v without %v", live.QueryPart, live.QueryMSN)
+			return
+		}
+		part, err = strconv.ParseInt(v, 10, 64)
+		if err != nil || part < 0 {
+			err = fmt.Errorf("invalid %v %v", live.QueryPart, v)
+			return
+		}
+	}
+	skip = q.Get(live.QuerySkip)
+	switch skip {
+	case "", live.SkipSegments, live.SkipDateRanges:
+	default:
+		err = fmt.Errorf("invalid %v %v", live.QuerySkip, skip)
+	}
+	return
+}
+
+//ServeHTTP - 400 for invalid or too far Delivery Directives,
+//503 if the segment or part is not published within three times the target duration
+func (o *Origin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	msn, part, skip, err := parseDirectives(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var timeout <-chan time.Time
+	for {
+		o.mu.Lock()
+		s, updated := o.current, o.updated
+		o.mu.Unlock()
+		if s == nil {
+			http.Error(w, "playlist not published", http.StatusNotFound)
+			return
+		}
+		if msn < 0 || s.has(msn, part) {
+			o.write(w, s, skip)
+			return
+		}
+		if s.tooFar(msn, part) {
+			http.Error(w, fmt.Sprintf("segment %v part %v too far in the future", msn, part), http.StatusBadRequest)
+			return
+		}
+		if timeout == nil {
+			//three times the target duration
+			timeout = o.after(3 * s.targetDuration)
+		}
+		select {
+		case <-updated:
+		case <-timeout:
+			http.Error(w, fmt.Sprintf("segment %v part %v not available", msn, part), http.StatusServiceUnavailable)
+			return
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+func (o *Origin) write(w http.ResponseWriter, s *snapshot, skip string) {
+	body, err := o.response(s, skip)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.Write(body)
+}
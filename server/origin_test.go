@@ -0,0 +1,272 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eswarantg/m3u8reader"
+	"github.com/eswarantg/m3u8reader/live"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+const partsPerSegment = 4
+
+//partsPlaylist - LL-HLS media playlist once n parts are produced, the last 6 complete segments are listed
+func partsPlaylist(n int, ended bool, dateRanges string) *m3u8reader.M3U8 {
+	complete, partial := n/partsPerSegment, n%partsPerSegment
+	first := complete - 6
+	if first < 0 {
+		first = 0
+	}
+	var sb strings.Builder
+	sb.WriteString("#EXTM3U\n#EXT-X-VERSION:10\n#EXT-X-TARGETDURATION:4\n#EXT-X-PART-INF:PART-TARGET=1\n")
+	sb.WriteString("#EXT-X-SERVER-CONTROL:CAN-BLOCK-RELOAD=YES,PART-HOLD-BACK=3,CAN-SKIP-UNTIL=12,CAN-SKIP-DATERANGES=YES\n")
+	fmt.Fprintf(&sb, "#EXT-X-MEDIA-SEQUENCE:%v\n#EXT-X-PROGRAM-DATE-TIME:2024-01-01T00:00:%02vZ\n", first, first*4)
+	sb.WriteString(dateRanges)
+	for msn := first; msn <= complete; msn++ {
+		count := partsPerSegment
+		if msn == complete {
+			count = partial
+		}
+		if msn >= complete-1 {
+			for i := 0; i < count; i++ {
+				fmt.Fprintf(&sb, "#EXT-X-PART:DURATION=1.0,URI=\"seg%v.p%v.mp4\"\n", msn, i)
+			}
+		}
+		if msn < complete {
+			fmt.Fprintf(&sb, "#EXTINF:4.0,\nseg%v.mp4\n", msn)
+		}
+	}
+	if ended {
+		sb.WriteString("#EXT-X-ENDLIST\n")
+	} else {
+		fmt.Fprintf(&sb, "#EXT-X-PRELOAD-HINT:TYPE=PART,URI=\"seg%v.p%v.mp4\"\n", complete, partial)
+	}
+	m := &m3u8reader.M3U8{}
+	_, err := m.ParseData([]byte(sb.String()))
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+//get - status and body of the response to the query
+func get(o *Origin, query string) (int, string) {
+	r := httptest.NewRequest(http.MethodGet, "/live.m3u8?"+query, nil)
+	w := httptest.NewRecorder()
+	o.ServeHTTP(w, r)
+	return w.Code, w.Body.String()
+}
+
+func Test_Origin(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	o := NewOrigin()
+	code, _ := get(o, "")
+	if code != http.StatusNotFound {
+		t.Errorf("not published : status expected %v : got %v", http.StatusNotFound, code)
+	}
+	err := o.Publish(partsPlaylist(22, false, ""))
+	if err != nil {
+		t.Fatalf("Publish : %v", err)
+	}
+	o.after = func(d time.Duration) <-chan time.Time {
+		t.Errorf("unexpected wait %v", d)
+		return nil
+	}
+	tests := []struct {
+		query    string
+		code     int
+		contains string
+	}{
+		{"", http.StatusOK, "seg5.p1.mp4"},
+		{"_HLS_msn=4", http.StatusOK, "seg5.p1.mp4"},
+		{"_HLS_msn=5&_HLS_part=1", http.StatusOK, "seg5.p1.mp4"},
+		{"_HLS_msn=7", http.StatusBadRequest, "too far"},
+		{"_HLS_msn=5&_HLS_part=5", http.StatusBadRequest, "too far"},
+		{"_HLS_part=1", http.StatusBadRequest, "without"},
+		{"_HLS_msn=x", http.StatusBadRequest, "invalid"},
+		{"_HLS_msn=5&_HLS_part=-1", http.StatusBadRequest, "invalid"},
+		{"_HLS_skip=NO", http.StatusBadRequest, "invalid"},
+		{"_HLS_skip=YES", http.StatusOK, "#EXT-X-SKIP:SKIPPED-SEGMENTS=2\n"},
+		{"_HLS_msn=5&_HLS_part=0&_HLS_skip=v2", http.StatusOK, "#EXT-X-SKIP:SKIPPED-SEGMENTS=2,RECENTLY-REMOVED-DATERANGES=\"\""},
+	}
+	for _, test := range tests {
+		code, body := get(o, test.query)
+		if code != test.code || !strings.Contains(body, test.contains) {
+			t.Errorf("%v : expected %v %v : got %v %v", test.query, test.code, test.contains, code, body)
+		}
+	}
+	err = o.Publish(partsPlaylist(22, true, ""))
+	if err != nil {
+		t.Fatalf("Publish : %v", err)
+	}
+	//the playlist ended, nothing to wait for
+	code, body := get(o, "_HLS_msn=6&_HLS_part=0")
+	if code != http.StatusOK || !strings.Contains(body, "#EXT-X-ENDLIST") {
+		t.Errorf("ended : expected %v : got %v %v", http.StatusOK, code, body)
+	}
+	m := &m3u8reader.M3U8{}
+	m.ParseData([]byte("#EXTM3U\n#EXT-X-STREAM-INF:BANDWIDTH=1000\nlow.m3u8\n"))
+	if o.Publish(m) == nil {
+		t.Errorf("master playlist : error expected")
+	}
+}
+
+func Test_OriginBlocking(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	o := NewOrigin()
+	o.Publish(partsPlaylist(22, false, ""))
+	waits := make(chan time.Duration, 1)
+	timeout := make(chan time.Time)
+	o.after = func(d time.Duration) <-chan time.Time {
+		waits <- d
+		return timeout
+	}
+	type response struct {
+		code int
+		body string
+	}
+	request := func(query string) chan response {
+		done := make(chan response, 1)
+		go func() {
+			code, body := get(o, query)
+			done <- response{code, body}
+		}()
+		//held till the part is published or the timeout
+		if d := <-waits; d != 12*time.Second {
+			t.Errorf("%v : timeout expected %v : got %v", query, 12*time.Second, d)
+		}
+		return done
+	}
+	done := request("_HLS_msn=5&_HLS_part=2")
+	o.Publish(partsPlaylist(23, false, ""))
+	resp := <-done
+	if resp.code != http.StatusOK || !strings.Contains(resp.body, "seg5.p2.mp4") {
+		t.Errorf("blocking part : got %v %v", resp.code, resp.body)
+	}
+
+	//the segment is complete once the last part is published
+	done = request("_HLS_msn=5")
+	o.Publish(partsPlaylist(24, false, ""))
+	resp = <-done
+	if resp.code != http.StatusOK || !strings.Contains(resp.body, "seg5.mp4") {
+		t.Errorf("blocking segment : got %v %v", resp.code, resp.body)
+	}
+
+	done = request("_HLS_msn=6&_HLS_part=1")
+	//not yet
+	o.Publish(partsPlaylist(25, false, ""))
+	timeout <- time.Now()
+	resp = <-done
+	if resp.code != http.StatusServiceUnavailable {
+		t.Errorf("timeout : status expected %v : got %v %v", http.StatusServiceUnavailable, resp.code, resp.body)
+	}
+}
+
+func Test_OriginDelta(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	o := NewOrigin()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	o.now = func() time.Time {
+		return now
+	}
+	const ad1 = "#EXT-X-DATERANGE:ID=\"ad1\",START-DATE=\"2024-01-01T00:00:00Z\",DURATION=4\n"
+	const ad2 = "#EXT-X-DATERANGE:ID=\"ad2\",START-DATE=\"2024-01-01T00:00:24Z\",DURATION=4\n"
+	o.Publish(partsPlaylist(30, false, ad1+ad2))
+	full := partsPlaylist(30, false, ad1+ad2)
+	now = now.Add(4 * time.Second)
+	o.Publish(partsPlaylist(34, false, ad2))
+	code, body := get(o, "_HLS_skip=v2")
+	if code != http.StatusOK || !strings.Contains(body, "RECENTLY-REMOVED-DATERANGES=\"ad1\"") {
+		t.Errorf("v2 : got %v %v", code, body)
+	}
+	//merged by the client onto the playlist it has
+	delta := &m3u8reader.M3U8{}
+	_, err := delta.ParseData([]byte(body))
+	if err == nil {
+		err = delta.ApplyDelta(full)
+	}
+	if err != nil {
+		t.Fatalf("ApplyDelta : %v", err)
+	}
+	want, _ := partsPlaylist(34, false, ad2).MediaPlaylist()
+	got, _ := delta.MediaPlaylist()
+	if len(got.Segments) != len(want.Segments) || len(got.DateRanges) != 1 || got.DateRanges[0].Id != "ad2" {
+		t.Errorf("merged : expected %v : got %v", want, got)
+	}
+	for i := range got.Segments {
+		if got.Segments[i].URI != want.Segments[i].URI || !got.Segments[i].ProgramDateTime.Equal(want.Segments[i].ProgramDateTime) {
+			t.Errorf("merged : %v : expected %v : got %v", i, want.Segments[i], got.Segments[i])
+		}
+	}
+	//no longer recently removed
+	now = now.Add(time.Minute)
+	o.Publish(partsPlaylist(35, false, ad2))
+	_, body = get(o, "_HLS_skip=v2")
+	if !strings.Contains(body, "RECENTLY-REMOVED-DATERANGES=\"\"") {
+		t.Errorf("v2 expired : got %v", body)
+	}
+}
+
+//Test_OriginPoller - the Origin as the stand-in origin of a blocking Poller
+func Test_OriginPoller(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	o := NewOrigin()
+	o.Publish(partsPlaylist(8, false, ""))
+	server := httptest.NewServer(o)
+	defer server.Close()
+	p, err := live.NewPoller(server.URL + "/live.m3u8")
+	if err != nil {
+		t.Fatalf("NewPoller : %v", err)
+	}
+	p.HTTPClient = server.Client()
+	p.Blocking = true
+	p.MaxErrors = 1
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	go func() {
+		//the packager
+		for n := 9; n <= 24; n++ {
+			time.Sleep(time.Millisecond)
+			o.Publish(partsPlaylist(n, n == 24, ""))
+		}
+	}()
+	done := make(chan error)
+	go func() {
+		done <- p.Run(ctx)
+	}()
+	var uris []string
+	for seg := range p.Segments() {
+		uris = append(uris, seg.URI)
+	}
+	if err = <-done; err != nil {
+		t.Errorf("Run : %v", err)
+	}
+	expected := "[seg0.mp4 seg1.mp4 seg2.mp4 seg3.mp4 seg4.mp4 seg5.mp4]"
+	if fmt.Sprint(uris) != expected {
+		t.Errorf("segments expected %v : got %v", expected, uris)
+	}
+}
+
+func Test_OriginContentType(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	o := NewOrigin()
+	o.Publish(partsPlaylist(4, false, ""))
+	server := httptest.NewServer(o)
+	defer server.Close()
+	resp, err := server.Client().Get(server.URL + "/live.m3u8")
+	if err != nil {
+		t.Fatalf("Get : %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if resp.Header.Get("Content-Type") != ContentType || !strings.HasPrefix(string(body), "#EXTM3U") {
+		t.Errorf("response : got %v %v", resp.Header, string(body))
+	}
+}
@@ -0,0 +1,422 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/eswarantg/m3u8reader"
+	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+//MinWindowSize - a segment is not removed if the playlist would then be shorter than 3 target durations
+const MinWindowSize = 3
+
+//partsWindow - parts are listed for the segments ending within 3 target durations of the end
+const partsWindow = 3
+
+//Window - live Media Playlist of a packager, keeping the segments of the last Size target durations
+//The keys, map and program date time set apply to the segment in progress, i.e. to the next part or segment added
+type Window struct {
+	TargetDuration time.Duration
+	PartTarget     time.Duration //EXT-X-PART-INF, 0 if parts are not added
+	Size           int           //number of target durations kept, at least MinWindowSize
+	ServerControl  *m3u8reader.ServerControl
+	Version        int64 //EXT-X-VERSION, computed if 0
+
+	segments      []m3u8reader.Segment
+	pdtSet        []bool //EXT-X-PROGRAM-DATE-TIME set for the segment
+	mediaSequence int64
+	discSequence  int64
+	//segment in progress
+	parts         []m3u8reader.Part
+	keys          []m3u8reader.Key
+	curMap        *m3u8reader.Map
+	discontinuity bool
+	pdt           time.Time
+	nextPDTSet    bool
+	hint          *m3u8reader.PreloadHint
+	reports       []m3u8reader.RenditionReport
+	ended         bool
+}
+
+//NewWindow - size is the number of target durations kept
+func NewWindow(targetDuration time.Duration, size int) *Window {
+	return &Window{TargetDuration: targetDuration, Size: size}
+}
+
+//SetKeys - keys of the segments following, one per KEYFORMAT, nil if not encrypted
+func (w *Window) SetKeys(keys []m3u8reader.Key) {
+	w.keys = keys
+}
+
+//SetMap - Media Initialization Section of the segments following, nil if none
+func (w *Window) SetMap(m *m3u8reader.Map) {
+	w.curMap = m
+}
+
+//Discontinuity - EXT-X-DISCONTINUITY before the segment in progress
+func (w *Window) Discontinuity() {
+	w.discontinuity = true
+}
+
+//SetProgramDateTime - of the segment in progress, the following ones are computed from the durations
+func (w *Window) SetProgramDateTime(t time.Time) {
+	w.pdt = t
+	w.nextPDTSet = true
+}
+
+//SetPreloadHint - EXT-X-PRELOAD-HINT, cleared when a part or segment is added
+func (w *Window) SetPreloadHint(hint *m3u8reader.PreloadHint) {
+	w.hint = hint
+}
+
+//SetRenditionReports - EXT-X-RENDITION-REPORT of the other renditions
+func (w *Window) SetRenditionReports(reports []m3u8reader.RenditionReport) {
+	w.reports = reports
+}
+
+//End - EXT-X-ENDLIST, nothing is added after
+func (w *Window) End() {
+	w.ended = true
+}
+
+//AddPart - part of the segment in progress, Duration, URI, Independent, Gap and ByteRange are used
+func (w *Window) AddPart(part m3u8reader.Part) error {
+	if w.ended {
+		return fmt.Errorf("playlist ended")
+	}
+	if w.PartTarget <= 0 {
+		return fmt.Errorf("PartTarget not set")
+	}
+	if part.Duration > w.PartTarget {
+		return fmt.Errorf("part %v duration %v exceeds PART-TARGET %v", part.URI, part.Duration, w.PartTarget)
+	}
+	w.parts = append(w.parts, part)
+	w.hint = nil
+	return nil
+}
+
+//AddSegment - completes the segment in progress with its parts, Duration, Title, URI, ByteRange, Gap and Bitrate are used
+//Segments are removed from the head of the playlist as long as it is at least Size target durations
+func (w *Window) AddSegment(seg m3u8reader.Segment) (msn int64, err error) {
+	if w.ended {
+		return 0, fmt.Errorf("playlist ended")
+	}
+	//EXTINF duration rounded to the nearest integer must not exceed the target duration
+	if math.Round(seg.Duration.Seconds()) > w.TargetDuration.Seconds() {
+		return 0, fmt.Errorf("segment %v duration %v exceeds EXT-X-TARGETDURATION %v", seg.URI, seg.Duration, w.TargetDuration)
+	}
+	msn = w.mediaSequence + int64(len(w.segments))
+	seg.SequenceNumber = msn
+	seg.Keys = w.keys
+	seg.Map = w.curMap
+	seg.Discontinuity = w.discontinuity
+	seg.ProgramDateTime = w.pdt
+	seg.Parts = w.parts
+	w.segments = append(w.segments, seg)
+	w.pdtSet = append(w.pdtSet, w.nextPDTSet)
+	if !w.pdt.IsZero() {
+		w.pdt = w.pdt.Add(seg.Duration)
+	}
+	w.parts, w.discontinuity, w.nextPDTSet, w.hint = nil, false, false, nil
+	w.slide()
+	return
+}
+
+func (w *Window) slide() {
+	size := w.Size
+	if size < MinWindowSize {
+		size = MinWindowSize
+	}
+	keep := time.Duration(size) * w.TargetDuration
+	var total time.Duration
+	for _, seg := range w.segments {
+		total += seg.Duration
+	}
+	for len(w.segments) > 1 && total-w.segments[0].Duration >= keep {
+		total -= w.segments[0].Duration
+		if w.segments[0].Discontinuity {
+			w.discSequence++
+		}
+		w.segments, w.pdtSet = w.segments[1:], w.pdtSet[1:]
+		w.mediaSequence++
+	}
+}
+
+func keysEqual(a []m3u8reader.Key, b []m3u8reader.Key) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Method != b[i].Method || a[i].URI != b[i].URI || !bytes.Equal(a[i].IV, b[i].IV) ||
+			a[i].KeyFormat != b[i].KeyFormat || strings.Join(a[i].KeyFormatVersions, "/") != strings.Join(b[i].KeyFormatVersions, "/") {
+			return false
+		}
+	}
+	return true
+}
+
+func mapsEqual(a *m3u8reader.Map, b *m3u8reader.Map) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.URI == b.URI && byteRangesEqual(a.ByteRange, b.ByteRange)
+}
+
+func byteRangesEqual(a *m3u8reader.ByteRange, b *m3u8reader.ByteRange) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func byteRangeValue(br *m3u8reader.ByteRange) [2]int64 {
+	return [2]int64{br.Length, br.Offset}
+}
+
+//builder - posts the entries of the playlist as if parsed
+type builder struct {
+	m   *m3u8reader.M3U8
+	err error
+}
+
+//post - attrs are attribute ids and values, nil values are not stored
+func (b *builder) post(tag common.TagId, attrs ...interface{}) {
+	if b.err != nil {
+		return
+	}
+	kv := parsers.NewAttrKVPairs()
+	for i := 0; i+1 < len(attrs); i += 2 {
+		if attrs[i+1] != nil {
+			kv.Store(attrs[i].(common.AttrId), attrs[i+1])
+		}
+	}
+	b.err = b.m.PostRecord(tag, kv)
+}
+
+//keys - METHOD=NONE first if a KEYFORMAT of prev is no longer used
+func (b *builder) keys(prev []m3u8reader.Key, keys []m3u8reader.Key) {
+	formats := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		formats[k.KeyFormat] = true
+	}
+	for _, k := range prev {
+		if !formats[k.KeyFormat] {
+			b.post(common.M3U8ExtXKey, common.M3U8Method, "NONE")
+			break
+		}
+	}
+	for _, k := range keys {
+		var iv interface{}
+		if len(k.IV) > 16 {
+			b.err = fmt.Errorf("key %v IV longer than 128 bits", k.URI)
+			return
+		}
+		if len(k.IV) > 0 {
+			var v [16]byte
+			copy(v[len(v)-len(k.IV):], k.IV)
+			iv = v
+		}
+		var format, versions interface{}
+		if k.KeyFormat != "" {
+			format = k.KeyFormat
+		}
+		if len(k.KeyFormatVersions) > 0 {
+			versions = strings.Join(k.KeyFormatVersions, "/")
+		}
+		var uri interface{}
+		if k.URI != "" {
+			uri = k.URI
+		}
+		b.post(common.M3U8ExtXKey, common.M3U8Method, k.Method, common.M3U8Uri, uri, common.M3U8IV, iv,
+			common.M3U8KeyFormat, format, common.M3U8KeyFormatVersions, versions)
+	}
+}
+
+func (b *builder) setMap(m *m3u8reader.Map) {
+	var br interface{}
+	if m.ByteRange != nil {
+		br = byteRangeValue(m.ByteRange)
+	}
+	b.post(common.M3U8ExtXMap, common.M3U8Uri, m.URI, common.M3U8ByteRange, br)
+}
+
+func (b *builder) part(part m3u8reader.Part) {
+	var independent, gap, br interface{}
+	if part.Independent {
+		independent = true
+	}
+	if part.Gap {
+		gap = true
+	}
+	if part.ByteRange != nil {
+		br = byteRangeValue(part.ByteRange)
+	}
+	b.post(common.M3U8ExtXPart, common.M3U8Duration, part.Duration.Seconds(), common.M3U8Uri, part.URI,
+		common.M3U8Independent, independent, common.M3U8Gap, gap, common.M3U8ByteRange, br)
+}
+
+//version - lowest EXT-X-VERSION of the features in the playlist, as per RFC 8216bis section 8
+func (w *Window) version() (version int64) {
+	if w.Version > 0 {
+		return w.Version
+	}
+	version = 3 //floating point EXTINF durations
+	require := func(v int64) {
+		if v > version {
+			version = v
+		}
+	}
+	keys := func(keys []m3u8reader.Key) {
+		for _, k := range keys {
+			if len(k.IV) > 0 {
+				require(2)
+			}
+			if k.KeyFormat != "" || len(k.KeyFormatVersions) > 0 {
+				require(5)
+			}
+		}
+	}
+	if w.PartTarget > 0 || w.ServerControl != nil {
+		require(9)
+	}
+	for _, seg := range w.segments {
+		keys(seg.Keys)
+		if seg.Map != nil {
+			require(6)
+		}
+		if seg.ByteRange != nil {
+			require(4)
+		}
+	}
+	if len(w.parts) > 0 {
+		keys(w.keys)
+		if w.curMap != nil {
+			require(6)
+		}
+	}
+	return
+}
+
+//Playlist - Media Playlist of the segments in the window, the parts of the last 3 target durations
+//and of the segment in progress
+func (w *Window) Playlist() (*m3u8reader.M3U8, error) {
+	b := &builder{m: &m3u8reader.M3U8{}}
+	b.m.Init()
+	b.post(common.M3U8FormatIdentifier)
+	b.post(common.M3U8ExtXVersion, common.INTUnknownAttr, w.version())
+	b.post(common.M3U8TargetDuration, common.INTUnknownAttr, int64(math.Ceil(w.TargetDuration.Seconds())))
+	if sc := w.ServerControl; sc != nil {
+		var canBlock, canSkipUntil, canSkipDateRanges, holdBack, partHoldBack interface{}
+		if sc.CanBlockReload {
+			canBlock = true
+		}
+		if sc.CanSkipUntil > 0 {
+			canSkipUntil = sc.CanSkipUntil.Seconds()
+			if sc.CanSkipDateRanges {
+				canSkipDateRanges = true
+			}
+		}
+		if sc.HoldBack > 0 {
+			holdBack = sc.HoldBack.Seconds()
+		}
+		if sc.PartHoldBack > 0 {
+			partHoldBack = sc.PartHoldBack.Seconds()
+		}
+		b.post(common.M3U8ExtXServerControl, common.M3U8CanBlockReload, canBlock, common.M3U8CanSkipUntil, canSkipUntil,
+			common.M3U8CanSkipDateRanges, canSkipDateRanges, common.M3U8HoldBack, holdBack, common.M3U8PartHoldBack, partHoldBack)
+	}
+	if w.PartTarget > 0 {
+		b.post(common.M3U8ExtXPartInf, common.M3U8PartTarget, w.PartTarget.Seconds())
+	}
+	b.post(common.M3U8ExtXMediaSequence, common.INTUnknownAttr, w.mediaSequence)
+	if w.discSequence > 0 {
+		b.post(common.M3U8ExtXDiscontinuitySequence, common.INTUnknownAttr, w.discSequence)
+	}
+	var total time.Duration
+	for _, seg := range w.segments {
+		total += seg.Duration
+	}
+	for _, part := range w.parts {
+		total += part.Duration
+	}
+	var end time.Duration
+	var prevKeys []m3u8reader.Key
+	var prevMap *m3u8reader.Map
+	//state of the segment preceding the parts in progress
+	head := true
+	writeState := func(keys []m3u8reader.Key, m *m3u8reader.Map, discontinuity bool, pdt time.Time, pdtSet bool) {
+		if discontinuity {
+			b.post(common.M3U8ExtXDiscontinuity)
+		}
+		//the keys and map in effect are repeated at the head of the playlist
+		if head || !keysEqual(prevKeys, keys) {
+			b.keys(prevKeys, keys)
+		}
+		if m != nil && (head || !mapsEqual(prevMap, m)) {
+			b.setMap(m)
+		}
+		if !pdt.IsZero() && (head || pdtSet || discontinuity) {
+			b.post(common.M3U8ExtXIProgramDateTime, common.INTUnknownAttr, pdt)
+		}
+		prevKeys, prevMap, head = keys, m, false
+	}
+	for i, seg := range w.segments {
+		writeState(seg.Keys, seg.Map, seg.Discontinuity, seg.ProgramDateTime, w.pdtSet[i])
+		end += seg.Duration
+		if end > total-partsWindow*w.TargetDuration {
+			for _, part := range seg.Parts {
+				b.part(part)
+			}
+		}
+		if seg.Gap {
+			b.post(common.M3U8ExtXGap)
+		}
+		if seg.Bitrate > 0 {
+			b.post(common.M3U8ExtXBitrate, common.INTUnknownAttr, seg.Bitrate)
+		}
+		if seg.ByteRange != nil {
+			b.post(common.M3U8ExtXByteRange, common.INTUnknownAttr, byteRangeValue(seg.ByteRange))
+		}
+		var title interface{}
+		if seg.Title != "" {
+			title = seg.Title
+		}
+		b.post(common.M3U8ExtInf, common.INTUnknownAttr, seg.Duration.Seconds(), common.M3U8Title, title, common.M3U8Uri, seg.URI)
+	}
+	if len(w.parts) > 0 {
+		writeState(w.keys, w.curMap, w.discontinuity, w.pdt, w.nextPDTSet)
+		for _, part := range w.parts {
+			b.part(part)
+		}
+	}
+	if w.ended {
+		b.post(common.M3U8ExtXEndList)
+	} else if hint := w.hint; hint != nil {
+		var start, length interface{}
+		if hint.ByteRangeStart > 0 {
+			start = hint.ByteRangeStart
+		}
+		if hint.ByteRangeLength > 0 {
+			length = hint.ByteRangeLength
+		}
+		b.post(common.M3U8ExtXPreLoadHint, common.M3U8Type, hint.Type, common.M3U8Uri, hint.URI,
+			common.M3U8ByteRangeStart, start, common.M3U8ByteRangeLength, length)
+	}
+	for _, report := range w.reports {
+		var lastPart interface{}
+		if report.LastPart >= 0 {
+			lastPart = report.LastPart
+		}
+		b.post(common.M3U8ExtXRenditionReport, common.M3U8Uri, report.URI, common.M3U8LastMsn, report.LastMsn,
+			common.M3U8LastPart, lastPart)
+	}
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.m, nil
+}
@@ -0,0 +1,234 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/eswarantg/m3u8reader"
+	"github.com/eswarantg/m3u8reader/parsers"
+	"github.com/eswarantg/m3u8reader/validate"
+)
+
+//reparse - the playlist as read back by each parser
+func reparse(t *testing.T, name string, m *m3u8reader.M3U8) []*m3u8reader.MediaPlaylist {
+	data, err := m.Marshal()
+	if err != nil {
+		t.Fatalf("%v : Marshal : %v", name, err)
+	}
+	var ret []*m3u8reader.MediaPlaylist
+	for _, opt := range []m3u8reader.ParserOption{m3u8reader.M3U8ParserScanner2, m3u8reader.M3U8ParserScanner3,
+		m3u8reader.M3U8ParserGrammar, m3u8reader.M3U8ParserYacc} {
+		parsed := &m3u8reader.M3U8{}
+		parsed.SetParserOption(opt)
+		_, err = parsed.ParseData(data)
+		if err != nil {
+			t.Fatalf("%v : parser %v : %v\n%v", name, opt, err, string(data))
+		}
+		for _, f := range validate.Validate(parsed) {
+			t.Errorf("%v : parser %v : %v %v %v\n%v", name, opt, f.Severity, f.Rule, f.Message, string(data))
+		}
+		media, err := parsed.MediaPlaylist()
+		if err != nil {
+			t.Fatalf("%v : parser %v : %v", name, opt, err)
+		}
+		ret = append(ret, media)
+	}
+	return ret
+}
+
+func Test_Window(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	w := NewWindow(4*time.Second, 3)
+	//the yacc lexer requires the fractional seconds of the program date time
+	start := time.Date(2024, 1, 1, 0, 0, 0, 5e8, time.UTC)
+	w.SetProgramDateTime(start)
+	w.SetKeys([]m3u8reader.Key{{Method: "AES-128", URI: "key1", IV: []byte{1}}})
+	w.SetMap(&m3u8reader.Map{URI: "init1.mp4"})
+	for i := 0; i < 8; i++ {
+		switch i {
+		case 2:
+			w.SetKeys([]m3u8reader.Key{{Method: "AES-128", URI: "key2"}})
+		case 3:
+			w.Discontinuity()
+			w.SetMap(&m3u8reader.Map{URI: "init2.mp4"})
+		case 6:
+			w.SetKeys(nil)
+		}
+		msn, err := w.AddSegment(m3u8reader.Segment{Duration: 4 * time.Second, URI: fmt.Sprintf("seg%v.mp4", i)})
+		if err != nil || msn != int64(i) {
+			t.Fatalf("%v : AddSegment : %v %v", i, msn, err)
+		}
+	}
+	//no parts, the report is without LAST-PART
+	w.SetRenditionReports([]m3u8reader.RenditionReport{{URI: "../audio/live.m3u8", LastMsn: 7, LastPart: -1}})
+	m, err := w.Playlist()
+	if err != nil {
+		t.Fatalf("Playlist : %v", err)
+	}
+	for _, media := range reparse(t, "window", m) {
+		//3 target durations kept
+		if media.MediaSequence != 5 || media.DiscontinuitySequence != 1 || len(media.Segments) != 3 || media.Version != 6 {
+			t.Errorf("window : got %v %v %v %v", media.MediaSequence, media.DiscontinuitySequence, len(media.Segments), media.Version)
+			continue
+		}
+		expected := []struct {
+			key     string
+			mapURI  string
+			discSeq int64
+		}{
+			{"key2", "init2.mp4", 1},
+			{"", "init2.mp4", 1},
+			{"", "init2.mp4", 1},
+		}
+		for i, seg := range media.Segments {
+			var key string
+			if len(seg.Keys) > 0 {
+				key = seg.Keys[0].URI
+			}
+			if seg.SequenceNumber != int64(5+i) || seg.URI != fmt.Sprintf("seg%v.mp4", 5+i) || key != expected[i].key ||
+				seg.Map == nil || seg.Map.URI != expected[i].mapURI || seg.DiscontinuitySequence != expected[i].discSeq ||
+				!seg.ProgramDateTime.Equal(start.Add(time.Duration(5+i)*4*time.Second)) {
+				t.Errorf("window : %v : got %v", i, seg)
+			}
+		}
+		if len(media.RenditionReports) != 1 || media.RenditionReports[0].LastMsn != 7 || media.RenditionReports[0].LastPart != -1 {
+			t.Errorf("window : rendition report : got %v", media.RenditionReports)
+		}
+	}
+}
+
+func Test_WindowParts(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	w := NewWindow(4*time.Second, 6)
+	w.PartTarget = time.Second
+	w.ServerControl = &m3u8reader.ServerControl{CanBlockReload: true, PartHoldBack: 3 * time.Second, CanSkipUntil: 24 * time.Second}
+	w.SetMap(&m3u8reader.Map{URI: "init.mp4"})
+	w.SetProgramDateTime(time.Date(2024, 1, 1, 0, 0, 0, 5e8, time.UTC))
+	publish := func(msn int, part int) {
+		w.SetPreloadHint(&m3u8reader.PreloadHint{Type: "PART", URI: fmt.Sprintf("seg%v.p%v.mp4", msn, part)})
+		w.SetRenditionReports([]m3u8reader.RenditionReport{{URI: "../audio/live.m3u8", LastMsn: int64(msn), LastPart: int64(part) - 1}})
+	}
+	for msn := 0; msn < 10; msn++ {
+		for part := 0; part < 4; part++ {
+			err := w.AddPart(m3u8reader.Part{Duration: time.Second, URI: fmt.Sprintf("seg%v.p%v.mp4", msn, part), Independent: part == 0})
+			if err != nil {
+				t.Fatalf("AddPart : %v", err)
+			}
+			publish(msn, part+1)
+		}
+		_, err := w.AddSegment(m3u8reader.Segment{Duration: 4 * time.Second, URI: fmt.Sprintf("seg%v.mp4", msn)})
+		if err != nil {
+			t.Fatalf("AddSegment : %v", err)
+		}
+		publish(msn+1, 0)
+	}
+	w.AddPart(m3u8reader.Part{Duration: time.Second, URI: "seg10.p0.mp4", Independent: true})
+	publish(10, 1)
+	m, err := w.Playlist()
+	if err != nil {
+		t.Fatalf("Playlist : %v", err)
+	}
+	msn, part := m.NextPart()
+	if msn != 10 || part != 1 {
+		t.Errorf("next part expected 10.1 : got %v.%v", msn, part)
+	}
+	for _, media := range reparse(t, "parts", m) {
+		if media.MediaSequence != 4 || len(media.Segments) != 6 || media.Version != 9 || media.ServerControl == nil ||
+			!media.ServerControl.CanBlockReload || media.PartTarget != time.Second {
+			t.Errorf("parts : got %v %v %v %v", media.MediaSequence, len(media.Segments), media.Version, media.ServerControl)
+			continue
+		}
+		//parts of the last 3 target durations, segments 7 to 9 and the one in progress
+		if len(media.Parts) != 13 || media.Parts[0].URI != "seg7.p0.mp4" || media.Parts[12].URI != "seg10.p0.mp4" {
+			t.Errorf("parts : got %v", media.Parts)
+		}
+		if len(media.PreloadHints) != 1 || media.PreloadHints[0].URI != "seg10.p1.mp4" {
+			t.Errorf("preload hint : got %v", media.PreloadHints)
+		}
+		if len(media.RenditionReports) != 1 || media.RenditionReports[0].LastMsn != 10 || media.RenditionReports[0].LastPart != 0 {
+			t.Errorf("rendition report : got %v", media.RenditionReports)
+		}
+	}
+
+	//the Origin serves what the Window produces
+	o := NewOrigin()
+	err = o.Publish(m)
+	if err != nil {
+		t.Fatalf("Publish : %v", err)
+	}
+	code, body := get(o, "_HLS_msn=10&_HLS_part=0&_HLS_skip=YES")
+	if code != 200 || len(body) == 0 {
+		t.Errorf("origin : got %v %v", code, body)
+	}
+
+	w.End()
+	m, _ = w.Playlist()
+	for _, media := range reparse(t, "ended", m) {
+		if !media.EndList || len(media.PreloadHints) != 0 {
+			t.Errorf("ended : got %v %v", media.EndList, media.PreloadHints)
+		}
+	}
+}
+
+func Test_WindowErrors(t *testing.T) {
+	w := NewWindow(4*time.Second, 3)
+	_, err := w.AddSegment(m3u8reader.Segment{Duration: 4600 * time.Millisecond, URI: "long.mp4"})
+	if err == nil {
+		t.Errorf("segment longer than the target duration : error expected")
+	}
+	err = w.AddPart(m3u8reader.Part{Duration: time.Second, URI: "p.mp4"})
+	if err == nil {
+		t.Errorf("part without PartTarget : error expected")
+	}
+	w.PartTarget = time.Second
+	err = w.AddPart(m3u8reader.Part{Duration: 2 * time.Second, URI: "p.mp4"})
+	if err == nil {
+		t.Errorf("part longer than PartTarget : error expected")
+	}
+	w.End()
+	_, err = w.AddSegment(m3u8reader.Segment{Duration: 4 * time.Second, URI: "seg.mp4"})
+	if err == nil {
+		t.Errorf("segment after End : error expected")
+	}
+}
+
+func Test_WindowVersion(t *testing.T) {
+	parsers.AttrKVPairsSyncPool = false
+	tests := []struct {
+		name     string
+		keys     []m3u8reader.Key
+		m        *m3u8reader.Map
+		br       *m3u8reader.ByteRange
+		expected int64
+	}{
+		{"clear", nil, nil, nil, 3},
+		{"iv", []m3u8reader.Key{{Method: "AES-128", URI: "key", IV: []byte{1}}}, nil, nil, 3},
+		{"byte range", nil, nil, &m3u8reader.ByteRange{Length: 1000}, 4},
+		{"keyformat", []m3u8reader.Key{{Method: "SAMPLE-AES", URI: "skd://key", KeyFormat: "com.apple.streamingkeydelivery"}}, nil, nil, 5},
+		{"keyformatversions", []m3u8reader.Key{{Method: "AES-128", URI: "key", KeyFormatVersions: []string{"1"}}}, nil, nil, 5},
+		{"map", []m3u8reader.Key{{Method: "SAMPLE-AES", URI: "skd://key", KeyFormat: "com.apple.streamingkeydelivery"}},
+			&m3u8reader.Map{URI: "init.mp4"}, nil, 6},
+	}
+	for _, test := range tests {
+		w := NewWindow(4*time.Second, 3)
+		w.SetKeys(test.keys)
+		w.SetMap(test.m)
+		for i := 0; i < 3; i++ {
+			_, err := w.AddSegment(m3u8reader.Segment{Duration: 4 * time.Second, URI: fmt.Sprintf("seg%v.mp4", i), ByteRange: test.br})
+			if err != nil {
+				t.Fatalf("%v : AddSegment : %v", test.name, err)
+			}
+		}
+		m, err := w.Playlist()
+		if err != nil {
+			t.Fatalf("%v : Playlist : %v", test.name, err)
+		}
+		//reparse reports the findings of validate.Validate
+		for _, media := range reparse(t, test.name, m) {
+			if media.Version != test.expected {
+				t.Errorf("%v : expected %v : got %v", test.name, test.expected, media.Version)
+			}
+		}
+	}
+}
@@ -0,0 +1,350 @@
+package steering
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/eswarantg/m3u8reader"
+)
+
+//DefaultPathwayId - Pathway of variants without PATHWAY-ID
+const DefaultPathwayId = "."
+
+//DefaultTTL - used when the Steering Manifest doesn't specify TTL
+const DefaultTTL = 300 * time.Second
+
+//Manifest - Content Steering Manifest
+type Manifest struct {
+	Version         int            `json:"VERSION"`
+	TTL             int64          `json:"TTL"`
+	ReloadURI       string         `json:"RELOAD-URI,omitempty"`
+	PathwayPriority []string       `json:"PATHWAY-PRIORITY"`
+	PathwayClones   []PathwayClone `json:"PATHWAY-CLONES,omitempty"`
+}
+
+//PathwayClone - creates a new Pathway from an existing one
+type PathwayClone struct {
+	BaseId         string         `json:"BASE-ID"`
+	Id             string         `json:"ID"`
+	UriReplacement UriReplacement `json:"URI-REPLACEMENT"`
+}
+
+//UriReplacement - rules to build the URIs of a cloned Pathway
+type UriReplacement struct {
+	Host   string            `json:"HOST,omitempty"`
+	Params map[string]string `json:"PARAMS,omitempty"`
+}
+
+//ParseManifest - parses and validates the JSON Steering Manifest
+func ParseManifest(data []byte) (*Manifest, error) {
+	m := &Manifest{}
+	err := json.Unmarshal(data, m)
+	if err != nil {
+		return nil, fmt.Errorf("steering manifest : %w", err)
+	}
+	if m.Version != 1 {
+		return nil, fmt.Errorf("steering manifest : unsupported VERSION %v", m.Version)
+	}
+	if len(m.PathwayPriority) == 0 {
+		return nil, fmt.Errorf("steering manifest : PATHWAY-PRIORITY missing")
+	}
+	for _, clone := range m.PathwayClones {
+		if clone.BaseId == "" || clone.Id == "" {
+			return nil, fmt.Errorf("steering manifest : PATHWAY-CLONES requires BASE-ID and ID")
+		}
+	}
+	return m, nil
+}
+
+//ReloadAfter - time after which the manifest is to be reloaded
+func (m *Manifest) ReloadAfter() time.Duration {
+	if m.TTL <= 0 {
+		return DefaultTTL
+	}
+	return time.Duration(m.TTL) * time.Second
+}
+
+//pathwayOf - PATHWAY-ID of the variant, "." if not specified
+func pathwayOf(v m3u8reader.Variant) string {
+	if v.PathwayId == "" {
+		return DefaultPathwayId
+	}
+	return v.PathwayId
+}
+
+//replaceURI - applies the URI-REPLACEMENT rules to the URI resolved against base
+//Relative URIs are kept as is when base is nil
+func replaceURI(uri string, base *url.URL, rep UriReplacement) string {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return uri
+	}
+	if !u.IsAbs() {
+		if base == nil {
+			return uri
+		}
+		u = base.ResolveReference(u)
+	}
+	if rep.Host != "" {
+		u.Host = rep.Host
+	}
+	if len(rep.Params) > 0 {
+		q := u.Query()
+		for k, v := range rep.Params {
+			q.Set(k, v)
+		}
+		u.RawQuery = q.Encode()
+	}
+	return u.String()
+}
+
+//cloneGroupId - GROUP-ID of the renditions cloned for the Pathway, empty and NONE are kept
+func cloneGroupId(groupId string, pathway string) string {
+	if groupId == "" || groupId == "NONE" {
+		return groupId
+	}
+	return groupId + "_clone_" + pathway
+}
+
+//clones - variants and renditions of all the Pathways including the ones cloned as per the manifest
+//The renditions of the groups of the base Pathway are cloned into new groups, see cloneGroupId
+func (m *Manifest) clones(master *m3u8reader.MasterPlaylist, base *url.URL) (variants []m3u8reader.Variant, renditions []m3u8reader.Rendition) {
+	variants = append([]m3u8reader.Variant(nil), master.Variants...)
+	renditions = append([]m3u8reader.Rendition(nil), master.Renditions...)
+	present := make(map[string]bool)
+	for _, v := range variants {
+		present[pathwayOf(v)] = true
+	}
+	for _, clone := range m.PathwayClones {
+		if present[clone.Id] || !present[clone.BaseId] {
+			continue
+		}
+		//TYPE and GROUP-ID of the groups of the base Pathway
+		groups := make(map[[2]string]bool)
+		for _, v := range master.Variants {
+			if pathwayOf(v) != clone.BaseId {
+				continue
+			}
+			for _, mediaType := range []string{m3u8reader.MediaTypeAudio, m3u8reader.MediaTypeVideo,
+				m3u8reader.MediaTypeSubtitles, m3u8reader.MediaTypeClosedCaptions} {
+				if groupId := v.GroupId(mediaType); groupId != "" {
+					groups[[2]string{mediaType, groupId}] = true
+				}
+			}
+			v.PathwayId = clone.Id
+			v.URI = replaceURI(v.URI, base, clone.UriReplacement)
+			v.Audio = cloneGroupId(v.Audio, clone.Id)
+			v.Video = cloneGroupId(v.Video, clone.Id)
+			v.Subtitles = cloneGroupId(v.Subtitles, clone.Id)
+			v.ClosedCaptions = cloneGroupId(v.ClosedCaptions, clone.Id)
+			v.Entry = nil
+			variants = append(variants, v)
+		}
+		for _, r := range master.Renditions {
+			if !groups[[2]string{r.Type, r.GroupId}] {
+				continue
+			}
+			r.PathwayId = clone.Id
+			r.GroupId = cloneGroupId(r.GroupId, clone.Id)
+			if r.URI != "" {
+				r.URI = replaceURI(r.URI, base, clone.UriReplacement)
+			}
+			r.Entry = nil
+			renditions = append(renditions, r)
+		}
+		present[clone.Id] = true
+	}
+	return
+}
+
+//Variants - variants of all the Pathways including the ones cloned as per the manifest
+//base is the URL of the Multivariant Playlist, the relative URIs of the cloned variants are resolved against it
+func (m *Manifest) Variants(master *m3u8reader.MasterPlaylist, base *url.URL) []m3u8reader.Variant {
+	variants, _ := m.clones(master, base)
+	return variants
+}
+
+//Renditions - renditions of all the Pathways including the ones cloned as per the manifest, see Variants
+func (m *Manifest) Renditions(master *m3u8reader.MasterPlaylist, base *url.URL) []m3u8reader.Rendition {
+	_, renditions := m.clones(master, base)
+	return renditions
+}
+
+//PreferredPathway - first Pathway of PATHWAY-PRIORITY available in the variants
+//Empty if none of them are available
+func (m *Manifest) PreferredPathway(variants []m3u8reader.Variant) string {
+	present := make(map[string]bool)
+	for _, v := range variants {
+		present[pathwayOf(v)] = true
+	}
+	for _, pathway := range m.PathwayPriority {
+		if present[pathway] {
+			return pathway
+		}
+	}
+	return ""
+}
+
+//FilterVariants - variants that belong to the Pathway
+func FilterVariants(variants []m3u8reader.Variant, pathway string) []m3u8reader.Variant {
+	var toret []m3u8reader.Variant
+	for _, v := range variants {
+		if pathwayOf(v) == pathway {
+			toret = append(toret, v)
+		}
+	}
+	return toret
+}
+
+//FilterRenditions - renditions of the groups the variants refer to
+func FilterRenditions(renditions []m3u8reader.Rendition, variants []m3u8reader.Variant) []m3u8reader.Rendition {
+	var toret []m3u8reader.Rendition
+	for _, r := range renditions {
+		for _, v := range variants {
+			if r.GroupId != "" && v.GroupId(r.Type) == r.GroupId {
+				toret = append(toret, r)
+				break
+			}
+		}
+	}
+	return toret
+}
+
+//Client - fetches the Steering Manifest and tracks the preferred Pathway
+type Client struct {
+	HTTPClient *http.Client
+	//Throughput in bits per second sent as _HLS_throughput, 0 to skip
+	Throughput int64
+
+	mu       sync.Mutex
+	master   *m3u8reader.MasterPlaylist
+	base     *url.URL
+	uri      *url.URL
+	pathway  string
+	manifest *Manifest
+}
+
+//NewClient - serverURI is the SERVER-URI of EXT-X-CONTENT-STEERING
+//base is the URL of the Multivariant Playlist, relative URIs are resolved against it
+func NewClient(master *m3u8reader.MasterPlaylist, base *url.URL, serverURI string) (*Client, error) {
+	u, err := url.Parse(serverURI)
+	if err != nil {
+		return nil, err
+	}
+	if !u.IsAbs() && base != nil {
+		u = base.ResolveReference(u)
+	}
+	if !u.IsAbs() {
+		return nil, fmt.Errorf("steering server URI %v is not absolute", serverURI)
+	}
+	c := &Client{master: master, base: base, uri: u}
+	if master.ContentSteering != nil && master.ContentSteering.PathwayId != "" {
+		c.pathway = master.ContentSteering.PathwayId
+	} else if len(master.Variants) > 0 {
+		c.pathway = pathwayOf(master.Variants[0])
+	}
+	return c, nil
+}
+
+//Pathway - currently preferred Pathway
+func (c *Client) Pathway() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.pathway
+}
+
+//Manifest - last Steering Manifest fetched, nil if not yet fetched
+func (c *Client) Manifest() *Manifest {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.manifest
+}
+
+//requestURL - adds _HLS_pathway and _HLS_throughput query parameters
+func (c *Client) requestURL() string {
+	u := *c.uri
+	q := u.Query()
+	if c.pathway != "" {
+		q.Set("_HLS_pathway", c.pathway)
+	}
+	if c.Throughput > 0 {
+		q.Set("_HLS_throughput", strconv.FormatInt(c.Throughput, 10))
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+//Fetch - loads the Steering Manifest and updates the preferred Pathway
+func (c *Client) Fetch(ctx context.Context) (*Manifest, error) {
+	c.mu.Lock()
+	reqURL := c.requestURL()
+	c.mu.Unlock()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("steering manifest %v : status %v", reqURL, resp.Status)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	m, err := ParseManifest(data)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if m.ReloadURI != "" {
+		var reload *url.URL
+		reload, err = c.uri.Parse(m.ReloadURI)
+		if err != nil {
+			return nil, fmt.Errorf("steering manifest RELOAD-URI : %w", err)
+		}
+		c.uri = reload
+	}
+	c.manifest = m
+	if pathway := m.PreferredPathway(m.Variants(c.master, c.base)); pathway != "" {
+		c.pathway = pathway
+	}
+	return m, nil
+}
+
+//Variants - variants of the currently preferred Pathway
+func (c *Client) Variants() []m3u8reader.Variant {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	variants := c.master.Variants
+	if c.manifest != nil {
+		variants = c.manifest.Variants(c.master, c.base)
+	}
+	return FilterVariants(variants, c.pathway)
+}
+
+//Renditions - renditions of the groups of the variants of the currently preferred Pathway
+func (c *Client) Renditions() []m3u8reader.Rendition {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	variants, renditions := c.master.Variants, c.master.Renditions
+	if c.manifest != nil {
+		variants, renditions = c.manifest.clones(c.master, c.base)
+	}
+	return FilterRenditions(renditions, FilterVariants(variants, c.pathway))
+}
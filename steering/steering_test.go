@@ -0,0 +1,214 @@
+package steering_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/eswarantg/m3u8reader"
+	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/parsers"
+	"github.com/eswarantg/m3u8reader/steering"
+)
+
+var masterData = strings.Join([]string{
+	"#EXTM3U",
+	"#EXT-X-CONTENT-STEERING:SERVER-URI=\"/steering\",PATHWAY-ID=\"CDN-A\"",
+	"#EXT-X-STREAM-INF:BANDWIDTH=1280000,PATHWAY-ID=\"CDN-A\"",
+	"https://a.example.com/low/index.m3u8",
+	"#EXT-X-STREAM-INF:BANDWIDTH=2560000,PATHWAY-ID=\"CDN-A\"",
+	"https://a.example.com/high/index.m3u8",
+	"#EXT-X-STREAM-INF:BANDWIDTH=1280000,PATHWAY-ID=\"CDN-B\"",
+	"https://b.example.com/low/index.m3u8",
+	"#EXT-X-STREAM-INF:BANDWIDTH=2560000,PATHWAY-ID=\"CDN-B\"",
+	"https://b.example.com/high/index.m3u8",
+	"",
+}, "\n")
+
+func readMaster(t *testing.T) *m3u8reader.MasterPlaylist {
+	return parseMaster(t, masterData)
+}
+
+func parseMaster(t *testing.T, data string) *m3u8reader.MasterPlaylist {
+	parsers.AttrKVPairsSyncPool = false
+	manifest := m3u8reader.M3U8{}
+	manifest.SetBuffer(make([]byte, 4096))
+	_, err := manifest.ParseData([]byte(data))
+	if err != nil {
+		t.Fatalf("ParseData : %v", err)
+	}
+	master, err := manifest.MasterPlaylist()
+	if err != nil {
+		t.Fatalf("MasterPlaylist : %v", err)
+	}
+	return master
+}
+
+func Test_ContentSteeringTag(t *testing.T) {
+	master := readMaster(t)
+	if master.ContentSteering == nil {
+		t.Fatalf("content steering expected")
+	}
+	if master.ContentSteering.ServerURI != "/steering" || master.ContentSteering.PathwayId != "CDN-A" {
+		t.Errorf("content steering : got %+v", *master.ContentSteering)
+	}
+	if master.Variants[2].PathwayId != "CDN-B" {
+		t.Errorf("pathway : got %v", master.Variants[2].PathwayId)
+	}
+	if master.ContentSteering.Entry.Tag != common.M3U8ExtXContentSteering {
+		t.Errorf("tag : got %v", master.ContentSteering.Entry.Tag)
+	}
+}
+
+func Test_Client(t *testing.T) {
+	var requests []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/steering", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RawQuery)
+		fmt.Fprint(w, `{"VERSION":1,"TTL":10,"RELOAD-URI":"/steering/reload?session=1","PATHWAY-PRIORITY":["CDN-B","CDN-A"]}`)
+	})
+	mux.HandleFunc("/steering/reload", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RawQuery)
+		fmt.Fprint(w, `{"VERSION":1,"TTL":20,"PATHWAY-PRIORITY":["CDN-C","CDN-B"],
+			"PATHWAY-CLONES":[{"BASE-ID":"CDN-A","ID":"CDN-C","URI-REPLACEMENT":{"HOST":"c.example.com","PARAMS":{"token":"xyz"}}}]}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	master := readMaster(t)
+	base, _ := url.Parse(server.URL + "/master.m3u8")
+	client, err := steering.NewClient(master, base, master.ContentSteering.ServerURI)
+	if err != nil {
+		t.Fatalf("NewClient : %v", err)
+	}
+	client.HTTPClient = server.Client()
+	client.Throughput = 5000000
+	if client.Pathway() != "CDN-A" {
+		t.Errorf("initial pathway : got %v", client.Pathway())
+	}
+
+	m, err := client.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("Fetch : %v", err)
+	}
+	if m.ReloadAfter().Seconds() != 10 {
+		t.Errorf("ttl : got %v", m.ReloadAfter())
+	}
+	if client.Pathway() != "CDN-B" {
+		t.Errorf("pathway : got %v", client.Pathway())
+	}
+	variants := client.Variants()
+	if len(variants) != 2 || !strings.HasPrefix(variants[0].URI, "https://b.example.com/") {
+		t.Errorf("variants : got %+v", variants)
+	}
+
+	_, err = client.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("Fetch : %v", err)
+	}
+	if client.Pathway() != "CDN-C" {
+		t.Errorf("cloned pathway : got %v", client.Pathway())
+	}
+	variants = client.Variants()
+	if len(variants) != 2 || variants[1].URI != "https://c.example.com/high/index.m3u8?token=xyz" {
+		t.Errorf("cloned variants : got %+v", variants)
+	}
+
+	expected := []string{
+		"_HLS_pathway=CDN-A&_HLS_throughput=5000000",
+		"_HLS_pathway=CDN-B&_HLS_throughput=5000000&session=1",
+	}
+	if len(requests) != len(expected) {
+		t.Fatalf("requests expected %v : got %v", expected, requests)
+	}
+	for i := range expected {
+		if requests[i] != expected[i] {
+			t.Errorf("%v : request expected %v : got %v", i, expected[i], requests[i])
+		}
+	}
+}
+
+func Test_ManifestClones(t *testing.T) {
+	master := parseMaster(t, strings.Join([]string{
+		"#EXTM3U",
+		"#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID=\"aud\",NAME=\"English\",LANGUAGE=\"en\",DEFAULT=YES,URI=\"audio/en.m3u8\"",
+		"#EXT-X-MEDIA:TYPE=CLOSED-CAPTIONS,GROUP-ID=\"cc\",NAME=\"English\",INSTREAM-ID=\"CC1\"",
+		"#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID=\"aud-b\",NAME=\"English\",LANGUAGE=\"en\",URI=\"https://b.example.com/audio/en.m3u8\"",
+		"#EXT-X-STREAM-INF:BANDWIDTH=1280000,AUDIO=\"aud\",CLOSED-CAPTIONS=\"cc\",PATHWAY-ID=\"CDN-A\"",
+		"low/index.m3u8",
+		"#EXT-X-STREAM-INF:BANDWIDTH=2560000,AUDIO=\"aud\",CLOSED-CAPTIONS=\"cc\",PATHWAY-ID=\"CDN-A\"",
+		"https://a.example.com/high/index.m3u8",
+		"#EXT-X-STREAM-INF:BANDWIDTH=1280000,AUDIO=\"aud-b\",PATHWAY-ID=\"CDN-B\"",
+		"https://b.example.com/low/index.m3u8",
+		"",
+	}, "\n"))
+	m, err := steering.ParseManifest([]byte(`{"VERSION":1,"PATHWAY-PRIORITY":["CDN-C"],
+		"PATHWAY-CLONES":[{"BASE-ID":"CDN-A","ID":"CDN-C","URI-REPLACEMENT":{"HOST":"c.example.com","PARAMS":{"token":"xyz"}}}]}`))
+	if err != nil {
+		t.Fatalf("ParseManifest : %v", err)
+	}
+	base, _ := url.Parse("https://a.example.com/vod/master.m3u8?session=1")
+	tests := []struct {
+		name     string
+		base     *url.URL
+		variants []string //URI AUDIO CLOSED-CAPTIONS of the cloned variants
+		audio    string   //URI of the cloned audio rendition
+	}{
+		{"resolved", base, []string{
+			"https://c.example.com/vod/low/index.m3u8?token=xyz aud_clone_CDN-C cc_clone_CDN-C",
+			"https://c.example.com/high/index.m3u8?token=xyz aud_clone_CDN-C cc_clone_CDN-C",
+		}, "https://c.example.com/vod/audio/en.m3u8?token=xyz"},
+		{"no base URL", nil, []string{
+			"low/index.m3u8 aud_clone_CDN-C cc_clone_CDN-C",
+			"https://c.example.com/high/index.m3u8?token=xyz aud_clone_CDN-C cc_clone_CDN-C",
+		}, "audio/en.m3u8"},
+	}
+	for _, test := range tests {
+		var variants []string
+		for _, v := range steering.FilterVariants(m.Variants(master, test.base), "CDN-C") {
+			variants = append(variants, v.URI+" "+v.Audio+" "+v.ClosedCaptions)
+		}
+		if strings.Join(variants, ",") != strings.Join(test.variants, ",") {
+			t.Errorf("%v : variants expected %v : got %v", test.name, test.variants, variants)
+		}
+		renditions := m.Renditions(master, test.base)
+		//aud and cc of CDN-A cloned, aud-b of CDN-B not
+		if len(renditions) != 5 {
+			t.Fatalf("%v : renditions expected 5 : got %+v", test.name, renditions)
+		}
+		audio, captions := renditions[3], renditions[4]
+		if audio.GroupId != "aud_clone_CDN-C" || audio.PathwayId != "CDN-C" || audio.URI != test.audio || audio.Entry != nil {
+			t.Errorf("%v : audio expected %v : got %+v", test.name, test.audio, audio)
+		}
+		if captions.GroupId != "cc_clone_CDN-C" || !captions.InVariant() || captions.InStreamId != "CC1" {
+			t.Errorf("%v : closed captions : got %+v", test.name, captions)
+		}
+		pathway := steering.FilterRenditions(renditions, steering.FilterVariants(m.Variants(master, test.base), "CDN-C"))
+		if len(pathway) != 2 || pathway[0].GroupId != "aud_clone_CDN-C" {
+			t.Errorf("%v : renditions of the pathway : got %+v", test.name, pathway)
+		}
+	}
+}
+
+func Test_ParseManifest(t *testing.T) {
+	tests := []struct {
+		data string
+		err  bool
+	}{
+		{`{"VERSION":1,"PATHWAY-PRIORITY":["A"]}`, false},
+		{`{"VERSION":2,"PATHWAY-PRIORITY":["A"]}`, true},
+		{`{"VERSION":1}`, true},
+		{`{"VERSION":1,"PATHWAY-PRIORITY":["A"],"PATHWAY-CLONES":[{"ID":"B"}]}`, true},
+		{`not json`, true},
+	}
+	for i, test := range tests {
+		_, err := steering.ParseManifest([]byte(test.data))
+		if (err != nil) != test.err {
+			t.Errorf("%v : error expected %v : got %v", i, test.err, err)
+		}
+	}
+}
@@ -0,0 +1,388 @@
+package validate
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/eswarantg/m3u8reader"
+	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+//Rule IDs of the findings
+const (
+	//RuleTargetDuration - EXTINF duration rounded to the nearest integer above EXT-X-TARGETDURATION
+	RuleTargetDuration = "EXTINF-TARGETDURATION"
+	//RuleVersion - EXT-X-VERSION missing or lower than required for the features used
+	RuleVersion = "EXT-X-VERSION"
+	//RulePartTarget - EXT-X-PART DURATION above EXT-X-PART-INF PART-TARGET
+	RulePartTarget = "EXT-X-PART-TARGET"
+	//RuleMediaSequence - EXT-X-MEDIA-SEQUENCE or EXT-X-SKIP after the first segment or more than once
+	RuleMediaSequence = "EXT-X-MEDIA-SEQUENCE"
+	//RuleBandwidth - EXT-X-STREAM-INF or EXT-X-I-FRAME-STREAM-INF without BANDWIDTH
+	RuleBandwidth = "STREAM-INF-BANDWIDTH"
+	//RuleMediaGroup - GROUP-ID referenced by EXT-X-STREAM-INF not defined by EXT-X-MEDIA
+	RuleMediaGroup = "EXT-X-MEDIA-GROUP"
+	//RuleMixedPlaylist - Multivariant Playlist tags along with Media Playlist tags
+	RuleMixedPlaylist = "MIXED-PLAYLIST"
+	//RulePartHoldBack - EXT-X-SERVER-CONTROL PART-HOLD-BACK missing or below 2x PART-TARGET (3x recommended)
+	RulePartHoldBack = "SERVER-CONTROL-PART-HOLD-BACK"
+	//RuleHoldBack - EXT-X-SERVER-CONTROL HOLD-BACK below 3x EXT-X-TARGETDURATION
+	RuleHoldBack = "SERVER-CONTROL-HOLD-BACK"
+	//RuleCanSkipUntil - EXT-X-SERVER-CONTROL CAN-SKIP-UNTIL below 6x EXT-X-TARGETDURATION
+	RuleCanSkipUntil = "SERVER-CONTROL-CAN-SKIP-UNTIL"
+)
+
+//Finding - violation of RFC 8216 found in the playlist
+type Finding struct {
+	Rule     string
+	Severity m3u8reader.Severity
+	Entry    int //index in M3U8.Entries, -1 if not specific to an entry
+	Message  string
+}
+
+func (f Finding) String() string {
+	if f.Entry < 0 {
+		return fmt.Sprintf("%v : %v : %v", f.Severity, f.Rule, f.Message)
+	}
+	return fmt.Sprintf("%v : %v : entry %v : %v", f.Severity, f.Rule, f.Entry, f.Message)
+}
+
+type checker struct {
+	entries  []m3u8reader.M3U8Entry
+	findings []Finding
+}
+
+func (c *checker) add(rule string, severity m3u8reader.Severity, entry int, format string, args ...interface{}) {
+	c.findings = append(c.findings, Finding{Rule: rule, Severity: severity, Entry: entry, Message: fmt.Sprintf(format, args...)})
+}
+
+//first - index of the first entry with the tag, -1 if none
+func (c *checker) first(tag common.TagId) int {
+	for i := range c.entries {
+		if c.entries[i].Tag == tag {
+			return i
+		}
+	}
+	return -1
+}
+
+//Values which can't be read (not present or not substituted) are not validated
+func getFloat64(entry *m3u8reader.M3U8Entry, attrId common.AttrId) (float64, bool) {
+	f, err := entry.Values.GetFloat64(entry.Tag, attrId)
+	return f, err == nil
+}
+
+func getInt64(entry *m3u8reader.M3U8Entry, attrId common.AttrId) (int64, bool) {
+	i, err := entry.Values.GetInt64(entry.Tag, attrId)
+	return i, err == nil
+}
+
+func getString(entry *m3u8reader.M3U8Entry, attrId common.AttrId) (string, bool) {
+	s, err := entry.Values.GetString(entry.Tag, attrId)
+	return s, err == nil
+}
+
+//Validate - checks the playlist against the rules
+//Findings are in the order of the entries, the ones not specific to an entry first
+func Validate(m *m3u8reader.M3U8) []Finding {
+	c := &checker{entries: m.Entries}
+	c.checkMixed()
+	c.checkVersion()
+	c.checkTargetDuration()
+	c.checkPartTarget()
+	c.checkMediaSequence()
+	c.checkServerControl()
+	c.checkVariants()
+	sort.SliceStable(c.findings, func(i, j int) bool {
+		return c.findings[i].Entry < c.findings[j].Entry
+	})
+	return c.findings
+}
+
+//Tags allowed only in one kind of playlist
+var masterTags = map[common.TagId]bool{
+	common.M3U8ExtXMedia:           true,
+	common.M3U8ExtXStreamInf:       true,
+	common.M3U8ExtXIFrameStreamInf: true,
+	common.M3U8ExtXSessionData:     true,
+	common.M3U8ExtXSesionKey:       true,
+	common.M3U8ExtXContentSteering: true,
+}
+
+var mediaTags = map[common.TagId]bool{
+	common.M3U8TargetDuration:            true,
+	common.M3U8ExtXServerControl:         true,
+	common.M3U8ExtXPartInf:               true,
+	common.M3U8ExtXMediaSequence:         true,
+	common.M3U8XSkip:                     true,
+	common.M3U8ExtInf:                    true,
+	common.M3U8ExtXIProgramDateTime:      true,
+	common.M3U8ExtXPart:                  true,
+	common.M3U8ExtXPreLoadHint:           true,
+	common.M3U8ExtXRenditionReport:       true,
+	common.M3U8ExtXMap:                   true,
+	common.M3U8ExtXDiscontinuity:         true,
+	common.M3U8ExtXEndList:               true,
+	common.M3U8ExtXPlaylistType:          true,
+	common.M3U8ExtXByteRange:             true,
+	common.M3U8ExtXKey:                   true,
+	common.M3U8ExtXDataRange:             true,
+	common.M3U8ExtXDiscontinuitySequence: true,
+	common.M3U8ExtXIFramesOnly:           true,
+	common.M3U8ExtXGap:                   true,
+	common.M3U8ExtXBitrate:               true,
+}
+
+//checkMixed - kind of the playlist is set by the first tag specific to a kind
+//every tag of the other kind is reported
+func (c *checker) checkMixed() {
+	isMaster, isMedia := false, false
+	for i, entry := range c.entries {
+		switch {
+		case masterTags[entry.Tag] && isMedia:
+			c.add(RuleMixedPlaylist, m3u8reader.SeverityError, i, "%v in a Media Playlist", common.TagNames[entry.Tag])
+		case mediaTags[entry.Tag] && isMaster:
+			c.add(RuleMixedPlaylist, m3u8reader.SeverityError, i, "%v in a Multivariant Playlist", common.TagNames[entry.Tag])
+		case masterTags[entry.Tag]:
+			isMaster = true
+		case mediaTags[entry.Tag]:
+			isMedia = true
+		}
+	}
+}
+
+//requiredVersion - minimum EXT-X-VERSION for the entry, with the feature requiring it
+func requiredVersion(entry *m3u8reader.M3U8Entry, iFramesOnly bool) (version int64, feature string) {
+	version = 1
+	require := func(v int64, f string) {
+		if v > version {
+			version, feature = v, f
+		}
+	}
+	tagName := common.TagNames[entry.Tag]
+	switch entry.Tag {
+	case common.M3U8ExtXKey, common.M3U8ExtXSesionKey:
+		if entry.Values.Exists(common.M3U8IV) {
+			require(2, tagName+" IV")
+		}
+		if entry.Values.Exists(common.M3U8KeyFormat) || entry.Values.Exists(common.M3U8KeyFormatVersions) {
+			require(5, tagName+" KEYFORMAT")
+		}
+	case common.M3U8ExtInf:
+		if d, ok := getFloat64(entry, common.INTUnknownAttr); ok && d != math.Trunc(d) {
+			require(3, "floating point EXTINF duration")
+		}
+	case common.M3U8ExtXByteRange, common.M3U8ExtXIFramesOnly:
+		require(4, tagName)
+	case common.M3U8ExtXMap:
+		if iFramesOnly {
+			require(5, tagName)
+		} else {
+			require(6, tagName+" without EXT-X-I-FRAMES-ONLY")
+		}
+	case common.M3U8ExtXMedia:
+		if id, ok := getString(entry, common.M3U8InStreamId); ok && strings.HasPrefix(id, "SERVICE") {
+			require(7, tagName+" INSTREAM-ID SERVICE")
+		}
+	case common.M3U8ExtXDefine:
+		require(8, tagName)
+		if entry.Values.Exists(common.M3U8QueryParam) {
+			require(11, tagName+" QUERYPARAM")
+		}
+	case common.M3U8XSkip:
+		require(9, tagName)
+		if entry.Values.Exists(common.M3U8RecentlyRemovedDateRanges) {
+			require(10, tagName+" RECENTLY-REMOVED-DATERANGES")
+		}
+	}
+	for _, val := range entry.Values.Map() {
+		if s, ok := val.(string); ok && strings.Contains(s, parsers.VariableRef) {
+			require(8, "variable reference")
+		}
+	}
+	return
+}
+
+//checkVersion - every feature needing a higher version than declared is reported once
+func (c *checker) checkVersion() {
+	declared := int64(1)
+	if i := c.first(common.M3U8ExtXVersion); i >= 0 {
+		if v, ok := getInt64(&c.entries[i], common.INTUnknownAttr); ok {
+			declared = v
+		}
+	}
+	iFramesOnly := c.first(common.M3U8ExtXIFramesOnly) >= 0
+	reported := make(map[string]bool)
+	for i := range c.entries {
+		version, feature := requiredVersion(&c.entries[i], iFramesOnly)
+		if version <= declared || reported[feature] {
+			continue
+		}
+		reported[feature] = true
+		c.add(RuleVersion, m3u8reader.SeverityError, i, "%v requires version %v : declared %v", feature, version, declared)
+	}
+}
+
+//checkTargetDuration - EXTINF rounded to the nearest integer must not exceed the target duration
+func (c *checker) checkTargetDuration() {
+	i := c.first(common.M3U8TargetDuration)
+	if i < 0 {
+		return
+	}
+	target, ok := getInt64(&c.entries[i], common.INTUnknownAttr)
+	if !ok {
+		return
+	}
+	for i := range c.entries {
+		if c.entries[i].Tag != common.M3U8ExtInf {
+			continue
+		}
+		d, ok := getFloat64(&c.entries[i], common.INTUnknownAttr)
+		if ok && int64(math.Round(d)) > target {
+			c.add(RuleTargetDuration, m3u8reader.SeverityError, i, "duration %v above target duration %v", d, target)
+		}
+	}
+}
+
+//checkPartTarget - EXT-X-PART DURATION must not exceed the PART-TARGET
+func (c *checker) checkPartTarget() {
+	i := c.first(common.M3U8ExtXPartInf)
+	if i < 0 {
+		return
+	}
+	target, ok := getFloat64(&c.entries[i], common.M3U8PartTarget)
+	if !ok {
+		return
+	}
+	for i := range c.entries {
+		if c.entries[i].Tag != common.M3U8ExtXPart {
+			continue
+		}
+		d, ok := getFloat64(&c.entries[i], common.M3U8Duration)
+		if ok && d > target+tolerance {
+			c.add(RulePartTarget, m3u8reader.SeverityError, i, "duration %v above part target %v", d, target)
+		}
+	}
+}
+
+//checkMediaSequence - segments are numbered from EXT-X-MEDIA-SEQUENCE plus the SKIPPED-SEGMENTS of EXT-X-SKIP
+//both are to appear once, before the first segment
+func (c *checker) checkMediaSequence() {
+	firstSegment := -1
+	seen := make(map[common.TagId]bool)
+	for i := range c.entries {
+		entry := &c.entries[i]
+		switch entry.Tag {
+		case common.M3U8ExtInf, common.M3U8ExtXPart:
+			if firstSegment < 0 {
+				firstSegment = i
+			}
+		case common.M3U8ExtXMediaSequence, common.M3U8XSkip:
+			switch {
+			case firstSegment >= 0:
+				c.add(RuleMediaSequence, m3u8reader.SeverityError, i, "%v after the first segment", common.TagNames[entry.Tag])
+			case seen[entry.Tag]:
+				c.add(RuleMediaSequence, m3u8reader.SeverityError, i, "%v more than once", common.TagNames[entry.Tag])
+			}
+			seen[entry.Tag] = true
+			if entry.Tag != common.M3U8XSkip {
+				continue
+			}
+			if skipped, ok := getInt64(entry, common.M3U8SkippedSegments); ok && skipped < 0 {
+				c.add(RuleMediaSequence, m3u8reader.SeverityError, i, "SKIPPED-SEGMENTS %v negative", skipped)
+			}
+		}
+	}
+}
+
+//tolerance - durations written with rounding, e.g. PART-TARGET of 1/3 s, are not reported
+const tolerance = 0.001
+
+//checkServerControl - hold backs of EXT-X-SERVER-CONTROL against the target durations
+func (c *checker) checkServerControl() {
+	var targetDuration, partTarget float64
+	if i := c.first(common.M3U8TargetDuration); i >= 0 {
+		if t, ok := getInt64(&c.entries[i], common.INTUnknownAttr); ok {
+			targetDuration = float64(t)
+		}
+	}
+	partInf := c.first(common.M3U8ExtXPartInf)
+	if partInf >= 0 {
+		partTarget, _ = getFloat64(&c.entries[partInf], common.M3U8PartTarget)
+	}
+	i := c.first(common.M3U8ExtXServerControl)
+	if i < 0 {
+		if partInf >= 0 {
+			c.add(RulePartHoldBack, m3u8reader.SeverityError, partInf, "PART-HOLD-BACK required with %v", common.TagNames[common.M3U8ExtXPartInf])
+		}
+		return
+	}
+	entry := &c.entries[i]
+	if partInf >= 0 {
+		partHoldBack, ok := getFloat64(entry, common.M3U8PartHoldBack)
+		switch {
+		case !ok:
+			c.add(RulePartHoldBack, m3u8reader.SeverityError, i, "PART-HOLD-BACK required with %v", common.TagNames[common.M3U8ExtXPartInf])
+		case partHoldBack < 2*partTarget-tolerance:
+			c.add(RulePartHoldBack, m3u8reader.SeverityError, i, "PART-HOLD-BACK %v below 2x PART-TARGET %v", partHoldBack, partTarget)
+		case partHoldBack < 3*partTarget-tolerance:
+			c.add(RulePartHoldBack, m3u8reader.SeverityWarning, i, "PART-HOLD-BACK %v below 3x PART-TARGET %v", partHoldBack, partTarget)
+		}
+	}
+	if holdBack, ok := getFloat64(entry, common.M3U8HoldBack); ok && holdBack < 3*targetDuration-tolerance {
+		c.add(RuleHoldBack, m3u8reader.SeverityError, i, "HOLD-BACK %v below 3x target duration %v", holdBack, targetDuration)
+	}
+	if canSkipUntil, ok := getFloat64(entry, common.M3U8CanSkipUntil); ok && canSkipUntil < 6*targetDuration-tolerance {
+		c.add(RuleCanSkipUntil, m3u8reader.SeverityError, i, "CAN-SKIP-UNTIL %v below 6x target duration %v", canSkipUntil, targetDuration)
+	}
+}
+
+//Renditions TYPE referenced by the attributes of EXT-X-STREAM-INF
+var groupTypes = []struct {
+	attrId    common.AttrId
+	mediaType string
+}{
+	{common.M3U8Audio, "AUDIO"},
+	{common.M3U8Video, "VIDEO"},
+	{common.M3U8Subtitles, "SUBTITLES"},
+	{common.M3U8ClosedCaptions, "CLOSED-CAPTIONS"},
+}
+
+//checkVariants - BANDWIDTH is required and the groups referenced are to be defined
+func (c *checker) checkVariants() {
+	groups := make(map[string]bool)
+	for i := range c.entries {
+		entry := &c.entries[i]
+		if entry.Tag != common.M3U8ExtXMedia {
+			continue
+		}
+		mediaType, _ := getString(entry, common.M3U8Type)
+		groupId, _ := getString(entry, common.M3U8GroupId)
+		groups[mediaType+":"+groupId] = true
+	}
+	for i := range c.entries {
+		entry := &c.entries[i]
+		switch entry.Tag {
+		case common.M3U8ExtXStreamInf, common.M3U8ExtXIFrameStreamInf:
+			if !entry.Values.Exists(common.M3U8Bandwidth) {
+				c.add(RuleBandwidth, m3u8reader.SeverityError, i, "BANDWIDTH required for %v", common.TagNames[entry.Tag])
+			}
+		}
+		if entry.Tag != common.M3U8ExtXStreamInf {
+			continue
+		}
+		for _, group := range groupTypes {
+			groupId, ok := getString(entry, group.attrId)
+			if !ok || (group.attrId == common.M3U8ClosedCaptions && groupId == "NONE") {
+				continue
+			}
+			if !groups[group.mediaType+":"+groupId] {
+				c.add(RuleMediaGroup, m3u8reader.SeverityError, i, "%v GROUP-ID \"%v\" not defined by %v",
+					group.mediaType, groupId, common.TagNames[common.M3U8ExtXMedia])
+			}
+		}
+	}
+}
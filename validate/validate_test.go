@@ -0,0 +1,99 @@
+package validate_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/eswarantg/m3u8reader"
+	"github.com/eswarantg/m3u8reader/parsers"
+	"github.com/eswarantg/m3u8reader/validate"
+)
+
+func Test_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		opt      m3u8reader.ParserOption
+		lines    []string
+		expected []string //rule:severity:entry
+	}{
+		{"valid media", m3u8reader.M3U8ParserScanner3, []string{
+			"#EXTM3U", "#EXT-X-VERSION:6", "#EXT-X-TARGETDURATION:4", "#EXT-X-MEDIA-SEQUENCE:5",
+			"#EXT-X-MAP:URI=\"init.mp4\"", "#EXTINF:4.0,", "seg5.ts", "#EXTINF:4.4,", "seg6.ts", "#EXT-X-ENDLIST",
+		}, nil},
+		{"valid master", m3u8reader.M3U8ParserScanner3, []string{
+			"#EXTM3U", "#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID=\"aac\",NAME=\"en\",LANGUAGE=\"en\"",
+			"#EXT-X-STREAM-INF:BANDWIDTH=1280000,AUDIO=\"aac\",CLOSED-CAPTIONS=NONE", "low.m3u8",
+		}, nil},
+		{"target duration", m3u8reader.M3U8ParserScanner3, []string{
+			"#EXTM3U", "#EXT-X-VERSION:3", "#EXT-X-TARGETDURATION:4", "#EXTINF:4.0,", "seg1.ts", "#EXTINF:4.5,", "seg2.ts",
+		}, []string{"EXTINF-TARGETDURATION:error:4"}},
+		{"version", m3u8reader.M3U8ParserScanner3, []string{
+			"#EXTM3U", "#EXT-X-TARGETDURATION:4", "#EXT-X-KEY:METHOD=AES-128,URI=\"k\",IV=0x01",
+			"#EXT-X-MAP:URI=\"init.mp4\"", "#EXTINF:4.0,", "seg1.ts", "#EXTINF:3.5,", "seg2.ts", "#EXTINF:3.5,", "seg3.ts",
+		}, []string{"EXT-X-VERSION:error:2", "EXT-X-VERSION:error:3", "EXT-X-VERSION:error:5"}},
+		{"part target", m3u8reader.M3U8ParserScanner3, []string{
+			"#EXTM3U", "#EXT-X-VERSION:9", "#EXT-X-TARGETDURATION:4", "#EXT-X-PART-INF:PART-TARGET=1.0",
+			"#EXT-X-SERVER-CONTROL:PART-HOLD-BACK=3.0",
+			"#EXT-X-PART:DURATION=1.0,URI=\"p1.mp4\"", "#EXT-X-PART:DURATION=1.2,URI=\"p2.mp4\"",
+		}, []string{"EXT-X-PART-TARGET:error:6"}},
+		{"media sequence", m3u8reader.M3U8ParserScanner3, []string{
+			"#EXTM3U", "#EXT-X-TARGETDURATION:4", "#EXTINF:4,", "seg1.ts", "#EXT-X-MEDIA-SEQUENCE:10", "#EXTINF:4,", "seg2.ts",
+		}, []string{"EXT-X-MEDIA-SEQUENCE:error:3"}},
+		{"media sequence twice", m3u8reader.M3U8ParserScanner3, []string{
+			"#EXTM3U", "#EXT-X-TARGETDURATION:4", "#EXT-X-MEDIA-SEQUENCE:10", "#EXT-X-MEDIA-SEQUENCE:12", "#EXTINF:4,", "seg12.ts",
+		}, []string{"EXT-X-MEDIA-SEQUENCE:error:3"}},
+		{"skip", m3u8reader.M3U8ParserScanner3, []string{
+			"#EXTM3U", "#EXT-X-VERSION:9", "#EXT-X-TARGETDURATION:4", "#EXT-X-MEDIA-SEQUENCE:10",
+			"#EXT-X-SKIP:SKIPPED-SEGMENTS=3", "#EXTINF:4,", "seg13.ts", "#EXTINF:4,", "seg14.ts",
+		}, nil},
+		{"skip after segment", m3u8reader.M3U8ParserScanner3, []string{
+			"#EXTM3U", "#EXT-X-VERSION:9", "#EXT-X-TARGETDURATION:4", "#EXT-X-MEDIA-SEQUENCE:10",
+			"#EXTINF:4,", "seg10.ts", "#EXT-X-SKIP:SKIPPED-SEGMENTS=3", "#EXTINF:4,", "seg14.ts",
+		}, []string{"EXT-X-MEDIA-SEQUENCE:error:5"}},
+		{"skip negative", m3u8reader.M3U8ParserScanner3, []string{
+			"#EXTM3U", "#EXT-X-VERSION:9", "#EXT-X-TARGETDURATION:4", "#EXT-X-MEDIA-SEQUENCE:10",
+			"#EXT-X-SKIP:SKIPPED-SEGMENTS=-3", "#EXTINF:4,", "seg7.ts",
+		}, []string{"EXT-X-MEDIA-SEQUENCE:error:4"}},
+		//the scan parsers reject EXT-X-STREAM-INF without BANDWIDTH, the Grammar parser keeps it
+		{"bandwidth", m3u8reader.M3U8ParserGrammar, []string{
+			"#EXTM3U", "#EXT-X-STREAM-INF:RESOLUTION=1280x720", "low.m3u8",
+		}, []string{"STREAM-INF-BANDWIDTH:error:1"}},
+		{"media group", m3u8reader.M3U8ParserScanner3, []string{
+			"#EXTM3U", "#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID=\"aac\",NAME=\"en\",LANGUAGE=\"en\"",
+			"#EXT-X-STREAM-INF:BANDWIDTH=1280000,AUDIO=\"aac\",SUBTITLES=\"subs\"", "low.m3u8",
+			"#EXT-X-STREAM-INF:BANDWIDTH=2560000,VIDEO=\"aac\"", "high.m3u8",
+		}, []string{"EXT-X-MEDIA-GROUP:error:2", "EXT-X-MEDIA-GROUP:error:3"}},
+		{"mixed", m3u8reader.M3U8ParserScanner3, []string{
+			"#EXTM3U", "#EXT-X-STREAM-INF:BANDWIDTH=1280000", "low.m3u8", "#EXT-X-TARGETDURATION:4", "#EXTINF:4,", "seg1.ts",
+		}, []string{"MIXED-PLAYLIST:error:2", "MIXED-PLAYLIST:error:3"}},
+		{"server control", m3u8reader.M3U8ParserScanner3, []string{
+			"#EXTM3U", "#EXT-X-VERSION:9", "#EXT-X-TARGETDURATION:4", "#EXT-X-PART-INF:PART-TARGET=1.0",
+			"#EXT-X-SERVER-CONTROL:PART-HOLD-BACK=1.5,HOLD-BACK=8,CAN-SKIP-UNTIL=12",
+		}, []string{"SERVER-CONTROL-PART-HOLD-BACK:error:4", "SERVER-CONTROL-HOLD-BACK:error:4", "SERVER-CONTROL-CAN-SKIP-UNTIL:error:4"}},
+		{"part hold back recommended", m3u8reader.M3U8ParserScanner3, []string{
+			"#EXTM3U", "#EXT-X-VERSION:9", "#EXT-X-TARGETDURATION:4", "#EXT-X-PART-INF:PART-TARGET=1.0",
+			"#EXT-X-SERVER-CONTROL:PART-HOLD-BACK=2.5",
+		}, []string{"SERVER-CONTROL-PART-HOLD-BACK:warning:4"}},
+		{"part hold back missing", m3u8reader.M3U8ParserScanner3, []string{
+			"#EXTM3U", "#EXT-X-VERSION:9", "#EXT-X-TARGETDURATION:4", "#EXT-X-PART-INF:PART-TARGET=1.0",
+		}, []string{"SERVER-CONTROL-PART-HOLD-BACK:error:3"}},
+	}
+	parsers.AttrKVPairsSyncPool = false
+	for _, test := range tests {
+		manifest := m3u8reader.M3U8{}
+		manifest.SetParserOption(test.opt)
+		_, err := manifest.ParseData([]byte(strings.Join(test.lines, "\n") + "\n"))
+		if err != nil {
+			t.Errorf("%v : %v", test.name, err)
+			continue
+		}
+		var got []string
+		for _, f := range validate.Validate(&manifest) {
+			got = append(got, fmt.Sprintf("%v:%v:%v", f.Rule, f.Severity, f.Entry))
+		}
+		if fmt.Sprint(got) != fmt.Sprint(test.expected) {
+			t.Errorf("%v : expected %v : got %v", test.name, test.expected, got)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package m3u8reader
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+//hexadecimal-sequence attributes, substituted along with the quoted-string attributes
+//as per RFC 8216bis section 4.3
+var hexSequenceAttrs = map[common.AttrId]bool{
+	common.M3U8IV:        true,
+	common.M3U8Scte35Cmd: true,
+	common.M3U8Scte35Out: true,
+	common.M3U8Scte35In:  true,
+}
+
+//validVariableName - [a-zA-Z0-9-_]+
+func validVariableName(name string) bool {
+	if len(name) == 0 {
+		return false
+	}
+	for _, ch := range name {
+		switch {
+		case ch >= 'a' && ch <= 'z', ch >= 'A' && ch <= 'Z', ch >= '0' && ch <= '9', ch == '-', ch == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+//substitute - replaces every {$name} in value
+func substitute(value string, vars map[string]string) (string, error) {
+	if !strings.Contains(value, "{$") {
+		return value, nil
+	}
+	var sb strings.Builder
+	for {
+		start := strings.Index(value, "{$")
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(value[start:], '}')
+		if end < 0 {
+			break
+		}
+		end += start
+		name := value[start+2 : end]
+		if !validVariableName(name) {
+			//not a variable reference, keep as is
+			sb.WriteString(value[:start+2])
+			value = value[start+2:]
+			continue
+		}
+		val, ok := vars[name]
+		if !ok {
+			return "", fmt.Errorf("undefined variable \"%v\"", name)
+		}
+		sb.WriteString(value[:start])
+		sb.WriteString(val)
+		value = value[end+1:]
+	}
+	sb.WriteString(value)
+	return sb.String(), nil
+}
+
+//define - processes one EXT-X-DEFINE entry
+func define(entry *M3U8Entry, vars map[string]string, parent map[string]string, query url.Values) (err error) {
+	r := newAttrReader(entry)
+	var name, value string
+	switch {
+	case r.has(common.M3U8Name):
+		name = r.str(common.M3U8Name)
+		if !r.has(common.M3U8Value) {
+			return fmt.Errorf("%v : missing VALUE for NAME \"%v\"", common.TagNames[entry.Tag], name)
+		}
+		value = r.str(common.M3U8Value)
+	case r.has(common.M3U8Import):
+		name = r.str(common.M3U8Import)
+		var ok bool
+		value, ok = parent[name]
+		if !ok {
+			return fmt.Errorf("%v : IMPORT \"%v\" not defined in Multivariant Playlist", common.TagNames[entry.Tag], name)
+		}
+	case r.has(common.M3U8QueryParam):
+		name = r.str(common.M3U8QueryParam)
+		values, ok := query[name]
+		if !ok || len(values) == 0 {
+			return fmt.Errorf("%v : QUERYPARAM \"%v\" not present in playlist URL", common.TagNames[entry.Tag], name)
+		}
+		value = values[0]
+	default:
+		return fmt.Errorf("%v : one of NAME, IMPORT or QUERYPARAM required", common.TagNames[entry.Tag])
+	}
+	if r.err != nil {
+		return r.err
+	}
+	if !validVariableName(name) {
+		return fmt.Errorf("%v : invalid variable name \"%v\"", common.TagNames[entry.Tag], name)
+	}
+	if _, ok := vars[name]; ok {
+		return fmt.Errorf("%v : variable \"%v\" defined more than once", common.TagNames[entry.Tag], name)
+	}
+	vars[name] = value
+	return nil
+}
+
+//SubstituteVariables - processes EXT-X-DEFINE tags and replaces {$name} references
+//in URI lines, quoted-string and hexadecimal-sequence attribute values
+//parent - variables of the Multivariant Playlist used for IMPORT, nil if not available
+//playlistURL - URL the playlist was loaded from used for QUERYPARAM, empty if not available
+//Returns the variables defined, which can be passed as parent for the Media Playlists
+func (m *M3U8) SubstituteVariables(parent map[string]string, playlistURL string) (vars map[string]string, err error) {
+	var query url.Values
+	if playlistURL != "" {
+		var u *url.URL
+		u, err = url.Parse(playlistURL)
+		if err != nil {
+			return
+		}
+		query = u.Query()
+	}
+	vars = make(map[string]string)
+	for i := range m.Entries {
+		entry := &m.Entries[i]
+		if entry.Tag == common.M3U8ExtXDefine {
+			err = define(entry, vars, parent, query)
+			if err != nil {
+				return
+			}
+			continue
+		}
+		if entry.Values == nil {
+			continue
+		}
+		for attrId, val := range entry.Values.Map() {
+			switch attrId {
+			case common.INTUnknownAttr:
+				//URI line
+				if entry.Tag != common.M3U8ExtXStreamInf {
+					continue
+				}
+			default:
+				if !quotedStringAttrs[attrId] && !hexSequenceAttrs[attrId] {
+					continue
+				}
+			}
+			var str string
+			switch v := val.(type) {
+			case string:
+				str = v
+			case []byte:
+				str = string(v)
+			default:
+				continue
+			}
+			var newStr string
+			newStr, err = substitute(str, vars)
+			if err != nil {
+				err = fmt.Errorf("%v:%v %w", common.TagNames[entry.Tag], common.AttrNames[attrId], err)
+				return
+			}
+			if newStr != str {
+				//type the value which was kept as text for the substitution
+				var newVal interface{}
+				newVal, err = parsers.ConvertValue(entry.Tag, attrId, newStr)
+				if err != nil {
+					return
+				}
+				entry.StoreKV(attrId, newVal)
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,93 @@
+package m3u8reader_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eswarantg/m3u8reader"
+	"github.com/eswarantg/m3u8reader/common"
+	"github.com/eswarantg/m3u8reader/parsers"
+)
+
+func parseString(t *testing.T, data string) *m3u8reader.M3U8 {
+	parsers.AttrKVPairsSyncPool = false
+	manifest := &m3u8reader.M3U8{}
+	manifest.SetBuffer(make([]byte, 4096))
+	_, err := manifest.ParseData([]byte(data))
+	if err != nil {
+		t.Fatalf("ParseData : %v", err)
+	}
+	return manifest
+}
+
+func Test_SubstituteVariables(t *testing.T) {
+	master := parseString(t, strings.Join([]string{
+		"#EXTM3U",
+		"#EXT-X-DEFINE:NAME=\"host\",VALUE=\"https://cdn.example.com\"",
+		"#EXT-X-DEFINE:QUERYPARAM=\"token\"",
+		"#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID=\"aac\",NAME=\"en\",LANGUAGE=\"en\",URI=\"{$host}/audio.m3u8?t={$token}\"",
+		"#EXT-X-STREAM-INF:BANDWIDTH=1280000,AUDIO=\"aac\"",
+		"{$host}/low/index.m3u8?t={$token}",
+		"",
+	}, "\n"))
+	vars, err := master.SubstituteVariables(nil, "https://origin.example.com/master.m3u8?token=abc&x=1")
+	if err != nil {
+		t.Fatalf("SubstituteVariables : %v", err)
+	}
+	uri, _ := master.Entries[3].URI()
+	if uri != "https://cdn.example.com/audio.m3u8?t=abc" {
+		t.Errorf("media uri : got %v", uri)
+	}
+	uri, _ = master.Entries[4].URI()
+	if uri != "https://cdn.example.com/low/index.m3u8?t=abc" {
+		t.Errorf("variant uri : got %v", uri)
+	}
+
+	media := parseString(t, strings.Join([]string{
+		"#EXTM3U",
+		"#EXT-X-TARGETDURATION:4",
+		"#EXT-X-DEFINE:IMPORT=\"host\"",
+		"#EXT-X-MAP:URI=\"{$host}/init.mp4\"",
+		"#EXTINF:4.0,",
+		"{$host}/seg1.mp4",
+		"",
+	}, "\n"))
+	_, err = media.SubstituteVariables(vars, "")
+	if err != nil {
+		t.Fatalf("SubstituteVariables : %v", err)
+	}
+	segments, err := media.Segments()
+	if err != nil {
+		t.Fatalf("Segments : %v", err)
+	}
+	if segments[0].URI != "https://cdn.example.com/seg1.mp4" || segments[0].Map.URI != "https://cdn.example.com/init.mp4" {
+		t.Errorf("segment uri/map : got %v/%v", segments[0].URI, segments[0].Map.URI)
+	}
+
+	key := parseString(t, "#EXTM3U\n#EXT-X-DEFINE:NAME=\"iv\",VALUE=\"0x0F\"\n#EXT-X-KEY:METHOD=AES-128,URI=\"k\",IV={$iv}\n")
+	_, err = key.SubstituteVariables(nil, "")
+	if err != nil {
+		t.Fatalf("SubstituteVariables : %v", err)
+	}
+	if iv := key.Entries[2].Values.Get(common.M3U8IV); iv != [16]byte{15: 0x0f} {
+		t.Errorf("iv : got %T(%v)", iv, iv)
+	}
+
+	failures := []struct {
+		data   string
+		parent map[string]string
+		url    string
+	}{
+		{"#EXTM3U\n#EXT-X-MAP:URI=\"{$host}/init.mp4\"\n", nil, ""},
+		{"#EXTM3U\n#EXT-X-DEFINE:IMPORT=\"host\"\n", map[string]string{"other": "x"}, ""},
+		{"#EXTM3U\n#EXT-X-DEFINE:QUERYPARAM=\"token\"\n", nil, "https://example.com/a.m3u8?x=1"},
+		{"#EXTM3U\n#EXT-X-DEFINE:NAME=\"a\",VALUE=\"1\"\n#EXT-X-DEFINE:NAME=\"a\",VALUE=\"2\"\n", nil, ""},
+	}
+	for i, test := range failures {
+		manifest := parseString(t, test.data)
+		_, err = manifest.SubstituteVariables(test.parent, test.url)
+		if err == nil {
+			t.Errorf("%v : expected error", i)
+		}
+	}
+}